@@ -0,0 +1,14 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/database-playground/backend-v2/internal/events"
+)
+
+// ArchiveEvents archives the events older than the retention of the policy.
+//
+// It returns the number of events archived.
+func (c *Context) ArchiveEvents(ctx context.Context, policy events.RetentionPolicy) (int, error) {
+	return events.NewEventService(c.entClient, nil).ApplyRetention(ctx, policy)
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
+)
+
+// apolloManifestFormat is the format of the persisted query manifests
+// generated by Apollo's generate-persisted-query-manifest.
+const apolloManifestFormat = "apollo-persisted-query-manifest"
+
+// ParsePersistedQueryManifest parses a persisted query manifest to a map
+// from the SHA-256 hashes to the queries.
+//
+// The manifest is either a JSON object mapping the hashes to the queries
+// (the persisted documents of GraphQL Code Generator), or an Apollo
+// persisted query manifest.
+func ParsePersistedQueryManifest(content []byte) (map[string]string, error) {
+	var apolloManifest struct {
+		Format     string `json:"format"`
+		Operations []struct {
+			ID   string `json:"id"`
+			Body string `json:"body"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(content, &apolloManifest); err == nil && apolloManifest.Format == apolloManifestFormat {
+		manifest := make(map[string]string, len(apolloManifest.Operations))
+		for _, op := range apolloManifest.Operations {
+			manifest[op.ID] = op.Body
+		}
+
+		return manifest, nil
+	}
+
+	var manifest map[string]string
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// UploadPersistedQueries adds the queries of the manifest, keyed by their
+// SHA-256 hashes in hex, to the persisted query allowlist.
+//
+// The persisted queries are immutable, so the queries already in the
+// allowlist are skipped. It returns an error without adding any query if
+// a hash does not match its query.
+//
+// UploadPersistedQueries returns the number of the added queries.
+func (c *Context) UploadPersistedQueries(ctx context.Context, manifest map[string]string) (int, error) {
+	if len(manifest) == 0 {
+		return 0, nil
+	}
+
+	for hash, query := range manifest {
+		if query == "" {
+			return 0, fmt.Errorf("persisted query %q: query is required", hash)
+		}
+
+		sum := sha256.Sum256([]byte(query))
+		if hex.EncodeToString(sum[:]) != hash {
+			return 0, fmt.Errorf("persisted query %q: hash does not match the SHA-256 hash of the query", hash)
+		}
+	}
+
+	hashes := slices.Sorted(maps.Keys(manifest))
+
+	existingHashes, err := c.entClient.PersistedQuery.Query().
+		Where(persistedquery.HashIn(hashes...)).
+		Select(persistedquery.FieldHash).
+		Strings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query persisted queries: %w", err)
+	}
+
+	newHashes := slices.DeleteFunc(hashes, func(hash string) bool {
+		return slices.Contains(existingHashes, hash)
+	})
+	if len(newHashes) == 0 {
+		return 0, nil
+	}
+
+	err = c.entClient.PersistedQuery.MapCreateBulk(newHashes, func(create *ent.PersistedQueryCreate, i int) {
+		create.SetHash(newHashes[i]).SetQuery(manifest[newHashes[i]])
+	}).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("create persisted queries: %w", err)
+	}
+
+	return len(newHashes), nil
+}
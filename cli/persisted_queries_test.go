@@ -0,0 +1,114 @@
+package cli_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/database-playground/backend-v2/cli"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func hashOf(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploadPersistedQueries(t *testing.T) {
+	t.Run("should add the new queries and skip the existing ones", func(t *testing.T) {
+		entClient := testhelper.NewEntSqliteClient(t)
+		ctx := context.Background()
+		cliCtx := cli.NewContext(entClient)
+
+		meQuery := "query Me { me { id } }"
+		questionsQuery := "query Questions { questions { totalCount } }"
+
+		added, err := cliCtx.UploadPersistedQueries(ctx, map[string]string{hashOf(meQuery): meQuery})
+		if err != nil {
+			t.Fatalf("UploadPersistedQueries failed: %v", err)
+		}
+		if added != 1 {
+			t.Errorf("Expected 1 query added, got %d", added)
+		}
+
+		added, err = cliCtx.UploadPersistedQueries(ctx, map[string]string{
+			hashOf(meQuery):        meQuery,
+			hashOf(questionsQuery): questionsQuery,
+		})
+		if err != nil {
+			t.Fatalf("UploadPersistedQueries failed: %v", err)
+		}
+		if added != 1 {
+			t.Errorf("Expected 1 query added, got %d", added)
+		}
+
+		count, err := entClient.PersistedQuery.Query().Count(ctx)
+		if err != nil {
+			t.Fatalf("Failed to count persisted queries: %v", err)
+		}
+		if count != 2 {
+			t.Errorf("Expected 2 persisted queries, got %d", count)
+		}
+	})
+
+	t.Run("should reject the manifest with a mismatched hash", func(t *testing.T) {
+		entClient := testhelper.NewEntSqliteClient(t)
+		ctx := context.Background()
+		cliCtx := cli.NewContext(entClient)
+
+		meQuery := "query Me { me { id } }"
+
+		_, err := cliCtx.UploadPersistedQueries(ctx, map[string]string{
+			hashOf(meQuery):                       meQuery,
+			hashOf("query Other { me { name } }"): "query Other { me { id } }",
+		})
+		if err == nil {
+			t.Fatal("Expected error for mismatched hash")
+		}
+
+		count, err := entClient.PersistedQuery.Query().Count(ctx)
+		if err != nil {
+			t.Fatalf("Failed to count persisted queries: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("Expected no persisted queries, got %d", count)
+		}
+	})
+}
+
+func TestParsePersistedQueryManifest(t *testing.T) {
+	meQuery := "query Me { me { id } }"
+
+	t.Run("should parse the map of hashes to queries", func(t *testing.T) {
+		manifest, err := cli.ParsePersistedQueryManifest([]byte(`{"` + hashOf(meQuery) + `": "` + meQuery + `"}`))
+		if err != nil {
+			t.Fatalf("ParsePersistedQueryManifest failed: %v", err)
+		}
+		if manifest[hashOf(meQuery)] != meQuery {
+			t.Errorf("Expected the query of %s, got %v", hashOf(meQuery), manifest)
+		}
+	})
+
+	t.Run("should parse the Apollo manifest", func(t *testing.T) {
+		manifest, err := cli.ParsePersistedQueryManifest([]byte(`{
+			"format": "apollo-persisted-query-manifest",
+			"version": 1,
+			"operations": [{"id": "` + hashOf(meQuery) + `", "name": "Me", "type": "query", "body": "` + meQuery + `"}]
+		}`))
+		if err != nil {
+			t.Fatalf("ParsePersistedQueryManifest failed: %v", err)
+		}
+		if len(manifest) != 1 || manifest[hashOf(meQuery)] != meQuery {
+			t.Errorf("Expected the query of %s, got %v", hashOf(meQuery), manifest)
+		}
+	})
+
+	t.Run("should reject the invalid manifest", func(t *testing.T) {
+		if _, err := cli.ParsePersistedQueryManifest([]byte(`[]`)); err == nil {
+			t.Fatal("Expected error for invalid manifest")
+		}
+	})
+}
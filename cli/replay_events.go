@@ -0,0 +1,17 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/database-playground/backend-v2/internal/events"
+)
+
+// ReplayEvents re-queues the failed or historical events to the event handlers.
+//
+// The events are only queued in the outbox; the backend dispatches them
+// to the handlers asynchronously.
+//
+// It returns the number of outbox entries queued.
+func (c *Context) ReplayEvents(ctx context.Context, opts events.ReplayOptions) (int, error) {
+	return events.NewEventService(c.entClient, nil).Replay(ctx, opts)
+}
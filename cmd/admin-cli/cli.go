@@ -30,8 +30,11 @@ func main() {
 	setupCommand := newSetupCommand(c)
 	migrateCommand := newMigrateCommand(c)
 	seedUsersCommand := newSeedUsersCommand(c)
+	replayEventsCommand := newReplayEventsCommand(c)
+	archiveEventsCommand := newArchiveEventsCommand(c)
+	uploadPersistedQueriesCommand := newUploadPersistedQueriesCommand(c)
 
-	rootCommand := newRootCommand(promoteAdminCommand, setupCommand, migrateCommand, seedUsersCommand)
+	rootCommand := newRootCommand(promoteAdminCommand, setupCommand, migrateCommand, seedUsersCommand, replayEventsCommand, archiveEventsCommand, uploadPersistedQueriesCommand)
 
 	if err := rootCommand.Run(context.Background(), os.Args); err != nil {
 		log.Fatal(err)
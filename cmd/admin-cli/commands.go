@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	dpcli "github.com/database-playground/backend-v2/cli"
+	"github.com/database-playground/backend-v2/internal/events"
 	"github.com/urfave/cli/v3"
 )
 
@@ -114,6 +116,158 @@ func newSeedUsersCommand(clictx *dpcli.Context) *cli.Command {
 	}
 }
 
+func newReplayEventsCommand(clictx *dpcli.Context) *cli.Command {
+	return &cli.Command{
+		Name:        "replay-events",
+		Usage:       "Replay the failed or historical events to the event handlers",
+		Description: "Re-queue the events to the event outbox, which the backend dispatches to the handlers. With --dead-only, only the dead-lettered deliveries are retried; otherwise every matched event is replayed, including the events triggered before the outbox existed.",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "dead-only",
+				Usage: "Only replay the dead-lettered deliveries.",
+			},
+			&cli.StringSliceFlag{
+				Name:  "handler",
+				Usage: "The handlers to replay the events to. Defaults to every handler.",
+			},
+			&cli.StringSliceFlag{
+				Name:  "type",
+				Usage: "Only replay the events of these types.",
+			},
+			&cli.IntSliceFlag{
+				Name:  "event-id",
+				Usage: "Only replay the events with these IDs.",
+			},
+			&cli.TimestampFlag{
+				Name:  "since",
+				Usage: "Only replay the events triggered at or after this time (RFC 3339).",
+				Config: cli.TimestampConfig{
+					Layouts: []string{time.RFC3339},
+				},
+			},
+			&cli.TimestampFlag{
+				Name:  "until",
+				Usage: "Only replay the events triggered before this time (RFC 3339).",
+				Config: cli.TimestampConfig{
+					Layouts: []string{time.RFC3339},
+				},
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			opts := events.ReplayOptions{
+				Handlers: c.StringSlice("handler"),
+				EventIDs: c.IntSlice("event-id"),
+				DeadOnly: c.Bool("dead-only"),
+			}
+			for _, t := range c.StringSlice("type") {
+				opts.Types = append(opts.Types, events.EventType(t))
+			}
+			if c.IsSet("since") {
+				since := c.Timestamp("since")
+				opts.Since = &since
+			}
+			if c.IsSet("until") {
+				until := c.Timestamp("until")
+				opts.Until = &until
+			}
+
+			fmt.Println("Replaying events…")
+
+			queued, err := clictx.ReplayEvents(ctx, opts)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ %d deliveries queued! The backend will dispatch them shortly.\n", queued)
+			return nil
+		},
+	}
+}
+
+func newArchiveEventsCommand(clictx *dpcli.Context) *cli.Command {
+	return &cli.Command{
+		Name:        "archive-events",
+		Usage:       "Archive the events older than the retention",
+		Description: "Move the expired events out of the events table and keep their daily counts. The events are archived to the archived_events table, or to compressed JSON lines files with --archive-dir.",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "retention-days",
+				Usage: "Archive the events triggered before this many days ago. Must be at least 7.",
+				Value: 90,
+			},
+			&cli.StringFlag{
+				Name:  "archive-dir",
+				Usage: "Archive the events to the compressed JSON lines files in this directory instead of the archived_events table.",
+			},
+			&cli.IntFlag{
+				Name:  "batch-size",
+				Usage: "The number of events archived in a transaction.",
+				Value: events.DefaultRetentionBatchSize,
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			policy := events.RetentionPolicy{
+				Retention: time.Duration(c.Int("retention-days")) * 24 * time.Hour,
+				Archiver:  events.TableArchiver{},
+				BatchSize: c.Int("batch-size"),
+			}
+			if dir := c.String("archive-dir"); dir != "" {
+				archiver, err := events.NewFileArchiver(dir)
+				if err != nil {
+					return err
+				}
+				policy.Archiver = archiver
+			}
+
+			fmt.Println("Archiving events…")
+
+			archived, err := clictx.ArchiveEvents(ctx, policy)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ %d events archived!\n", archived)
+			return nil
+		},
+	}
+}
+
+func newUploadPersistedQueriesCommand(clictx *dpcli.Context) *cli.Command {
+	return &cli.Command{
+		Name:        "upload-persisted-queries",
+		Usage:       "Upload the persisted query manifest of the frontend build",
+		Description: "Add the queries of the manifest to the persisted query allowlist, which the backend only runs for the non-administrators in the strict mode (GRAPHQL_PERSISTED_QUERIES_STRICT). The manifest is either a JSON object mapping the SHA-256 hashes to the queries, or an Apollo persisted query manifest. The queries already in the allowlist are skipped.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "file",
+				Usage:    "The JSON file of the persisted query manifest.",
+				Required: true,
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			content, err := os.ReadFile(c.String("file"))
+			if err != nil {
+				return fmt.Errorf("read file: %w", err)
+			}
+
+			manifest, err := dpcli.ParsePersistedQueryManifest(content)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Uploading %d persisted queries from %q…\n", len(manifest), c.String("file"))
+
+			added, err := clictx.UploadPersistedQueries(ctx, manifest)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ %d persisted queries added!\n", added)
+			return nil
+		},
+	}
+}
+
 func newRootCommand(subcommands ...*cli.Command) *cli.Command {
 	return &cli.Command{
 		Name:     "admin-cli",
@@ -16,19 +16,31 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/graph"
 	"github.com/database-playground/backend-v2/httpapi"
 	authservice "github.com/database-playground/backend-v2/httpapi/auth"
+	"github.com/database-playground/backend-v2/internal/analytics"
 	"github.com/database-playground/backend-v2/internal/auth"
 	"github.com/database-playground/backend-v2/internal/config"
 	"github.com/database-playground/backend-v2/internal/deps"
 	"github.com/database-playground/backend-v2/internal/events"
 	"github.com/database-playground/backend-v2/internal/graphql/apq"
+	"github.com/database-playground/backend-v2/internal/graphql/audit"
+	"github.com/database-playground/backend-v2/internal/graphql/depth"
+	"github.com/database-playground/backend-v2/internal/graphql/idempotency"
+	"github.com/database-playground/backend-v2/internal/graphql/persistedquery"
 	"github.com/database-playground/backend-v2/internal/httputils"
+	"github.com/database-playground/backend-v2/internal/insights"
+	"github.com/database-playground/backend-v2/internal/plagiarism"
+	"github.com/database-playground/backend-v2/internal/proctoring"
 	"github.com/database-playground/backend-v2/internal/ranking"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	"github.com/database-playground/backend-v2/internal/scheduler"
 	"github.com/database-playground/backend-v2/internal/sqlrunner"
 	"github.com/database-playground/backend-v2/internal/submission"
 	"github.com/database-playground/backend-v2/internal/useraccount"
+	"github.com/database-playground/backend-v2/internal/webhook"
 	"github.com/database-playground/backend-v2/internal/workers"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -73,29 +85,78 @@ func ApqCache(redisClient rueidis.Client) graphql.Cache[string] {
 	return apq.NewCache(redisClient, 24*time.Hour)
 }
 
-func PostHogClient(lifecycle fx.Lifecycle, cfg config.BackendConfig) (posthog.Client, error) {
-	if cfg.PostHog.APIKey == nil || cfg.PostHog.Host == nil {
-		slog.Warn("PostHog client is not initialized, because you did not configure a PostHog API key and a host.")
-		return nil, nil
+// IdempotencyExtension creates the extension replaying the responses of the
+// mutations retried with the same Idempotency-Key for 24 hours.
+func IdempotencyExtension(redisClient rueidis.Client) *idempotency.Extension {
+	return idempotency.New(redisClient, 24*time.Hour)
+}
+
+// AnalyticsSink creates the analytics sinks configured in ANALYTICS_SINKS.
+//
+// If ANALYTICS_SINKS is not set, PostHog is used when it is configured.
+func AnalyticsSink(lifecycle fx.Lifecycle, cfg config.BackendConfig) (analytics.Sink, error) {
+	sinkNames := cfg.Analytics.Sinks
+	if len(sinkNames) == 0 && cfg.PostHog.APIKey != nil && cfg.PostHog.Host != nil {
+		sinkNames = []string{config.AnalyticsSinkPostHog}
+	}
+	if len(sinkNames) == 0 {
+		slog.Warn("No analytics sink is configured, so the analytics events are discarded.")
 	}
 
-	client, err := posthog.NewWithConfig(
-		*cfg.PostHog.APIKey,
-		posthog.Config{
-			Endpoint: *cfg.PostHog.Host,
-		},
-	)
-	if err != nil {
-		return nil, err
+	sinks := make([]analytics.Sink, 0, len(sinkNames))
+	// closeSinks closes the sinks created before a sink fails to be created.
+	closeSinks := func(err error) error {
+		return errors.Join(err, analytics.NewMultiSink(sinks...).Close())
 	}
+	for _, name := range sinkNames {
+		var (
+			sink   analytics.Sink
+			redact []string
+		)
+
+		switch name {
+		case config.AnalyticsSinkPostHog:
+			client, err := posthog.NewWithConfig(
+				*cfg.PostHog.APIKey,
+				posthog.Config{
+					Endpoint: *cfg.PostHog.Host,
+				},
+			)
+			if err != nil {
+				return nil, closeSinks(err)
+			}
+
+			sink = analytics.NewPostHogSink(client)
+			redact = cfg.Analytics.PostHog.Redact
+		case config.AnalyticsSinkFile:
+			fileSink, err := analytics.NewFileSink(cfg.Analytics.File.Path)
+			if err != nil {
+				return nil, closeSinks(err)
+			}
+
+			sink = fileSink
+			redact = cfg.Analytics.File.Redact
+		case config.AnalyticsSinkOTLP:
+			sink = analytics.NewOTLPSink()
+			redact = cfg.Analytics.OTLP.Redact
+		case config.AnalyticsSinkNoop:
+			sink = analytics.NoopSink{}
+		default:
+			return nil, closeSinks(fmt.Errorf("unknown analytics sink: %s", name))
+		}
+
+		sinks = append(sinks, analytics.Redact(sink, redact...))
+	}
+
+	sink := analytics.NewMultiSink(sinks...)
 
 	lifecycle.Append(fx.StopHook(func() {
-		if err := client.Close(); err != nil {
-			slog.Info("failed to close PostHog client", "error", err)
+		if err := sink.Close(); err != nil {
+			slog.Info("failed to close analytics sinks", "error", err)
 		}
 	}))
 
-	return client, nil
+	return sink, nil
 }
 
 // GqlgenHandler creates a gqlgen handler.
@@ -107,9 +168,13 @@ func GqlgenHandler(
 	eventService *events.EventService,
 	submissionService *submission.SubmissionService,
 	rankingService *ranking.Service,
+	insightsService *insights.Service,
+	proctoringReporter *proctoring.Reporter,
 	apqCache graphql.Cache[string],
+	idempotencyExtension *idempotency.Extension,
+	cfg config.BackendConfig,
 ) *handler.Server {
-	srv := handler.New(graph.NewSchema(entClient, storage, sqlrunner, useraccount, eventService, submissionService, rankingService))
+	srv := handler.New(graph.NewSchema(entClient, storage, sqlrunner, useraccount, eventService, submissionService, rankingService, insightsService, proctoringReporter))
 
 	srv.Use(otelgqlgen.Middleware())
 	srv.AddTransport(transport.Options{})
@@ -117,12 +182,32 @@ func GqlgenHandler(
 	srv.AddTransport(transport.POST{})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.AroundOperations(graph.NewLoadersMiddleware(entClient))
 
+	// the idempotent responses are stored after the transaction is committed.
+	srv.Use(idempotencyExtension)
 	srv.Use(entgql.Transactioner{TxOpener: entClient})
-	srv.Use(extension.Introspection{})
+	// the audit logs are written in the transaction of the mutations.
+	srv.Use(audit.New(entClient))
+	if gin.Mode() != gin.ReleaseMode {
+		srv.Use(extension.Introspection{})
+	}
+	// the persisted queries are resolved from the allowlist before the APQ cache.
+	if cfg.GraphQL.PersistedQueriesStrict {
+		srv.Use(persistedquery.Strict{
+			Allowlist: persistedquery.NewAllowlist(entClient),
+			Bypass:    graph.IsAdmin,
+		})
+	}
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: apqCache,
 	})
+	srv.Use(&extension.ComplexityLimit{
+		Func: graph.LimitFunc(cfg.GraphQL.ComplexityLimit, cfg.GraphQL.AdminComplexityLimit),
+	})
+	srv.Use(&depth.Limit{
+		Func: graph.LimitFunc(cfg.GraphQL.DepthLimit, cfg.GraphQL.AdminDepthLimit),
+	})
 
 	srv.SetErrorPresenter(graph.NewErrorPresenter())
 
@@ -134,19 +219,234 @@ func UserAccountContext(entClient *ent.Client, storage auth.Storage, eventServic
 	return useraccount.NewContext(entClient, storage, eventService)
 }
 
-// EventService creates an events.EventService.
-func EventService(entClient *ent.Client, posthogClient posthog.Client) *events.EventService {
-	return events.NewEventService(entClient, posthogClient)
+// InsightsService creates an insights.Service.
+func InsightsService(entClient *ent.Client) *insights.Service {
+	return insights.NewService(entClient)
 }
 
-// SubmissionService creates a submission.SubmissionService.
-func SubmissionService(entClient *ent.Client, eventService *events.EventService, sqlrunner *sqlrunner.SqlRunner) *submission.SubmissionService {
-	return submission.NewSubmissionService(entClient, eventService, sqlrunner)
+// EventService creates an events.EventService with the webhook dispatcher
+// and the proctoring escalator registered.
+func EventService(entClient *ent.Client, sink analytics.Sink, cfg config.BackendConfig) (*events.EventService, error) {
+	// The daily points are counted in the days of the rankings.
+	location, err := cfg.Ranking.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	eventService := events.NewEventService(entClient, sink, events.WithLocation(location))
+	eventService.RegisterHandler(webhook.HandlerName, webhook.NewDispatcher(entClient))
+	eventService.RegisterHandler(proctoring.HandlerName, proctoring.NewEscalator(entClient, []proctoring.Rule{
+		{Signal: events.ProctoringSignalTabSwitch, Threshold: cfg.Proctoring.TabSwitchThreshold, Window: cfg.Proctoring.Window, Kind: cheatrecord.KindTabSwitch},
+		{Signal: events.ProctoringSignalPaste, Threshold: cfg.Proctoring.PasteThreshold, Window: cfg.Proctoring.Window, Kind: cheatrecord.KindExamViolation},
+		{Signal: events.ProctoringSignalFocusLoss, Threshold: cfg.Proctoring.FocusLossThreshold, Window: cfg.Proctoring.Window, Kind: cheatrecord.KindExamViolation},
+	}))
+
+	return eventService, nil
 }
 
-// RankingService creates a ranking.Service.
-func RankingService(entClient *ent.Client) *ranking.Service {
-	return ranking.NewService(entClient)
+// EventDispatcher creates an events.Dispatcher.
+func EventDispatcher(eventService *events.EventService, cfg config.BackendConfig) *events.Dispatcher {
+	return events.NewDispatcher(
+		eventService,
+		events.WithWorkers(cfg.Events.Workers),
+		events.WithPollInterval(cfg.Events.PollInterval),
+		events.WithMaxAttempts(cfg.Events.MaxAttempts),
+	)
+}
+
+// EventDispatcherLifecycle runs the event dispatcher in the background.
+func EventDispatcherLifecycle(lifecycle fx.Lifecycle, dispatcher *events.Dispatcher) {
+	dispatcherCtx, cancel := context.WithCancel(context.Background())
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			workers.Global.Go(func() {
+				slog.Info("event dispatcher starting")
+				dispatcher.Run(dispatcherCtx)
+				slog.Info("event dispatcher stopped")
+			})
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// EventRetentionJobs creates the job applying the event retention policy,
+// if EVENTS_RETENTION is set.
+func EventRetentionJobs(eventService *events.EventService, cfg config.BackendConfig) ([]scheduler.Job, error) {
+	if cfg.Events.Retention == 0 {
+		return nil, nil
+	}
+
+	var archiver events.Archiver = events.TableArchiver{}
+	if cfg.Events.ArchiveDir != "" {
+		fileArchiver, err := events.NewFileArchiver(cfg.Events.ArchiveDir)
+		if err != nil {
+			return nil, err
+		}
+		archiver = fileArchiver
+	}
+
+	policy := events.RetentionPolicy{
+		Retention: cfg.Events.Retention,
+		Archiver:  archiver,
+	}
+
+	return []scheduler.Job{
+		{
+			Name:     "event_retention",
+			Schedule: cfg.Events.RetentionSchedule,
+			Run: func(ctx context.Context) error {
+				archived, err := eventService.ApplyRetention(ctx, policy)
+				if err != nil {
+					return err
+				}
+
+				slog.Info("archived expired events", "count", archived)
+				return nil
+			},
+		},
+	}, nil
+}
+
+// Scheduler creates a scheduler.Scheduler with the jobs registered.
+func Scheduler(entClient *ent.Client, redisClient rueidis.Client, jobs []scheduler.Job) (*scheduler.Scheduler, error) {
+	s := scheduler.NewScheduler(entClient, scheduler.NewRedisLocker(redisClient))
+	if err := s.Register(jobs...); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// SchedulerLifecycle runs the scheduler in the background, if SCHEDULER_ENABLED is set.
+func SchedulerLifecycle(lifecycle fx.Lifecycle, s *scheduler.Scheduler, cfg config.BackendConfig) {
+	if !cfg.Scheduler.Enabled {
+		slog.Info("scheduler is disabled on this instance")
+		return
+	}
+
+	schedulerCtx, cancel := context.WithCancel(context.Background())
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			workers.Global.Go(func() {
+				slog.Info("scheduler starting")
+				s.Run(schedulerCtx)
+				slog.Info("scheduler stopped")
+			})
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// SubmissionService creates a submission.SubmissionService, with the
+// submissions rate limited according to SUBMISSION_RATE_LIMIT_*.
+func SubmissionService(entClient *ent.Client, eventService *events.EventService, sqlrunner *sqlrunner.SqlRunner, redisClient rueidis.Client, cfg config.BackendConfig) *submission.SubmissionService {
+	limitCfg := cfg.SubmissionRateLimit
+
+	return submission.NewSubmissionService(entClient, eventService, sqlrunner,
+		submission.WithRateLimit(
+			ratelimit.NewLimiter(redisClient, "submission"),
+			ratelimit.Limit{Burst: limitCfg.UserBurst, Interval: limitCfg.UserInterval},
+			ratelimit.Limit{Burst: limitCfg.QuestionBurst, Interval: limitCfg.QuestionInterval},
+		),
+	)
+}
+
+// ProctoringReporter creates a proctoring.Reporter, with the signals rate
+// limited according to PROCTORING_RATE_LIMIT_*.
+func ProctoringReporter(eventService *events.EventService, redisClient rueidis.Client, cfg config.BackendConfig) *proctoring.Reporter {
+	return proctoring.NewReporter(eventService,
+		proctoring.WithRateLimit(
+			ratelimit.NewLimiter(redisClient, "proctoring"),
+			ratelimit.Limit{Burst: cfg.Proctoring.RateLimitBurst, Interval: cfg.Proctoring.RateLimitInterval},
+		),
+	)
+}
+
+// RankingService creates a ranking.Service, with the leaderboards kept in Redis
+// if RANKING_LEADERBOARD is set.
+func RankingService(entClient *ent.Client, redisClient rueidis.Client, cfg config.BackendConfig) (*ranking.Service, error) {
+	location, err := cfg.Ranking.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.Ranking.Leaderboard {
+		return ranking.NewService(entClient, ranking.WithLocation(location)), nil
+	}
+
+	rankingService := ranking.NewService(entClient,
+		ranking.WithLocation(location),
+		ranking.WithLeaderboard(redisClient, location),
+	)
+	rankingService.RegisterHooks()
+
+	return rankingService, nil
+}
+
+// RankingJobs creates the job recording the daily rank snapshots, and the job
+// rebuilding the leaderboards if RANKING_LEADERBOARD is set.
+func RankingJobs(rankingService *ranking.Service, cfg config.BackendConfig) []scheduler.Job {
+	jobs := []scheduler.Job{
+		{
+			Name:     "rank_snapshot",
+			Schedule: cfg.Ranking.SnapshotSchedule,
+			Run: func(ctx context.Context) error {
+				return rankingService.Snapshot(ctx, time.Now())
+			},
+		},
+	}
+
+	if cfg.Ranking.Leaderboard {
+		jobs = append(jobs, scheduler.Job{
+			Name:     "leaderboard_rebuild",
+			Schedule: cfg.Ranking.RebuildSchedule,
+			Run:      rankingService.Rebuild,
+		})
+	}
+
+	return jobs
+}
+
+// PlagiarismJobs creates the job detecting the plagiarism, if PLAGIARISM_ENABLED is set.
+func PlagiarismJobs(entClient *ent.Client, cfg config.BackendConfig) []scheduler.Job {
+	if !cfg.Plagiarism.Enabled {
+		return nil
+	}
+
+	detector := plagiarism.NewDetector(entClient,
+		plagiarism.WithLookback(cfg.Plagiarism.Lookback),
+		plagiarism.WithWindow(cfg.Plagiarism.Window),
+		plagiarism.WithMinTokens(cfg.Plagiarism.MinTokens),
+		plagiarism.WithMaxSharedUsers(cfg.Plagiarism.MaxSharedUsers),
+	)
+
+	return []scheduler.Job{
+		{
+			Name:     "plagiarism_detection",
+			Schedule: cfg.Plagiarism.Schedule,
+			Run: func(ctx context.Context) error {
+				created, err := detector.Detect(ctx, time.Now())
+				if err != nil {
+					return err
+				}
+
+				slog.Info("detected suspected plagiarism", "count", created)
+				return nil
+			},
+		},
+	}
 }
 
 // AuthService creates an auth service.
@@ -158,6 +458,7 @@ func AuthService(entClient *ent.Client, storage auth.Storage, config config.Back
 func GinEngine(
 	services []httpapi.Service,
 	authStorage auth.Storage,
+	redisClient rueidis.Client,
 	gqlgenHandler *handler.Server,
 	cfg config.BackendConfig,
 ) *gin.Engine {
@@ -180,8 +481,11 @@ func GinEngine(
 	engine.Use(cors.New(cors.Config{
 		AllowOrigins: cfg.AllowedOrigins,
 		AllowMethods: []string{"GET", "POST", "OPTIONS"},
-		AllowHeaders: []string{"Content-Type", "User-Agent", "Referer", "Authorization"},
-		MaxAge:       24 * time.Hour,
+		AllowHeaders: []string{"Content-Type", "User-Agent", "Referer", "Authorization", idempotency.HeaderName},
+		ExposeHeaders: []string{
+			ratelimit.HeaderLimit, ratelimit.HeaderRemaining, ratelimit.HeaderReset, ratelimit.HeaderRetryAfter,
+		},
+		MaxAge: 24 * time.Hour,
 	}))
 	engine.Use(sloggin.NewWithConfig(slog.Default(), sloggin.Config{
 		WithSpanID:    true,
@@ -196,17 +500,31 @@ func GinEngine(
 		c.Next()
 	})
 
+	limiter := ratelimit.NewLimiter(redisClient, "http")
+
 	router := engine.Group("/")
 	router.Use(auth.Middleware(authStorage))
 	router.GET("/", func(ctx *gin.Context) {
 		handler := playground.Handler("GraphQL playground", "/query")
 		handler.ServeHTTP(ctx.Writer, ctx.Request)
 	})
-	router.POST("/query", func(ctx *gin.Context) {
-		gqlgenHandler.ServeHTTP(ctx.Writer, ctx.Request)
-	})
+	router.POST("/query",
+		ratelimit.Middleware(limiter, "graphql",
+			ratelimit.Limit{Burst: cfg.RateLimit.GraphQLBurst, Interval: cfg.RateLimit.GraphQLInterval},
+			ratelimit.WithGraphQLResponse(),
+		),
+		func(ctx *gin.Context) {
+			gqlgenHandler.ServeHTTP(ctx.Writer, ctx.Request)
+		},
+	)
 
+	// The limiter keys /api by the signed-in user, so it needs auth.Middleware
+	// to resolve the user first; otherwise every request falls back to the client IP.
 	api := engine.Group("/api")
+	api.Use(auth.Middleware(authStorage))
+	api.Use(ratelimit.Middleware(limiter, "api",
+		ratelimit.Limit{Burst: cfg.RateLimit.APIBurst, Interval: cfg.RateLimit.APIInterval},
+	))
 	httpapi.Register(api, services...)
 
 	return engine
@@ -279,3 +597,11 @@ func AnnotateService(f any) any {
 		fx.ResultTags(`group:"services"`),
 	)
 }
+
+// AnnotateJobs annotates a function returning the scheduled jobs to be registered to the scheduler.
+func AnnotateJobs(f any) any {
+	return fx.Annotate(
+		f,
+		fx.ResultTags(`group:"jobs,flatten"`),
+	)
+}
@@ -25,16 +25,29 @@ func main() {
 			// Internal Services
 			AuthStorage,
 			EventService,
+			EventDispatcher,
 			UserAccountContext,
 			SubmissionService,
+			ProctoringReporter,
 			RankingService,
+			InsightsService,
 			AnnotateService(AuthService),
 
 			// Statistics
-			PostHogClient,
+			AnalyticsSink,
+
+			// Scheduled Jobs
+			fx.Annotate(
+				Scheduler,
+				fx.ParamTags(``, ``, `group:"jobs"`),
+			),
+			AnnotateJobs(EventRetentionJobs),
+			AnnotateJobs(RankingJobs),
+			AnnotateJobs(PlagiarismJobs),
 
 			// GraphQL
 			ApqCache,
+			IdempotencyExtension,
 			GqlgenHandler,
 
 			// HTTP
@@ -45,6 +58,10 @@ func main() {
 		),
 		fx.Invoke(deps.OTelSDK),
 		fx.Invoke(GinLifecycle),
+		// Registered after GinLifecycle so that they are stopped before
+		// GinLifecycle waits for the workers to finish.
+		fx.Invoke(EventDispatcherLifecycle),
+		fx.Invoke(SchedulerLifecycle),
 	)
 
 	app.Run()
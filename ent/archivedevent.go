@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+)
+
+// ArchivedEvent is the model entity for the ArchivedEvent schema.
+type ArchivedEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The ID of the original event
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// TriggeredAt holds the value of the "triggered_at" field.
+	TriggeredAt time.Time `json:"triggered_at,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt   time.Time `json:"archived_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchivedEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivedevent.FieldPayload:
+			values[i] = new([]byte)
+		case archivedevent.FieldID, archivedevent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case archivedevent.FieldType:
+			values[i] = new(sql.NullString)
+		case archivedevent.FieldTriggeredAt, archivedevent.FieldArchivedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchivedEvent fields.
+func (_m *ArchivedEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivedevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case archivedevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case archivedevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case archivedevent.FieldTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered_at", values[i])
+			} else if value.Valid {
+				_m.TriggeredAt = value.Time
+			}
+		case archivedevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case archivedevent.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchivedEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ArchivedEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ArchivedEvent.
+// Note that you need to call ArchivedEvent.Unwrap() before calling this method if this ArchivedEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ArchivedEvent) Update() *ArchivedEventUpdateOne {
+	return NewArchivedEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ArchivedEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ArchivedEvent) Unwrap() *ArchivedEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchivedEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ArchivedEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchivedEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("triggered_at=")
+	builder.WriteString(_m.TriggeredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("archived_at=")
+	builder.WriteString(_m.ArchivedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchivedEvents is a parsable slice of ArchivedEvent.
+type ArchivedEvents []*ArchivedEvent
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivedevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TriggeredAt applies equality check predicate on the "triggered_at" field. It's identical to TriggeredAtEQ.
+func TriggeredAt(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// TriggeredAtEQ applies the EQ predicate on the "triggered_at" field.
+func TriggeredAtEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtNEQ applies the NEQ predicate on the "triggered_at" field.
+func TriggeredAtNEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIn applies the In predicate on the "triggered_at" field.
+func TriggeredAtIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtNotIn applies the NotIn predicate on the "triggered_at" field.
+func TriggeredAtNotIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtGT applies the GT predicate on the "triggered_at" field.
+func TriggeredAtGT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtGTE applies the GTE predicate on the "triggered_at" field.
+func TriggeredAtGTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLT applies the LT predicate on the "triggered_at" field.
+func TriggeredAtLT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLTE applies the LTE predicate on the "triggered_at" field.
+func TriggeredAtLTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldTriggeredAt, v))
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIsNull(FieldPayload))
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotNull(FieldPayload))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+)
+
+// ArchivedEventCreate is the builder for creating a ArchivedEvent entity.
+type ArchivedEventCreate struct {
+	config
+	mutation *ArchivedEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ArchivedEventCreate) SetUserID(v int) *ArchivedEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ArchivedEventCreate) SetType(v string) *ArchivedEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_c *ArchivedEventCreate) SetTriggeredAt(v time.Time) *ArchivedEventCreate {
+	_c.mutation.SetTriggeredAt(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *ArchivedEventCreate) SetPayload(v map[string]interface{}) *ArchivedEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *ArchivedEventCreate) SetArchivedAt(v time.Time) *ArchivedEventCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *ArchivedEventCreate) SetNillableArchivedAt(v *time.Time) *ArchivedEventCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ArchivedEventCreate) SetID(v int) *ArchivedEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_c *ArchivedEventCreate) Mutation() *ArchivedEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ArchivedEvent in the database.
+func (_c *ArchivedEventCreate) Save(ctx context.Context) (*ArchivedEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArchivedEventCreate) SaveX(ctx context.Context) *ArchivedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchivedEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchivedEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ArchivedEventCreate) defaults() {
+	if _, ok := _c.mutation.ArchivedAt(); !ok {
+		v := archivedevent.DefaultArchivedAt()
+		_c.mutation.SetArchivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArchivedEventCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ArchivedEvent.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ArchivedEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TriggeredAt(); !ok {
+		return &ValidationError{Name: "triggered_at", err: errors.New(`ent: missing required field "ArchivedEvent.triggered_at"`)}
+	}
+	if _, ok := _c.mutation.ArchivedAt(); !ok {
+		return &ValidationError{Name: "archived_at", err: errors.New(`ent: missing required field "ArchivedEvent.archived_at"`)}
+	}
+	return nil
+}
+
+func (_c *ArchivedEventCreate) sqlSave(ctx context.Context) (*ArchivedEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArchivedEventCreate) createSpec() (*ArchivedEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchivedEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(archivedevent.Table, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(archivedevent.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.TriggeredAt(); ok {
+		_spec.SetField(archivedevent.FieldTriggeredAt, field.TypeTime, value)
+		_node.TriggeredAt = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(archivedevent.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = value
+	}
+	return _node, _spec
+}
+
+// ArchivedEventCreateBulk is the builder for creating many ArchivedEvent entities in bulk.
+type ArchivedEventCreateBulk struct {
+	config
+	err      error
+	builders []*ArchivedEventCreate
+}
+
+// Save creates the ArchivedEvent entities in the database.
+func (_c *ArchivedEventCreateBulk) Save(ctx context.Context) ([]*ArchivedEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArchivedEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchivedEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArchivedEventCreateBulk) SaveX(ctx context.Context) []*ArchivedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchivedEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchivedEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// ArchivedEventUpdate is the builder for updating ArchivedEvent entities.
+type ArchivedEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ArchivedEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ArchivedEventUpdate builder.
+func (_u *ArchivedEventUpdate) Where(ps ...predicate.ArchivedEvent) *ArchivedEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ArchivedEventUpdate) SetUserID(v int) *ArchivedEventUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableUserID(v *int) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ArchivedEventUpdate) AddUserID(v int) *ArchivedEventUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ArchivedEventUpdate) SetType(v string) *ArchivedEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableType(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ArchivedEventUpdate) SetTriggeredAt(v time.Time) *ArchivedEventUpdate {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableTriggeredAt(v *time.Time) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ArchivedEventUpdate) SetPayload(v map[string]interface{}) *ArchivedEventUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *ArchivedEventUpdate) ClearPayload() *ArchivedEventUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *ArchivedEventUpdate) SetArchivedAt(v time.Time) *ArchivedEventUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableArchivedAt(v *time.Time) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_u *ArchivedEventUpdate) Mutation() *ArchivedEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArchivedEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchivedEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArchivedEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchivedEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchivedEventUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArchivedEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArchivedEventUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArchivedEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedevent.Table, archivedevent.Columns, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(archivedevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(archivedevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(archivedevent.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(archivedevent.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(archivedevent.FieldArchivedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArchivedEventUpdateOne is the builder for updating a single ArchivedEvent entity.
+type ArchivedEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ArchivedEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ArchivedEventUpdateOne) SetUserID(v int) *ArchivedEventUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableUserID(v *int) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ArchivedEventUpdateOne) AddUserID(v int) *ArchivedEventUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ArchivedEventUpdateOne) SetType(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableType(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ArchivedEventUpdateOne) SetTriggeredAt(v time.Time) *ArchivedEventUpdateOne {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableTriggeredAt(v *time.Time) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ArchivedEventUpdateOne) SetPayload(v map[string]interface{}) *ArchivedEventUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *ArchivedEventUpdateOne) ClearPayload() *ArchivedEventUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *ArchivedEventUpdateOne) SetArchivedAt(v time.Time) *ArchivedEventUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableArchivedAt(v *time.Time) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_u *ArchivedEventUpdateOne) Mutation() *ArchivedEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ArchivedEventUpdate builder.
+func (_u *ArchivedEventUpdateOne) Where(ps ...predicate.ArchivedEvent) *ArchivedEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArchivedEventUpdateOne) Select(field string, fields ...string) *ArchivedEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArchivedEvent entity.
+func (_u *ArchivedEventUpdateOne) Save(ctx context.Context) (*ArchivedEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchivedEventUpdateOne) SaveX(ctx context.Context) *ArchivedEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArchivedEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchivedEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchivedEventUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArchivedEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArchivedEventUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArchivedEventUpdateOne) sqlSave(ctx context.Context) (_node *ArchivedEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedevent.Table, archivedevent.Columns, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchivedEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedevent.FieldID)
+		for _, f := range fields {
+			if !archivedevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archivedevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(archivedevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(archivedevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(archivedevent.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(archivedevent.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(archivedevent.FieldArchivedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ArchivedEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/models"
 )
 
 // CheatRecord is the model entity for the CheatRecord schema.
@@ -18,30 +20,43 @@ type CheatRecord struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// How the cheating is detected
+	Kind cheatrecord.Kind `json:"kind,omitempty"`
+	// The review state: open → under_review → confirmed / dismissed, or pending → confirmed / dismissed for the detected records
+	State cheatrecord.State `json:"state,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
 	// ResolvedReason holds the value of the "resolved_reason" field.
 	ResolvedReason string `json:"resolved_reason,omitempty"`
-	// ResolvedAt holds the value of the "resolved_at" field.
+	// When the record is moved to under_review
+	ReviewedAt time.Time `json:"reviewed_at,omitempty"`
+	// When the record is confirmed or dismissed
 	ResolvedAt time.Time `json:"resolved_at,omitempty"`
 	// CheatedAt holds the value of the "cheated_at" field.
 	CheatedAt time.Time `json:"cheated_at,omitempty"`
+	// Evidence holds the value of the "evidence" field.
+	Evidence *models.CheatEvidence `json:"evidence,omitempty"`
+	// DetectionKey holds the value of the "detection_key" field.
+	DetectionKey *string `json:"detection_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CheatRecordQuery when eager-loading is set.
-	Edges              CheatRecordEdges `json:"edges"`
-	user_cheat_records *int
-	selectValues       sql.SelectValues
+	Edges                 CheatRecordEdges `json:"edges"`
+	cheat_record_reporter *int
+	user_cheat_records    *int
+	selectValues          sql.SelectValues
 }
 
 // CheatRecordEdges holds the relations/edges for other nodes in the graph.
 type CheatRecordEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Reporter holds the value of the reporter edge.
+	Reporter *User `json:"reporter,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [2]map[string]int
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -55,18 +70,33 @@ func (e CheatRecordEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ReporterOrErr returns the Reporter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheatRecordEdges) ReporterOrErr() (*User, error) {
+	if e.Reporter != nil {
+		return e.Reporter, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reporter"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CheatRecord) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case cheatrecord.FieldEvidence:
+			values[i] = new([]byte)
 		case cheatrecord.FieldID:
 			values[i] = new(sql.NullInt64)
-		case cheatrecord.FieldReason, cheatrecord.FieldResolvedReason:
+		case cheatrecord.FieldKind, cheatrecord.FieldState, cheatrecord.FieldReason, cheatrecord.FieldResolvedReason, cheatrecord.FieldDetectionKey:
 			values[i] = new(sql.NullString)
-		case cheatrecord.FieldResolvedAt, cheatrecord.FieldCheatedAt:
+		case cheatrecord.FieldReviewedAt, cheatrecord.FieldResolvedAt, cheatrecord.FieldCheatedAt:
 			values[i] = new(sql.NullTime)
-		case cheatrecord.ForeignKeys[0]: // user_cheat_records
+		case cheatrecord.ForeignKeys[0]: // cheat_record_reporter
+			values[i] = new(sql.NullInt64)
+		case cheatrecord.ForeignKeys[1]: // user_cheat_records
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -89,6 +119,18 @@ func (_m *CheatRecord) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case cheatrecord.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = cheatrecord.Kind(value.String)
+			}
+		case cheatrecord.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = cheatrecord.State(value.String)
+			}
 		case cheatrecord.FieldReason:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field reason", values[i])
@@ -101,6 +143,12 @@ func (_m *CheatRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ResolvedReason = value.String
 			}
+		case cheatrecord.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = value.Time
+			}
 		case cheatrecord.FieldResolvedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
@@ -113,7 +161,29 @@ func (_m *CheatRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CheatedAt = value.Time
 			}
+		case cheatrecord.FieldEvidence:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field evidence", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Evidence); err != nil {
+					return fmt.Errorf("unmarshal field evidence: %w", err)
+				}
+			}
+		case cheatrecord.FieldDetectionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detection_key", values[i])
+			} else if value.Valid {
+				_m.DetectionKey = new(string)
+				*_m.DetectionKey = value.String
+			}
 		case cheatrecord.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field cheat_record_reporter", value)
+			} else if value.Valid {
+				_m.cheat_record_reporter = new(int)
+				*_m.cheat_record_reporter = int(value.Int64)
+			}
+		case cheatrecord.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_cheat_records", value)
 			} else if value.Valid {
@@ -138,6 +208,11 @@ func (_m *CheatRecord) QueryUser() *UserQuery {
 	return NewCheatRecordClient(_m.config).QueryUser(_m)
 }
 
+// QueryReporter queries the "reporter" edge of the CheatRecord entity.
+func (_m *CheatRecord) QueryReporter() *UserQuery {
+	return NewCheatRecordClient(_m.config).QueryReporter(_m)
+}
+
 // Update returns a builder for updating this CheatRecord.
 // Note that you need to call CheatRecord.Unwrap() before calling this method if this CheatRecord
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -161,17 +236,34 @@ func (_m *CheatRecord) String() string {
 	var builder strings.Builder
 	builder.WriteString("CheatRecord(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.State))
+	builder.WriteString(", ")
 	builder.WriteString("reason=")
 	builder.WriteString(_m.Reason)
 	builder.WriteString(", ")
 	builder.WriteString("resolved_reason=")
 	builder.WriteString(_m.ResolvedReason)
 	builder.WriteString(", ")
+	builder.WriteString("reviewed_at=")
+	builder.WriteString(_m.ReviewedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("resolved_at=")
 	builder.WriteString(_m.ResolvedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("cheated_at=")
 	builder.WriteString(_m.CheatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("evidence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Evidence))
+	builder.WriteString(", ")
+	if v := _m.DetectionKey; v != nil {
+		builder.WriteString("detection_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
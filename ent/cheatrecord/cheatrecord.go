@@ -3,6 +3,9 @@
 package cheatrecord
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -14,16 +17,28 @@ const (
 	Label = "cheat_record"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
 	// FieldResolvedReason holds the string denoting the resolved_reason field in the database.
 	FieldResolvedReason = "resolved_reason"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
 	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
 	FieldResolvedAt = "resolved_at"
 	// FieldCheatedAt holds the string denoting the cheated_at field in the database.
 	FieldCheatedAt = "cheated_at"
+	// FieldEvidence holds the string denoting the evidence field in the database.
+	FieldEvidence = "evidence"
+	// FieldDetectionKey holds the string denoting the detection_key field in the database.
+	FieldDetectionKey = "detection_key"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeReporter holds the string denoting the reporter edge name in mutations.
+	EdgeReporter = "reporter"
 	// Table holds the table name of the cheatrecord in the database.
 	Table = "cheat_records"
 	// UserTable is the table that holds the user relation/edge.
@@ -33,20 +48,33 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_cheat_records"
+	// ReporterTable is the table that holds the reporter relation/edge.
+	ReporterTable = "cheat_records"
+	// ReporterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReporterInverseTable = "users"
+	// ReporterColumn is the table column denoting the reporter relation/edge.
+	ReporterColumn = "cheat_record_reporter"
 )
 
 // Columns holds all SQL columns for cheatrecord fields.
 var Columns = []string{
 	FieldID,
+	FieldKind,
+	FieldState,
 	FieldReason,
 	FieldResolvedReason,
+	FieldReviewedAt,
 	FieldResolvedAt,
 	FieldCheatedAt,
+	FieldEvidence,
+	FieldDetectionKey,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "cheat_records"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"cheat_record_reporter",
 	"user_cheat_records",
 }
 
@@ -70,6 +98,63 @@ var (
 	DefaultCheatedAt func() time.Time
 )
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindManual is the default value of the Kind enum.
+const DefaultKind = KindManual
+
+// Kind values.
+const (
+	KindManual        Kind = "manual"
+	KindTabSwitch     Kind = "tab_switch"
+	KindPlagiarism    Kind = "plagiarism"
+	KindExamViolation Kind = "exam_violation"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindManual, KindTabSwitch, KindPlagiarism, KindExamViolation:
+		return nil
+	default:
+		return fmt.Errorf("cheatrecord: invalid enum value for kind field: %q", k)
+	}
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateOpen is the default value of the State enum.
+const DefaultState = StateOpen
+
+// State values.
+const (
+	StatePending     State = "pending"
+	StateOpen        State = "open"
+	StateUnderReview State = "under_review"
+	StateConfirmed   State = "confirmed"
+	StateDismissed   State = "dismissed"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StatePending, StateOpen, StateUnderReview, StateConfirmed, StateDismissed:
+		return nil
+	default:
+		return fmt.Errorf("cheatrecord: invalid enum value for state field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the CheatRecord queries.
 type OrderOption func(*sql.Selector)
 
@@ -78,6 +163,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
 // ByReason orders the results by the reason field.
 func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
@@ -88,6 +183,11 @@ func ByResolvedReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolvedReason, opts...).ToFunc()
 }
 
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
 // ByResolvedAt orders the results by the resolved_at field.
 func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
@@ -98,12 +198,24 @@ func ByCheatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCheatedAt, opts...).ToFunc()
 }
 
+// ByDetectionKey orders the results by the detection_key field.
+func ByDetectionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectionKey, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByReporterField orders the results by reporter field.
+func ByReporterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReporterStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -111,3 +223,46 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newReporterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReporterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReporterTable, ReporterColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Kind) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Kind) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Kind(str)
+	if err := KindValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Kind", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e State) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *State) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = State(str)
+	if err := StateValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid State", str)
+	}
+	return nil
+}
@@ -65,6 +65,11 @@ func ResolvedReason(v string) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldEQ(FieldResolvedReason, v))
 }
 
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldReviewedAt, v))
+}
+
 // ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
 func ResolvedAt(v time.Time) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldEQ(FieldResolvedAt, v))
@@ -75,6 +80,51 @@ func CheatedAt(v time.Time) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldEQ(FieldCheatedAt, v))
 }
 
+// DetectionKey applies equality check predicate on the "detection_key" field. It's identical to DetectionKeyEQ.
+func DetectionKey(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldDetectionKey, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotIn(FieldState, vs...))
+}
+
 // ReasonEQ applies the EQ predicate on the "reason" field.
 func ReasonEQ(v string) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldEQ(FieldReason, v))
@@ -215,6 +265,56 @@ func ResolvedReasonContainsFold(v string) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldContainsFold(FieldResolvedReason, v))
 }
 
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotNull(FieldReviewedAt))
+}
+
 // ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
 func ResolvedAtEQ(v time.Time) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldEQ(FieldResolvedAt, v))
@@ -305,6 +405,91 @@ func CheatedAtLTE(v time.Time) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.FieldLTE(FieldCheatedAt, v))
 }
 
+// EvidenceIsNil applies the IsNil predicate on the "evidence" field.
+func EvidenceIsNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIsNull(FieldEvidence))
+}
+
+// EvidenceNotNil applies the NotNil predicate on the "evidence" field.
+func EvidenceNotNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotNull(FieldEvidence))
+}
+
+// DetectionKeyEQ applies the EQ predicate on the "detection_key" field.
+func DetectionKeyEQ(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEQ(FieldDetectionKey, v))
+}
+
+// DetectionKeyNEQ applies the NEQ predicate on the "detection_key" field.
+func DetectionKeyNEQ(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNEQ(FieldDetectionKey, v))
+}
+
+// DetectionKeyIn applies the In predicate on the "detection_key" field.
+func DetectionKeyIn(vs ...string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIn(FieldDetectionKey, vs...))
+}
+
+// DetectionKeyNotIn applies the NotIn predicate on the "detection_key" field.
+func DetectionKeyNotIn(vs ...string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotIn(FieldDetectionKey, vs...))
+}
+
+// DetectionKeyGT applies the GT predicate on the "detection_key" field.
+func DetectionKeyGT(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldGT(FieldDetectionKey, v))
+}
+
+// DetectionKeyGTE applies the GTE predicate on the "detection_key" field.
+func DetectionKeyGTE(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldGTE(FieldDetectionKey, v))
+}
+
+// DetectionKeyLT applies the LT predicate on the "detection_key" field.
+func DetectionKeyLT(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldLT(FieldDetectionKey, v))
+}
+
+// DetectionKeyLTE applies the LTE predicate on the "detection_key" field.
+func DetectionKeyLTE(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldLTE(FieldDetectionKey, v))
+}
+
+// DetectionKeyContains applies the Contains predicate on the "detection_key" field.
+func DetectionKeyContains(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldContains(FieldDetectionKey, v))
+}
+
+// DetectionKeyHasPrefix applies the HasPrefix predicate on the "detection_key" field.
+func DetectionKeyHasPrefix(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldHasPrefix(FieldDetectionKey, v))
+}
+
+// DetectionKeyHasSuffix applies the HasSuffix predicate on the "detection_key" field.
+func DetectionKeyHasSuffix(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldHasSuffix(FieldDetectionKey, v))
+}
+
+// DetectionKeyIsNil applies the IsNil predicate on the "detection_key" field.
+func DetectionKeyIsNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldIsNull(FieldDetectionKey))
+}
+
+// DetectionKeyNotNil applies the NotNil predicate on the "detection_key" field.
+func DetectionKeyNotNil() predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldNotNull(FieldDetectionKey))
+}
+
+// DetectionKeyEqualFold applies the EqualFold predicate on the "detection_key" field.
+func DetectionKeyEqualFold(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldEqualFold(FieldDetectionKey, v))
+}
+
+// DetectionKeyContainsFold applies the ContainsFold predicate on the "detection_key" field.
+func DetectionKeyContainsFold(v string) predicate.CheatRecord {
+	return predicate.CheatRecord(sql.FieldContainsFold(FieldDetectionKey, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.CheatRecord {
 	return predicate.CheatRecord(func(s *sql.Selector) {
@@ -328,6 +513,29 @@ func HasUserWith(preds ...predicate.User) predicate.CheatRecord {
 	})
 }
 
+// HasReporter applies the HasEdge predicate on the "reporter" edge.
+func HasReporter() predicate.CheatRecord {
+	return predicate.CheatRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReporterTable, ReporterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReporterWith applies the HasEdge predicate on the "reporter" edge with a given conditions (other predicates).
+func HasReporterWith(preds ...predicate.User) predicate.CheatRecord {
+	return predicate.CheatRecord(func(s *sql.Selector) {
+		step := newReporterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CheatRecord) predicate.CheatRecord {
 	return predicate.CheatRecord(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/models"
 )
 
 // CheatRecordCreate is the builder for creating a CheatRecord entity.
@@ -21,6 +22,34 @@ type CheatRecordCreate struct {
 	hooks    []Hook
 }
 
+// SetKind sets the "kind" field.
+func (_c *CheatRecordCreate) SetKind(v cheatrecord.Kind) *CheatRecordCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *CheatRecordCreate) SetNillableKind(v *cheatrecord.Kind) *CheatRecordCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *CheatRecordCreate) SetState(v cheatrecord.State) *CheatRecordCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *CheatRecordCreate) SetNillableState(v *cheatrecord.State) *CheatRecordCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
 // SetReason sets the "reason" field.
 func (_c *CheatRecordCreate) SetReason(v string) *CheatRecordCreate {
 	_c.mutation.SetReason(v)
@@ -41,6 +70,20 @@ func (_c *CheatRecordCreate) SetNillableResolvedReason(v *string) *CheatRecordCr
 	return _c
 }
 
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *CheatRecordCreate) SetReviewedAt(v time.Time) *CheatRecordCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *CheatRecordCreate) SetNillableReviewedAt(v *time.Time) *CheatRecordCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_c *CheatRecordCreate) SetResolvedAt(v time.Time) *CheatRecordCreate {
 	_c.mutation.SetResolvedAt(v)
@@ -69,6 +112,26 @@ func (_c *CheatRecordCreate) SetNillableCheatedAt(v *time.Time) *CheatRecordCrea
 	return _c
 }
 
+// SetEvidence sets the "evidence" field.
+func (_c *CheatRecordCreate) SetEvidence(v *models.CheatEvidence) *CheatRecordCreate {
+	_c.mutation.SetEvidence(v)
+	return _c
+}
+
+// SetDetectionKey sets the "detection_key" field.
+func (_c *CheatRecordCreate) SetDetectionKey(v string) *CheatRecordCreate {
+	_c.mutation.SetDetectionKey(v)
+	return _c
+}
+
+// SetNillableDetectionKey sets the "detection_key" field if the given value is not nil.
+func (_c *CheatRecordCreate) SetNillableDetectionKey(v *string) *CheatRecordCreate {
+	if v != nil {
+		_c.SetDetectionKey(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *CheatRecordCreate) SetUserID(id int) *CheatRecordCreate {
 	_c.mutation.SetUserID(id)
@@ -80,6 +143,25 @@ func (_c *CheatRecordCreate) SetUser(v *User) *CheatRecordCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (_c *CheatRecordCreate) SetReporterID(id int) *CheatRecordCreate {
+	_c.mutation.SetReporterID(id)
+	return _c
+}
+
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_c *CheatRecordCreate) SetNillableReporterID(id *int) *CheatRecordCreate {
+	if id != nil {
+		_c = _c.SetReporterID(*id)
+	}
+	return _c
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (_c *CheatRecordCreate) SetReporter(v *User) *CheatRecordCreate {
+	return _c.SetReporterID(v.ID)
+}
+
 // Mutation returns the CheatRecordMutation object of the builder.
 func (_c *CheatRecordCreate) Mutation() *CheatRecordMutation {
 	return _c.mutation
@@ -115,6 +197,14 @@ func (_c *CheatRecordCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *CheatRecordCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := cheatrecord.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := cheatrecord.DefaultState
+		_c.mutation.SetState(v)
+	}
 	if _, ok := _c.mutation.CheatedAt(); !ok {
 		v := cheatrecord.DefaultCheatedAt()
 		_c.mutation.SetCheatedAt(v)
@@ -123,6 +213,22 @@ func (_c *CheatRecordCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *CheatRecordCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CheatRecord.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := cheatrecord.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "CheatRecord.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := cheatrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.state": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Reason(); !ok {
 		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "CheatRecord.reason"`)}
 	}
@@ -158,6 +264,14 @@ func (_c *CheatRecordCreate) createSpec() (*CheatRecord, *sqlgraph.CreateSpec) {
 		_node = &CheatRecord{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(cheatrecord.Table, sqlgraph.NewFieldSpec(cheatrecord.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(cheatrecord.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(cheatrecord.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
 	if value, ok := _c.mutation.Reason(); ok {
 		_spec.SetField(cheatrecord.FieldReason, field.TypeString, value)
 		_node.Reason = value
@@ -166,6 +280,10 @@ func (_c *CheatRecordCreate) createSpec() (*CheatRecord, *sqlgraph.CreateSpec) {
 		_spec.SetField(cheatrecord.FieldResolvedReason, field.TypeString, value)
 		_node.ResolvedReason = value
 	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(cheatrecord.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = value
+	}
 	if value, ok := _c.mutation.ResolvedAt(); ok {
 		_spec.SetField(cheatrecord.FieldResolvedAt, field.TypeTime, value)
 		_node.ResolvedAt = value
@@ -174,6 +292,14 @@ func (_c *CheatRecordCreate) createSpec() (*CheatRecord, *sqlgraph.CreateSpec) {
 		_spec.SetField(cheatrecord.FieldCheatedAt, field.TypeTime, value)
 		_node.CheatedAt = value
 	}
+	if value, ok := _c.mutation.Evidence(); ok {
+		_spec.SetField(cheatrecord.FieldEvidence, field.TypeJSON, value)
+		_node.Evidence = value
+	}
+	if value, ok := _c.mutation.DetectionKey(); ok {
+		_spec.SetField(cheatrecord.FieldDetectionKey, field.TypeString, value)
+		_node.DetectionKey = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -191,6 +317,23 @@ func (_c *CheatRecordCreate) createSpec() (*CheatRecord, *sqlgraph.CreateSpec) {
 		_node.user_cheat_records = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cheatrecord.ReporterTable,
+			Columns: []string{cheatrecord.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.cheat_record_reporter = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -19,14 +19,15 @@ import (
 // CheatRecordQuery is the builder for querying CheatRecord entities.
 type CheatRecordQuery struct {
 	config
-	ctx        *QueryContext
-	order      []cheatrecord.OrderOption
-	inters     []Interceptor
-	predicates []predicate.CheatRecord
-	withUser   *UserQuery
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
-	loadTotal  []func(context.Context, []*CheatRecord) error
+	ctx          *QueryContext
+	order        []cheatrecord.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.CheatRecord
+	withUser     *UserQuery
+	withReporter *UserQuery
+	withFKs      bool
+	loadTotal    []func(context.Context, []*CheatRecord) error
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +86,28 @@ func (_q *CheatRecordQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryReporter chains the current query on the "reporter" edge.
+func (_q *CheatRecordQuery) QueryReporter() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cheatrecord.Table, cheatrecord.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, cheatrecord.ReporterTable, cheatrecord.ReporterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CheatRecord entity from the query.
 // Returns a *NotFoundError when no CheatRecord was found.
 func (_q *CheatRecordQuery) First(ctx context.Context) (*CheatRecord, error) {
@@ -272,15 +295,17 @@ func (_q *CheatRecordQuery) Clone() *CheatRecordQuery {
 		return nil
 	}
 	return &CheatRecordQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]cheatrecord.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.CheatRecord{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]cheatrecord.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.CheatRecord{}, _q.predicates...),
+		withUser:     _q.withUser.Clone(),
+		withReporter: _q.withReporter.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -295,18 +320,29 @@ func (_q *CheatRecordQuery) WithUser(opts ...func(*UserQuery)) *CheatRecordQuery
 	return _q
 }
 
+// WithReporter tells the query-builder to eager-load the nodes that are connected to
+// the "reporter" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CheatRecordQuery) WithReporter(opts ...func(*UserQuery)) *CheatRecordQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReporter = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Reason string `json:"reason,omitempty"`
+//		Kind cheatrecord.Kind `json:"kind,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CheatRecord.Query().
-//		GroupBy(cheatrecord.FieldReason).
+//		GroupBy(cheatrecord.FieldKind).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *CheatRecordQuery) GroupBy(field string, fields ...string) *CheatRecordGroupBy {
@@ -324,11 +360,11 @@ func (_q *CheatRecordQuery) GroupBy(field string, fields ...string) *CheatRecord
 // Example:
 //
 //	var v []struct {
-//		Reason string `json:"reason,omitempty"`
+//		Kind cheatrecord.Kind `json:"kind,omitempty"`
 //	}
 //
 //	client.CheatRecord.Query().
-//		Select(cheatrecord.FieldReason).
+//		Select(cheatrecord.FieldKind).
 //		Scan(ctx, &v)
 func (_q *CheatRecordQuery) Select(fields ...string) *CheatRecordSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -374,11 +410,12 @@ func (_q *CheatRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*CheatRecord{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUser != nil,
+			_q.withReporter != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withUser != nil || _q.withReporter != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -411,6 +448,12 @@ func (_q *CheatRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withReporter; query != nil {
+		if err := _q.loadReporter(ctx, query, nodes, nil,
+			func(n *CheatRecord, e *User) { n.Edges.Reporter = e }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -451,6 +494,38 @@ func (_q *CheatRecordQuery) loadUser(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (_q *CheatRecordQuery) loadReporter(ctx context.Context, query *UserQuery, nodes []*CheatRecord, init func(*CheatRecord), assign func(*CheatRecord, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CheatRecord)
+	for i := range nodes {
+		if nodes[i].cheat_record_reporter == nil {
+			continue
+		}
+		fk := *nodes[i].cheat_record_reporter
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "cheat_record_reporter" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *CheatRecordQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -519,6 +594,9 @@ func (_q *CheatRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -536,6 +614,12 @@ func (_q *CheatRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CheatRecordQuery) Modify(modifiers ...func(s *sql.Selector)) *CheatRecordSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CheatRecordGroupBy is the group-by builder for CheatRecord entities.
 type CheatRecordGroupBy struct {
 	selector
@@ -625,3 +709,9 @@ func (_s *CheatRecordSelect) sqlScan(ctx context.Context, root *CheatRecordQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CheatRecordSelect) Modify(modifiers ...func(s *sql.Selector)) *CheatRecordSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -14,13 +14,15 @@ import (
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/predicate"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/models"
 )
 
 // CheatRecordUpdate is the builder for updating CheatRecord entities.
 type CheatRecordUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CheatRecordMutation
+	hooks     []Hook
+	mutation  *CheatRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CheatRecordUpdate builder.
@@ -29,6 +31,34 @@ func (_u *CheatRecordUpdate) Where(ps ...predicate.CheatRecord) *CheatRecordUpda
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *CheatRecordUpdate) SetKind(v cheatrecord.Kind) *CheatRecordUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CheatRecordUpdate) SetNillableKind(v *cheatrecord.Kind) *CheatRecordUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *CheatRecordUpdate) SetState(v cheatrecord.State) *CheatRecordUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *CheatRecordUpdate) SetNillableState(v *cheatrecord.State) *CheatRecordUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
 // SetReason sets the "reason" field.
 func (_u *CheatRecordUpdate) SetReason(v string) *CheatRecordUpdate {
 	_u.mutation.SetReason(v)
@@ -63,6 +93,26 @@ func (_u *CheatRecordUpdate) ClearResolvedReason() *CheatRecordUpdate {
 	return _u
 }
 
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CheatRecordUpdate) SetReviewedAt(v time.Time) *CheatRecordUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CheatRecordUpdate) SetNillableReviewedAt(v *time.Time) *CheatRecordUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CheatRecordUpdate) ClearReviewedAt() *CheatRecordUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_u *CheatRecordUpdate) SetResolvedAt(v time.Time) *CheatRecordUpdate {
 	_u.mutation.SetResolvedAt(v)
@@ -97,6 +147,38 @@ func (_u *CheatRecordUpdate) SetNillableCheatedAt(v *time.Time) *CheatRecordUpda
 	return _u
 }
 
+// SetEvidence sets the "evidence" field.
+func (_u *CheatRecordUpdate) SetEvidence(v *models.CheatEvidence) *CheatRecordUpdate {
+	_u.mutation.SetEvidence(v)
+	return _u
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (_u *CheatRecordUpdate) ClearEvidence() *CheatRecordUpdate {
+	_u.mutation.ClearEvidence()
+	return _u
+}
+
+// SetDetectionKey sets the "detection_key" field.
+func (_u *CheatRecordUpdate) SetDetectionKey(v string) *CheatRecordUpdate {
+	_u.mutation.SetDetectionKey(v)
+	return _u
+}
+
+// SetNillableDetectionKey sets the "detection_key" field if the given value is not nil.
+func (_u *CheatRecordUpdate) SetNillableDetectionKey(v *string) *CheatRecordUpdate {
+	if v != nil {
+		_u.SetDetectionKey(*v)
+	}
+	return _u
+}
+
+// ClearDetectionKey clears the value of the "detection_key" field.
+func (_u *CheatRecordUpdate) ClearDetectionKey() *CheatRecordUpdate {
+	_u.mutation.ClearDetectionKey()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *CheatRecordUpdate) SetUserID(id int) *CheatRecordUpdate {
 	_u.mutation.SetUserID(id)
@@ -108,6 +190,25 @@ func (_u *CheatRecordUpdate) SetUser(v *User) *CheatRecordUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (_u *CheatRecordUpdate) SetReporterID(id int) *CheatRecordUpdate {
+	_u.mutation.SetReporterID(id)
+	return _u
+}
+
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_u *CheatRecordUpdate) SetNillableReporterID(id *int) *CheatRecordUpdate {
+	if id != nil {
+		_u = _u.SetReporterID(*id)
+	}
+	return _u
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (_u *CheatRecordUpdate) SetReporter(v *User) *CheatRecordUpdate {
+	return _u.SetReporterID(v.ID)
+}
+
 // Mutation returns the CheatRecordMutation object of the builder.
 func (_u *CheatRecordUpdate) Mutation() *CheatRecordMutation {
 	return _u.mutation
@@ -119,6 +220,12 @@ func (_u *CheatRecordUpdate) ClearUser() *CheatRecordUpdate {
 	return _u
 }
 
+// ClearReporter clears the "reporter" edge to the User entity.
+func (_u *CheatRecordUpdate) ClearReporter() *CheatRecordUpdate {
+	_u.mutation.ClearReporter()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CheatRecordUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -148,12 +255,28 @@ func (_u *CheatRecordUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CheatRecordUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := cheatrecord.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := cheatrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.state": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "CheatRecord.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CheatRecordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CheatRecordUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -166,6 +289,12 @@ func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(cheatrecord.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(cheatrecord.FieldState, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Reason(); ok {
 		_spec.SetField(cheatrecord.FieldReason, field.TypeString, value)
 	}
@@ -175,6 +304,12 @@ func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ResolvedReasonCleared() {
 		_spec.ClearField(cheatrecord.FieldResolvedReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(cheatrecord.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(cheatrecord.FieldReviewedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ResolvedAt(); ok {
 		_spec.SetField(cheatrecord.FieldResolvedAt, field.TypeTime, value)
 	}
@@ -184,6 +319,18 @@ func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.CheatedAt(); ok {
 		_spec.SetField(cheatrecord.FieldCheatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Evidence(); ok {
+		_spec.SetField(cheatrecord.FieldEvidence, field.TypeJSON, value)
+	}
+	if _u.mutation.EvidenceCleared() {
+		_spec.ClearField(cheatrecord.FieldEvidence, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DetectionKey(); ok {
+		_spec.SetField(cheatrecord.FieldDetectionKey, field.TypeString, value)
+	}
+	if _u.mutation.DetectionKeyCleared() {
+		_spec.ClearField(cheatrecord.FieldDetectionKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -213,6 +360,36 @@ func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cheatrecord.ReporterTable,
+			Columns: []string{cheatrecord.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cheatrecord.ReporterTable,
+			Columns: []string{cheatrecord.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{cheatrecord.Label}
@@ -228,9 +405,38 @@ func (_u *CheatRecordUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // CheatRecordUpdateOne is the builder for updating a single CheatRecord entity.
 type CheatRecordUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CheatRecordMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CheatRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKind sets the "kind" field.
+func (_u *CheatRecordUpdateOne) SetKind(v cheatrecord.Kind) *CheatRecordUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CheatRecordUpdateOne) SetNillableKind(v *cheatrecord.Kind) *CheatRecordUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *CheatRecordUpdateOne) SetState(v cheatrecord.State) *CheatRecordUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *CheatRecordUpdateOne) SetNillableState(v *cheatrecord.State) *CheatRecordUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
 }
 
 // SetReason sets the "reason" field.
@@ -267,6 +473,26 @@ func (_u *CheatRecordUpdateOne) ClearResolvedReason() *CheatRecordUpdateOne {
 	return _u
 }
 
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CheatRecordUpdateOne) SetReviewedAt(v time.Time) *CheatRecordUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CheatRecordUpdateOne) SetNillableReviewedAt(v *time.Time) *CheatRecordUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CheatRecordUpdateOne) ClearReviewedAt() *CheatRecordUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_u *CheatRecordUpdateOne) SetResolvedAt(v time.Time) *CheatRecordUpdateOne {
 	_u.mutation.SetResolvedAt(v)
@@ -301,6 +527,38 @@ func (_u *CheatRecordUpdateOne) SetNillableCheatedAt(v *time.Time) *CheatRecordU
 	return _u
 }
 
+// SetEvidence sets the "evidence" field.
+func (_u *CheatRecordUpdateOne) SetEvidence(v *models.CheatEvidence) *CheatRecordUpdateOne {
+	_u.mutation.SetEvidence(v)
+	return _u
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (_u *CheatRecordUpdateOne) ClearEvidence() *CheatRecordUpdateOne {
+	_u.mutation.ClearEvidence()
+	return _u
+}
+
+// SetDetectionKey sets the "detection_key" field.
+func (_u *CheatRecordUpdateOne) SetDetectionKey(v string) *CheatRecordUpdateOne {
+	_u.mutation.SetDetectionKey(v)
+	return _u
+}
+
+// SetNillableDetectionKey sets the "detection_key" field if the given value is not nil.
+func (_u *CheatRecordUpdateOne) SetNillableDetectionKey(v *string) *CheatRecordUpdateOne {
+	if v != nil {
+		_u.SetDetectionKey(*v)
+	}
+	return _u
+}
+
+// ClearDetectionKey clears the value of the "detection_key" field.
+func (_u *CheatRecordUpdateOne) ClearDetectionKey() *CheatRecordUpdateOne {
+	_u.mutation.ClearDetectionKey()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *CheatRecordUpdateOne) SetUserID(id int) *CheatRecordUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -312,6 +570,25 @@ func (_u *CheatRecordUpdateOne) SetUser(v *User) *CheatRecordUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (_u *CheatRecordUpdateOne) SetReporterID(id int) *CheatRecordUpdateOne {
+	_u.mutation.SetReporterID(id)
+	return _u
+}
+
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_u *CheatRecordUpdateOne) SetNillableReporterID(id *int) *CheatRecordUpdateOne {
+	if id != nil {
+		_u = _u.SetReporterID(*id)
+	}
+	return _u
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (_u *CheatRecordUpdateOne) SetReporter(v *User) *CheatRecordUpdateOne {
+	return _u.SetReporterID(v.ID)
+}
+
 // Mutation returns the CheatRecordMutation object of the builder.
 func (_u *CheatRecordUpdateOne) Mutation() *CheatRecordMutation {
 	return _u.mutation
@@ -323,6 +600,12 @@ func (_u *CheatRecordUpdateOne) ClearUser() *CheatRecordUpdateOne {
 	return _u
 }
 
+// ClearReporter clears the "reporter" edge to the User entity.
+func (_u *CheatRecordUpdateOne) ClearReporter() *CheatRecordUpdateOne {
+	_u.mutation.ClearReporter()
+	return _u
+}
+
 // Where appends a list predicates to the CheatRecordUpdate builder.
 func (_u *CheatRecordUpdateOne) Where(ps ...predicate.CheatRecord) *CheatRecordUpdateOne {
 	_u.mutation.Where(ps...)
@@ -365,12 +648,28 @@ func (_u *CheatRecordUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CheatRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := cheatrecord.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := cheatrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CheatRecord.state": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "CheatRecord.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CheatRecordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CheatRecordUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CheatRecordUpdateOne) sqlSave(ctx context.Context) (_node *CheatRecord, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -400,6 +699,12 @@ func (_u *CheatRecordUpdateOne) sqlSave(ctx context.Context) (_node *CheatRecord
 			}
 		}
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(cheatrecord.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(cheatrecord.FieldState, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Reason(); ok {
 		_spec.SetField(cheatrecord.FieldReason, field.TypeString, value)
 	}
@@ -409,6 +714,12 @@ func (_u *CheatRecordUpdateOne) sqlSave(ctx context.Context) (_node *CheatRecord
 	if _u.mutation.ResolvedReasonCleared() {
 		_spec.ClearField(cheatrecord.FieldResolvedReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(cheatrecord.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(cheatrecord.FieldReviewedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ResolvedAt(); ok {
 		_spec.SetField(cheatrecord.FieldResolvedAt, field.TypeTime, value)
 	}
@@ -418,6 +729,18 @@ func (_u *CheatRecordUpdateOne) sqlSave(ctx context.Context) (_node *CheatRecord
 	if value, ok := _u.mutation.CheatedAt(); ok {
 		_spec.SetField(cheatrecord.FieldCheatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Evidence(); ok {
+		_spec.SetField(cheatrecord.FieldEvidence, field.TypeJSON, value)
+	}
+	if _u.mutation.EvidenceCleared() {
+		_spec.ClearField(cheatrecord.FieldEvidence, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DetectionKey(); ok {
+		_spec.SetField(cheatrecord.FieldDetectionKey, field.TypeString, value)
+	}
+	if _u.mutation.DetectionKeyCleared() {
+		_spec.ClearField(cheatrecord.FieldDetectionKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -447,6 +770,36 @@ func (_u *CheatRecordUpdateOne) sqlSave(ctx context.Context) (_node *CheatRecord
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cheatrecord.ReporterTable,
+			Columns: []string{cheatrecord.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cheatrecord.ReporterTable,
+			Columns: []string{cheatrecord.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CheatRecord{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -25,8 +25,8 @@ type DatabaseQuery struct {
 	inters             []Interceptor
 	predicates         []predicate.Database
 	withQuestions      *QuestionQuery
-	modifiers          []func(*sql.Selector)
 	loadTotal          []func(context.Context, []*Database) error
+	modifiers          []func(*sql.Selector)
 	withNamedQuestions map[string]*QuestionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -280,8 +280,9 @@ func (_q *DatabaseQuery) Clone() *DatabaseQuery {
 		predicates:    append([]predicate.Database{}, _q.predicates...),
 		withQuestions: _q.withQuestions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -520,6 +521,9 @@ func (_q *DatabaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -537,6 +541,12 @@ func (_q *DatabaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *DatabaseQuery) Modify(modifiers ...func(s *sql.Selector)) *DatabaseSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WithNamedQuestions tells the query-builder to eager-load the nodes that are connected to the "questions"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *DatabaseQuery) WithNamedQuestions(name string, opts ...func(*QuestionQuery)) *DatabaseQuery {
@@ -640,3 +650,9 @@ func (_s *DatabaseSelect) sqlScan(ctx context.Context, root *DatabaseQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *DatabaseSelect) Modify(modifiers ...func(s *sql.Selector)) *DatabaseSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
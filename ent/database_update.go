@@ -18,8 +18,9 @@ import (
 // DatabaseUpdate is the builder for updating Database entities.
 type DatabaseUpdate struct {
 	config
-	hooks    []Hook
-	mutation *DatabaseMutation
+	hooks     []Hook
+	mutation  *DatabaseMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the DatabaseUpdate builder.
@@ -159,6 +160,12 @@ func (_u *DatabaseUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatabaseUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatabaseUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DatabaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -228,6 +235,7 @@ func (_u *DatabaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{database.Label}
@@ -243,9 +251,10 @@ func (_u *DatabaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // DatabaseUpdateOne is the builder for updating a single Database entity.
 type DatabaseUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *DatabaseMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *DatabaseMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetDescription sets the "description" field.
@@ -392,6 +401,12 @@ func (_u *DatabaseUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DatabaseUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DatabaseUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DatabaseUpdateOne) sqlSave(ctx context.Context) (_node *Database, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -478,6 +493,7 @@ func (_u *DatabaseUpdateOne) sqlSave(ctx context.Context) (_node *Database, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Database{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,15 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+	"github.com/database-playground/backend-v2/ent/auditlog"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/database"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
 	"github.com/database-playground/backend-v2/ent/group"
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
 	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
 	"github.com/database-playground/backend-v2/ent/scopeset"
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/ent/webhooksubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			cheatrecord.Table: cheatrecord.ValidColumn,
-			database.Table:    database.ValidColumn,
-			event.Table:       event.ValidColumn,
-			group.Table:       group.ValidColumn,
-			point.Table:       point.ValidColumn,
-			question.Table:    question.ValidColumn,
-			scopeset.Table:    scopeset.ValidColumn,
-			submission.Table:  submission.ValidColumn,
-			user.Table:        user.ValidColumn,
+			archivedevent.Table:       archivedevent.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			cheatrecord.Table:         cheatrecord.ValidColumn,
+			database.Table:            database.ValidColumn,
+			event.Table:               event.ValidColumn,
+			eventdailycount.Table:     eventdailycount.ValidColumn,
+			eventoutbox.Table:         eventoutbox.ValidColumn,
+			group.Table:               group.ValidColumn,
+			jobrun.Table:              jobrun.ValidColumn,
+			persistedquery.Table:      persistedquery.ValidColumn,
+			point.Table:               point.ValidColumn,
+			question.Table:            question.ValidColumn,
+			ranksnapshot.Table:        ranksnapshot.ValidColumn,
+			scopeset.Table:            scopeset.ValidColumn,
+			submission.Table:          submission.ValidColumn,
+			user.Table:                user.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
+			webhooksubscription.Table: webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -26,6 +26,7 @@ func main() {
 		"intercept",
 		"schema/snapshot",
 		"sql/globalid",
+		"sql/modifier",
 	)); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
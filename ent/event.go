@@ -25,7 +25,7 @@ type Event struct {
 	Type string `json:"type,omitempty"`
 	// TriggeredAt holds the value of the "triggered_at" field.
 	TriggeredAt time.Time `json:"triggered_at,omitempty"`
-	// Payload holds the value of the "payload" field.
+	// The payload encoded from the typed payload of the event type
 	Payload map[string]interface{} `json:"payload,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EventQuery when eager-loading is set.
@@ -37,11 +37,15 @@ type Event struct {
 type EventEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Outbox holds the value of the outbox edge.
+	Outbox []*EventOutbox `json:"outbox,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 	// totalCount holds the count of the edges above.
 	totalCount [1]map[string]int
+
+	namedOutbox map[string][]*EventOutbox
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -55,6 +59,15 @@ func (e EventEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// OutboxOrErr returns the Outbox value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) OutboxOrErr() ([]*EventOutbox, error) {
+	if e.loadedTypes[1] {
+		return e.Outbox, nil
+	}
+	return nil, &NotLoadedError{edge: "outbox"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -133,6 +146,11 @@ func (_m *Event) QueryUser() *UserQuery {
 	return NewEventClient(_m.config).QueryUser(_m)
 }
 
+// QueryOutbox queries the "outbox" edge of the Event entity.
+func (_m *Event) QueryOutbox() *EventOutboxQuery {
+	return NewEventClient(_m.config).QueryOutbox(_m)
+}
+
 // Update returns a builder for updating this Event.
 // Note that you need to call Event.Unwrap() before calling this method if this Event
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,5 +189,29 @@ func (_m *Event) String() string {
 	return builder.String()
 }
 
+// NamedOutbox returns the Outbox named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Event) NamedOutbox(name string) ([]*EventOutbox, error) {
+	if _m.Edges.namedOutbox == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedOutbox[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Event) appendNamedOutbox(name string, edges ...*EventOutbox) {
+	if _m.Edges.namedOutbox == nil {
+		_m.Edges.namedOutbox = make(map[string][]*EventOutbox)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedOutbox[name] = []*EventOutbox{}
+	} else {
+		_m.Edges.namedOutbox[name] = append(_m.Edges.namedOutbox[name], edges...)
+	}
+}
+
 // Events is a parsable slice of Event.
 type Events []*Event
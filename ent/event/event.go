@@ -24,6 +24,8 @@ const (
 	FieldPayload = "payload"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeOutbox holds the string denoting the outbox edge name in mutations.
+	EdgeOutbox = "outbox"
 	// Table holds the table name of the event in the database.
 	Table = "events"
 	// UserTable is the table that holds the user relation/edge.
@@ -33,6 +35,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// OutboxTable is the table that holds the outbox relation/edge.
+	OutboxTable = "event_outboxes"
+	// OutboxInverseTable is the table name for the EventOutbox entity.
+	// It exists in this package in order to avoid circular dependency with the "eventoutbox" package.
+	OutboxInverseTable = "event_outboxes"
+	// OutboxColumn is the table column denoting the outbox relation/edge.
+	OutboxColumn = "event_id"
 )
 
 // Columns holds all SQL columns for event fields.
@@ -90,6 +99,20 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByOutboxCount orders the results by outbox count.
+func ByOutboxCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutboxStep(), opts...)
+	}
+}
+
+// ByOutbox orders the results by outbox terms.
+func ByOutbox(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutboxStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -97,3 +120,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newOutboxStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutboxInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OutboxTable, OutboxColumn),
+	)
+}
@@ -228,6 +228,29 @@ func HasUserWith(preds ...predicate.User) predicate.Event {
 	})
 }
 
+// HasOutbox applies the HasEdge predicate on the "outbox" edge.
+func HasOutbox() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OutboxTable, OutboxColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutboxWith applies the HasEdge predicate on the "outbox" edge with a given conditions (other predicates).
+func HasOutboxWith(preds ...predicate.EventOutbox) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newOutboxStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Event) predicate.Event {
 	return predicate.Event(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
 	"github.com/database-playground/backend-v2/ent/user"
 )
 
@@ -58,6 +59,21 @@ func (_c *EventCreate) SetUser(v *User) *EventCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddOutboxIDs adds the "outbox" edge to the EventOutbox entity by IDs.
+func (_c *EventCreate) AddOutboxIDs(ids ...int) *EventCreate {
+	_c.mutation.AddOutboxIDs(ids...)
+	return _c
+}
+
+// AddOutbox adds the "outbox" edges to the EventOutbox entity.
+func (_c *EventCreate) AddOutbox(v ...*EventOutbox) *EventCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOutboxIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_c *EventCreate) Mutation() *EventMutation {
 	return _c.mutation
@@ -173,6 +189,22 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OutboxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OutboxTable,
+			Columns: []string{event.OutboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoutbox.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
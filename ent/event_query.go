@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
 	"github.com/database-playground/backend-v2/ent/predicate"
 	"github.com/database-playground/backend-v2/ent/user"
 )
@@ -19,13 +21,15 @@ import (
 // EventQuery is the builder for querying Event entities.
 type EventQuery struct {
 	config
-	ctx        *QueryContext
-	order      []event.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Event
-	withUser   *UserQuery
-	modifiers  []func(*sql.Selector)
-	loadTotal  []func(context.Context, []*Event) error
+	ctx             *QueryContext
+	order           []event.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Event
+	withUser        *UserQuery
+	withOutbox      *EventOutboxQuery
+	loadTotal       []func(context.Context, []*Event) error
+	modifiers       []func(*sql.Selector)
+	withNamedOutbox map[string]*EventOutboxQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +88,28 @@ func (_q *EventQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryOutbox chains the current query on the "outbox" edge.
+func (_q *EventQuery) QueryOutbox() *EventOutboxQuery {
+	query := (&EventOutboxClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(eventoutbox.Table, eventoutbox.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.OutboxTable, event.OutboxColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Event entity from the query.
 // Returns a *NotFoundError when no Event was found.
 func (_q *EventQuery) First(ctx context.Context) (*Event, error) {
@@ -277,9 +303,11 @@ func (_q *EventQuery) Clone() *EventQuery {
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.Event{}, _q.predicates...),
 		withUser:   _q.withUser.Clone(),
+		withOutbox: _q.withOutbox.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -294,6 +322,17 @@ func (_q *EventQuery) WithUser(opts ...func(*UserQuery)) *EventQuery {
 	return _q
 }
 
+// WithOutbox tells the query-builder to eager-load the nodes that are connected to
+// the "outbox" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithOutbox(opts ...func(*EventOutboxQuery)) *EventQuery {
+	query := (&EventOutboxClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOutbox = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +411,9 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 	var (
 		nodes       = []*Event{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUser != nil,
+			_q.withOutbox != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +443,20 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := _q.withOutbox; query != nil {
+		if err := _q.loadOutbox(ctx, query, nodes,
+			func(n *Event) { n.Edges.Outbox = []*EventOutbox{} },
+			func(n *Event, e *EventOutbox) { n.Edges.Outbox = append(n.Edges.Outbox, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedOutbox {
+		if err := _q.loadOutbox(ctx, query, nodes,
+			func(n *Event) { n.appendNamedOutbox(name) },
+			func(n *Event, e *EventOutbox) { n.appendNamedOutbox(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -440,6 +494,36 @@ func (_q *EventQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*E
 	}
 	return nil
 }
+func (_q *EventQuery) loadOutbox(ctx context.Context, query *EventOutboxQuery, nodes []*Event, init func(*Event), assign func(*Event, *EventOutbox)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(eventoutbox.FieldEventID)
+	}
+	query.Where(predicate.EventOutbox(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.OutboxColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EventID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -511,6 +595,9 @@ func (_q *EventQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -528,6 +615,26 @@ func (_q *EventQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EventQuery) Modify(modifiers ...func(s *sql.Selector)) *EventSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// WithNamedOutbox tells the query-builder to eager-load the nodes that are connected to the "outbox"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithNamedOutbox(name string, opts ...func(*EventOutboxQuery)) *EventQuery {
+	query := (&EventOutboxClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedOutbox == nil {
+		_q.withNamedOutbox = make(map[string]*EventOutboxQuery)
+	}
+	_q.withNamedOutbox[name] = query
+	return _q
+}
+
 // EventGroupBy is the group-by builder for Event entities.
 type EventGroupBy struct {
 	selector
@@ -617,3 +724,9 @@ func (_s *EventSelect) sqlScan(ctx context.Context, root *EventQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EventSelect) Modify(modifiers ...func(s *sql.Selector)) *EventSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventdailycount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldUserID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldType, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldDate, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldCount, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLTE(FieldUserID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldContainsFold(FieldType, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLTE(FieldDate, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.FieldLTE(FieldCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventDailyCount) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventDailyCount) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventDailyCount) predicate.EventDailyCount {
+	return predicate.EventDailyCount(sql.NotPredicates(p))
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+)
+
+// EventDailyCountCreate is the builder for creating a EventDailyCount entity.
+type EventDailyCountCreate struct {
+	config
+	mutation *EventDailyCountMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EventDailyCountCreate) SetUserID(v int) *EventDailyCountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *EventDailyCountCreate) SetType(v string) *EventDailyCountCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *EventDailyCountCreate) SetDate(v time.Time) *EventDailyCountCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *EventDailyCountCreate) SetCount(v int) *EventDailyCountCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// Mutation returns the EventDailyCountMutation object of the builder.
+func (_c *EventDailyCountCreate) Mutation() *EventDailyCountMutation {
+	return _c.mutation
+}
+
+// Save creates the EventDailyCount in the database.
+func (_c *EventDailyCountCreate) Save(ctx context.Context) (*EventDailyCount, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventDailyCountCreate) SaveX(ctx context.Context) *EventDailyCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventDailyCountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventDailyCountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventDailyCountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EventDailyCount.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "EventDailyCount.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := eventdailycount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "EventDailyCount.date"`)}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "EventDailyCount.count"`)}
+	}
+	if v, ok := _c.mutation.Count(); ok {
+		if err := eventdailycount.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EventDailyCountCreate) sqlSave(ctx context.Context) (*EventDailyCount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventDailyCountCreate) createSpec() (*EventDailyCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventDailyCount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventdailycount.Table, sqlgraph.NewFieldSpec(eventdailycount.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(eventdailycount.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(eventdailycount.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(eventdailycount.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(eventdailycount.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	return _node, _spec
+}
+
+// EventDailyCountCreateBulk is the builder for creating many EventDailyCount entities in bulk.
+type EventDailyCountCreateBulk struct {
+	config
+	err      error
+	builders []*EventDailyCountCreate
+}
+
+// Save creates the EventDailyCount entities in the database.
+func (_c *EventDailyCountCreateBulk) Save(ctx context.Context) ([]*EventDailyCount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventDailyCount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventDailyCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventDailyCountCreateBulk) SaveX(ctx context.Context) []*EventDailyCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventDailyCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventDailyCountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// EventDailyCountUpdate is the builder for updating EventDailyCount entities.
+type EventDailyCountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EventDailyCountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EventDailyCountUpdate builder.
+func (_u *EventDailyCountUpdate) Where(ps ...predicate.EventDailyCount) *EventDailyCountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EventDailyCountUpdate) SetUserID(v int) *EventDailyCountUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EventDailyCountUpdate) SetNillableUserID(v *int) *EventDailyCountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *EventDailyCountUpdate) AddUserID(v int) *EventDailyCountUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *EventDailyCountUpdate) SetType(v string) *EventDailyCountUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *EventDailyCountUpdate) SetNillableType(v *string) *EventDailyCountUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EventDailyCountUpdate) SetDate(v time.Time) *EventDailyCountUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EventDailyCountUpdate) SetNillableDate(v *time.Time) *EventDailyCountUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *EventDailyCountUpdate) SetCount(v int) *EventDailyCountUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *EventDailyCountUpdate) SetNillableCount(v *int) *EventDailyCountUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *EventDailyCountUpdate) AddCount(v int) *EventDailyCountUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// Mutation returns the EventDailyCountMutation object of the builder.
+func (_u *EventDailyCountUpdate) Mutation() *EventDailyCountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventDailyCountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventDailyCountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventDailyCountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventDailyCountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventDailyCountUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := eventdailycount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := eventdailycount.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventDailyCountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventDailyCountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventDailyCountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventdailycount.Table, eventdailycount.Columns, sqlgraph.NewFieldSpec(eventdailycount.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(eventdailycount.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(eventdailycount.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(eventdailycount.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(eventdailycount.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(eventdailycount.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(eventdailycount.FieldCount, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventdailycount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventDailyCountUpdateOne is the builder for updating a single EventDailyCount entity.
+type EventDailyCountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EventDailyCountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EventDailyCountUpdateOne) SetUserID(v int) *EventDailyCountUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EventDailyCountUpdateOne) SetNillableUserID(v *int) *EventDailyCountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *EventDailyCountUpdateOne) AddUserID(v int) *EventDailyCountUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *EventDailyCountUpdateOne) SetType(v string) *EventDailyCountUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *EventDailyCountUpdateOne) SetNillableType(v *string) *EventDailyCountUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EventDailyCountUpdateOne) SetDate(v time.Time) *EventDailyCountUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EventDailyCountUpdateOne) SetNillableDate(v *time.Time) *EventDailyCountUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *EventDailyCountUpdateOne) SetCount(v int) *EventDailyCountUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *EventDailyCountUpdateOne) SetNillableCount(v *int) *EventDailyCountUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *EventDailyCountUpdateOne) AddCount(v int) *EventDailyCountUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// Mutation returns the EventDailyCountMutation object of the builder.
+func (_u *EventDailyCountUpdateOne) Mutation() *EventDailyCountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventDailyCountUpdate builder.
+func (_u *EventDailyCountUpdateOne) Where(ps ...predicate.EventDailyCount) *EventDailyCountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventDailyCountUpdateOne) Select(field string, fields ...string) *EventDailyCountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventDailyCount entity.
+func (_u *EventDailyCountUpdateOne) Save(ctx context.Context) (*EventDailyCount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventDailyCountUpdateOne) SaveX(ctx context.Context) *EventDailyCount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventDailyCountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventDailyCountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventDailyCountUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := eventdailycount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := eventdailycount.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "EventDailyCount.count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventDailyCountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventDailyCountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventDailyCountUpdateOne) sqlSave(ctx context.Context) (_node *EventDailyCount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventdailycount.Table, eventdailycount.Columns, sqlgraph.NewFieldSpec(eventdailycount.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventDailyCount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventdailycount.FieldID)
+		for _, f := range fields {
+			if !eventdailycount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventdailycount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(eventdailycount.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(eventdailycount.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(eventdailycount.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(eventdailycount.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(eventdailycount.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(eventdailycount.FieldCount, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EventDailyCount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventdailycount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventoutbox
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldEventID, v))
+}
+
+// Handler applies equality check predicate on the "handler" field. It's identical to HandlerEQ.
+func Handler(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldHandler, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
+func ProcessedAt(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// HandlerEQ applies the EQ predicate on the "handler" field.
+func HandlerEQ(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldHandler, v))
+}
+
+// HandlerNEQ applies the NEQ predicate on the "handler" field.
+func HandlerNEQ(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldHandler, v))
+}
+
+// HandlerIn applies the In predicate on the "handler" field.
+func HandlerIn(vs ...string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldHandler, vs...))
+}
+
+// HandlerNotIn applies the NotIn predicate on the "handler" field.
+func HandlerNotIn(vs ...string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldHandler, vs...))
+}
+
+// HandlerGT applies the GT predicate on the "handler" field.
+func HandlerGT(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldHandler, v))
+}
+
+// HandlerGTE applies the GTE predicate on the "handler" field.
+func HandlerGTE(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldHandler, v))
+}
+
+// HandlerLT applies the LT predicate on the "handler" field.
+func HandlerLT(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldHandler, v))
+}
+
+// HandlerLTE applies the LTE predicate on the "handler" field.
+func HandlerLTE(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldHandler, v))
+}
+
+// HandlerContains applies the Contains predicate on the "handler" field.
+func HandlerContains(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldContains(FieldHandler, v))
+}
+
+// HandlerHasPrefix applies the HasPrefix predicate on the "handler" field.
+func HandlerHasPrefix(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldHasPrefix(FieldHandler, v))
+}
+
+// HandlerHasSuffix applies the HasSuffix predicate on the "handler" field.
+func HandlerHasSuffix(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldHasSuffix(FieldHandler, v))
+}
+
+// HandlerEqualFold applies the EqualFold predicate on the "handler" field.
+func HandlerEqualFold(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEqualFold(FieldHandler, v))
+}
+
+// HandlerContainsFold applies the ContainsFold predicate on the "handler" field.
+func HandlerContainsFold(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldContainsFold(FieldHandler, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
+func ProcessedAtEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtNEQ applies the NEQ predicate on the "processed_at" field.
+func ProcessedAtNEQ(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtIn applies the In predicate on the "processed_at" field.
+func ProcessedAtIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtNotIn applies the NotIn predicate on the "processed_at" field.
+func ProcessedAtNotIn(vs ...time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtGT applies the GT predicate on the "processed_at" field.
+func ProcessedAtGT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGT(FieldProcessedAt, v))
+}
+
+// ProcessedAtGTE applies the GTE predicate on the "processed_at" field.
+func ProcessedAtGTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldGTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtLT applies the LT predicate on the "processed_at" field.
+func ProcessedAtLT(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLT(FieldProcessedAt, v))
+}
+
+// ProcessedAtLTE applies the LTE predicate on the "processed_at" field.
+func ProcessedAtLTE(v time.Time) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldLTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtIsNil applies the IsNil predicate on the "processed_at" field.
+func ProcessedAtIsNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldIsNull(FieldProcessedAt))
+}
+
+// ProcessedAtNotNil applies the NotNil predicate on the "processed_at" field.
+func ProcessedAtNotNil() predicate.EventOutbox {
+	return predicate.EventOutbox(sql.FieldNotNull(FieldProcessedAt))
+}
+
+// HasEvent applies the HasEdge predicate on the "event" edge.
+func HasEvent() predicate.EventOutbox {
+	return predicate.EventOutbox(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EventTable, EventColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventWith applies the HasEdge predicate on the "event" edge with a given conditions (other predicates).
+func HasEventWith(preds ...predicate.Event) predicate.EventOutbox {
+	return predicate.EventOutbox(func(s *sql.Selector) {
+		step := newEventStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventOutbox) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventOutbox) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventOutbox) predicate.EventOutbox {
+	return predicate.EventOutbox(sql.NotPredicates(p))
+}
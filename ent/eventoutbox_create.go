@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+)
+
+// EventOutboxCreate is the builder for creating a EventOutbox entity.
+type EventOutboxCreate struct {
+	config
+	mutation *EventOutboxMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventOutboxCreate) SetEventID(v int) *EventOutboxCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetHandler sets the "handler" field.
+func (_c *EventOutboxCreate) SetHandler(v string) *EventOutboxCreate {
+	_c.mutation.SetHandler(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EventOutboxCreate) SetStatus(v eventoutbox.Status) *EventOutboxCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableStatus(v *eventoutbox.Status) *EventOutboxCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EventOutboxCreate) SetAttempts(v int) *EventOutboxCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableAttempts(v *int) *EventOutboxCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *EventOutboxCreate) SetNextAttemptAt(v time.Time) *EventOutboxCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableNextAttemptAt(v *time.Time) *EventOutboxCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *EventOutboxCreate) SetLockedUntil(v time.Time) *EventOutboxCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableLockedUntil(v *time.Time) *EventOutboxCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *EventOutboxCreate) SetLastError(v string) *EventOutboxCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableLastError(v *string) *EventOutboxCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventOutboxCreate) SetCreatedAt(v time.Time) *EventOutboxCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableCreatedAt(v *time.Time) *EventOutboxCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *EventOutboxCreate) SetProcessedAt(v time.Time) *EventOutboxCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *EventOutboxCreate) SetNillableProcessedAt(v *time.Time) *EventOutboxCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_c *EventOutboxCreate) SetEvent(v *Event) *EventOutboxCreate {
+	return _c.SetEventID(v.ID)
+}
+
+// Mutation returns the EventOutboxMutation object of the builder.
+func (_c *EventOutboxCreate) Mutation() *EventOutboxMutation {
+	return _c.mutation
+}
+
+// Save creates the EventOutbox in the database.
+func (_c *EventOutboxCreate) Save(ctx context.Context) (*EventOutbox, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventOutboxCreate) SaveX(ctx context.Context) *EventOutbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventOutboxCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventOutboxCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventOutboxCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := eventoutbox.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := eventoutbox.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := eventoutbox.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventoutbox.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventOutboxCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventOutbox.event_id"`)}
+	}
+	if _, ok := _c.mutation.Handler(); !ok {
+		return &ValidationError{Name: "handler", err: errors.New(`ent: missing required field "EventOutbox.handler"`)}
+	}
+	if v, ok := _c.mutation.Handler(); ok {
+		if err := eventoutbox.HandlerValidator(v); err != nil {
+			return &ValidationError{Name: "handler", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.handler": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventOutbox.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := eventoutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EventOutbox.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "EventOutbox.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventOutbox.created_at"`)}
+	}
+	if len(_c.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "EventOutbox.event"`)}
+	}
+	return nil
+}
+
+func (_c *EventOutboxCreate) sqlSave(ctx context.Context) (*EventOutbox, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventOutboxCreate) createSpec() (*EventOutbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventOutbox{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventoutbox.Table, sqlgraph.NewFieldSpec(eventoutbox.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Handler(); ok {
+		_spec.SetField(eventoutbox.FieldHandler, field.TypeString, value)
+		_node.Handler = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(eventoutbox.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(eventoutbox.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventoutbox.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(eventoutbox.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(eventoutbox.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventoutbox.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(eventoutbox.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	if nodes := _c.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoutbox.EventTable,
+			Columns: []string{eventoutbox.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventOutboxCreateBulk is the builder for creating many EventOutbox entities in bulk.
+type EventOutboxCreateBulk struct {
+	config
+	err      error
+	builders []*EventOutboxCreate
+}
+
+// Save creates the EventOutbox entities in the database.
+func (_c *EventOutboxCreateBulk) Save(ctx context.Context) ([]*EventOutbox, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventOutbox, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventOutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventOutboxCreateBulk) SaveX(ctx context.Context) []*EventOutbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventOutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventOutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// EventOutboxUpdate is the builder for updating EventOutbox entities.
+type EventOutboxUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EventOutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EventOutboxUpdate builder.
+func (_u *EventOutboxUpdate) Where(ps ...predicate.EventOutbox) *EventOutboxUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventOutboxUpdate) SetEventID(v int) *EventOutboxUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableEventID(v *int) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetHandler sets the "handler" field.
+func (_u *EventOutboxUpdate) SetHandler(v string) *EventOutboxUpdate {
+	_u.mutation.SetHandler(v)
+	return _u
+}
+
+// SetNillableHandler sets the "handler" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableHandler(v *string) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetHandler(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventOutboxUpdate) SetStatus(v eventoutbox.Status) *EventOutboxUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableStatus(v *eventoutbox.Status) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventOutboxUpdate) SetAttempts(v int) *EventOutboxUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableAttempts(v *int) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventOutboxUpdate) AddAttempts(v int) *EventOutboxUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EventOutboxUpdate) SetNextAttemptAt(v time.Time) *EventOutboxUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableNextAttemptAt(v *time.Time) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *EventOutboxUpdate) SetLockedUntil(v time.Time) *EventOutboxUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableLockedUntil(v *time.Time) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *EventOutboxUpdate) ClearLockedUntil() *EventOutboxUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EventOutboxUpdate) SetLastError(v string) *EventOutboxUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableLastError(v *string) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EventOutboxUpdate) ClearLastError() *EventOutboxUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *EventOutboxUpdate) SetProcessedAt(v time.Time) *EventOutboxUpdate {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *EventOutboxUpdate) SetNillableProcessedAt(v *time.Time) *EventOutboxUpdate {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *EventOutboxUpdate) ClearProcessedAt() *EventOutboxUpdate {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_u *EventOutboxUpdate) SetEvent(v *Event) *EventOutboxUpdate {
+	return _u.SetEventID(v.ID)
+}
+
+// Mutation returns the EventOutboxMutation object of the builder.
+func (_u *EventOutboxUpdate) Mutation() *EventOutboxMutation {
+	return _u.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (_u *EventOutboxUpdate) ClearEvent() *EventOutboxUpdate {
+	_u.mutation.ClearEvent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventOutboxUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventOutboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventOutboxUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventOutboxUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventOutboxUpdate) check() error {
+	if v, ok := _u.mutation.Handler(); ok {
+		if err := eventoutbox.HandlerValidator(v); err != nil {
+			return &ValidationError{Name: "handler", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.handler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventoutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.status": %w`, err)}
+		}
+	}
+	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventOutbox.event"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventOutboxUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventOutboxUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventOutboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventoutbox.Table, eventoutbox.Columns, sqlgraph.NewFieldSpec(eventoutbox.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Handler(); ok {
+		_spec.SetField(eventoutbox.FieldHandler, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventoutbox.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventoutbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(eventoutbox.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(eventoutbox.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(eventoutbox.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(eventoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(eventoutbox.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(eventoutbox.FieldProcessedAt, field.TypeTime)
+	}
+	if _u.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoutbox.EventTable,
+			Columns: []string{eventoutbox.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoutbox.EventTable,
+			Columns: []string{eventoutbox.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventOutboxUpdateOne is the builder for updating a single EventOutbox entity.
+type EventOutboxUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EventOutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *EventOutboxUpdateOne) SetEventID(v int) *EventOutboxUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableEventID(v *int) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetHandler sets the "handler" field.
+func (_u *EventOutboxUpdateOne) SetHandler(v string) *EventOutboxUpdateOne {
+	_u.mutation.SetHandler(v)
+	return _u
+}
+
+// SetNillableHandler sets the "handler" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableHandler(v *string) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetHandler(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EventOutboxUpdateOne) SetStatus(v eventoutbox.Status) *EventOutboxUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableStatus(v *eventoutbox.Status) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventOutboxUpdateOne) SetAttempts(v int) *EventOutboxUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableAttempts(v *int) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventOutboxUpdateOne) AddAttempts(v int) *EventOutboxUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EventOutboxUpdateOne) SetNextAttemptAt(v time.Time) *EventOutboxUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableNextAttemptAt(v *time.Time) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *EventOutboxUpdateOne) SetLockedUntil(v time.Time) *EventOutboxUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableLockedUntil(v *time.Time) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *EventOutboxUpdateOne) ClearLockedUntil() *EventOutboxUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EventOutboxUpdateOne) SetLastError(v string) *EventOutboxUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableLastError(v *string) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EventOutboxUpdateOne) ClearLastError() *EventOutboxUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *EventOutboxUpdateOne) SetProcessedAt(v time.Time) *EventOutboxUpdateOne {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *EventOutboxUpdateOne) SetNillableProcessedAt(v *time.Time) *EventOutboxUpdateOne {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *EventOutboxUpdateOne) ClearProcessedAt() *EventOutboxUpdateOne {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_u *EventOutboxUpdateOne) SetEvent(v *Event) *EventOutboxUpdateOne {
+	return _u.SetEventID(v.ID)
+}
+
+// Mutation returns the EventOutboxMutation object of the builder.
+func (_u *EventOutboxUpdateOne) Mutation() *EventOutboxMutation {
+	return _u.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (_u *EventOutboxUpdateOne) ClearEvent() *EventOutboxUpdateOne {
+	_u.mutation.ClearEvent()
+	return _u
+}
+
+// Where appends a list predicates to the EventOutboxUpdate builder.
+func (_u *EventOutboxUpdateOne) Where(ps ...predicate.EventOutbox) *EventOutboxUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventOutboxUpdateOne) Select(field string, fields ...string) *EventOutboxUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventOutbox entity.
+func (_u *EventOutboxUpdateOne) Save(ctx context.Context) (*EventOutbox, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventOutboxUpdateOne) SaveX(ctx context.Context) *EventOutbox {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventOutboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventOutboxUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventOutboxUpdateOne) check() error {
+	if v, ok := _u.mutation.Handler(); ok {
+		if err := eventoutbox.HandlerValidator(v); err != nil {
+			return &ValidationError{Name: "handler", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.handler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := eventoutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventOutbox.status": %w`, err)}
+		}
+	}
+	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventOutbox.event"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventOutboxUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventOutboxUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EventOutboxUpdateOne) sqlSave(ctx context.Context) (_node *EventOutbox, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventoutbox.Table, eventoutbox.Columns, sqlgraph.NewFieldSpec(eventoutbox.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventOutbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventoutbox.FieldID)
+		for _, f := range fields {
+			if !eventoutbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventoutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Handler(); ok {
+		_spec.SetField(eventoutbox.FieldHandler, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(eventoutbox.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventoutbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(eventoutbox.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(eventoutbox.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(eventoutbox.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(eventoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(eventoutbox.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(eventoutbox.FieldProcessedAt, field.TypeTime)
+	}
+	if _u.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoutbox.EventTable,
+			Columns: []string{eventoutbox.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoutbox.EventTable,
+			Columns: []string{eventoutbox.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EventOutbox{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/user"
 )
 
 // CreateDatabaseInput represents a mutation input for creating databases.
@@ -358,14 +359,15 @@ func (c *ScopeSetUpdateOne) SetInput(i UpdateScopeSetInput) *ScopeSetUpdateOne {
 
 // CreateUserInput represents a mutation input for creating users.
 type CreateUserInput struct {
-	Name           string
-	Email          string
-	Avatar         *string
-	GroupID        int
-	PointIDs       []int
-	EventIDs       []int
-	SubmissionIDs  []int
-	CheatRecordIDs []int
+	Name              string
+	Email             string
+	Avatar            *string
+	RankingVisibility *user.RankingVisibility
+	GroupID           int
+	PointIDs          []int
+	EventIDs          []int
+	SubmissionIDs     []int
+	CheatRecordIDs    []int
 }
 
 // Mutate applies the CreateUserInput on the UserMutation builder.
@@ -375,6 +377,9 @@ func (i *CreateUserInput) Mutate(m *UserMutation) {
 	if v := i.Avatar; v != nil {
 		m.SetAvatar(*v)
 	}
+	if v := i.RankingVisibility; v != nil {
+		m.SetRankingVisibility(*v)
+	}
 	m.SetGroupID(i.GroupID)
 	if v := i.PointIDs; len(v) > 0 {
 		m.AddPointIDs(v...)
@@ -401,6 +406,7 @@ type UpdateUserInput struct {
 	Name                 *string
 	ClearAvatar          bool
 	Avatar               *string
+	RankingVisibility    *user.RankingVisibility
 	GroupID              *int
 	ClearPoints          bool
 	AddPointIDs          []int
@@ -427,6 +433,9 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.Avatar; v != nil {
 		m.SetAvatar(*v)
 	}
+	if v := i.RankingVisibility; v != nil {
+		m.SetRankingVisibility(*v)
+	}
 	if v := i.GroupID; v != nil {
 		m.SetGroupID(*v)
 	}
@@ -479,3 +488,103 @@ func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// CreateWebhookSubscriptionInput represents a mutation input for creating webhooksubscriptions.
+type CreateWebhookSubscriptionInput struct {
+	Name        string
+	URL         string
+	Secret      string
+	EventTypes  []string
+	Enabled     *bool
+	Description *string
+	DeliveryIDs []int
+}
+
+// Mutate applies the CreateWebhookSubscriptionInput on the WebhookSubscriptionMutation builder.
+func (i *CreateWebhookSubscriptionInput) Mutate(m *WebhookSubscriptionMutation) {
+	m.SetName(i.Name)
+	m.SetURL(i.URL)
+	m.SetSecret(i.Secret)
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.DeliveryIDs; len(v) > 0 {
+		m.AddDeliveryIDs(v...)
+	}
+}
+
+// SetInput applies the change-set in the CreateWebhookSubscriptionInput on the WebhookSubscriptionCreate builder.
+func (c *WebhookSubscriptionCreate) SetInput(i CreateWebhookSubscriptionInput) *WebhookSubscriptionCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateWebhookSubscriptionInput represents a mutation input for updating webhooksubscriptions.
+type UpdateWebhookSubscriptionInput struct {
+	Name              *string
+	URL               *string
+	Secret            *string
+	EventTypes        []string
+	AppendEventTypes  []string
+	Enabled           *bool
+	ClearDescription  bool
+	Description       *string
+	ClearDeliveries   bool
+	AddDeliveryIDs    []int
+	RemoveDeliveryIDs []int
+}
+
+// Mutate applies the UpdateWebhookSubscriptionInput on the WebhookSubscriptionMutation builder.
+func (i *UpdateWebhookSubscriptionInput) Mutate(m *WebhookSubscriptionMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.URL; v != nil {
+		m.SetURL(*v)
+	}
+	if v := i.Secret; v != nil {
+		m.SetSecret(*v)
+	}
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	if i.AppendEventTypes != nil {
+		m.AppendEventTypes(i.EventTypes)
+	}
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if i.ClearDeliveries {
+		m.ClearDeliveries()
+	}
+	if v := i.AddDeliveryIDs; len(v) > 0 {
+		m.AddDeliveryIDs(v...)
+	}
+	if v := i.RemoveDeliveryIDs; len(v) > 0 {
+		m.RemoveDeliveryIDs(v...)
+	}
+}
+
+// SetInput applies the change-set in the UpdateWebhookSubscriptionInput on the WebhookSubscriptionUpdate builder.
+func (c *WebhookSubscriptionUpdate) SetInput(i UpdateWebhookSubscriptionInput) *WebhookSubscriptionUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateWebhookSubscriptionInput on the WebhookSubscriptionUpdateOne builder.
+func (c *WebhookSubscriptionUpdateOne) SetInput(i UpdateWebhookSubscriptionInput) *WebhookSubscriptionUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
@@ -25,8 +25,8 @@ type GroupQuery struct {
 	inters             []Interceptor
 	predicates         []predicate.Group
 	withScopeSets      *ScopeSetQuery
-	modifiers          []func(*sql.Selector)
 	loadTotal          []func(context.Context, []*Group) error
+	modifiers          []func(*sql.Selector)
 	withNamedScopeSets map[string]*ScopeSetQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -280,8 +280,9 @@ func (_q *GroupQuery) Clone() *GroupQuery {
 		predicates:    append([]predicate.Group{}, _q.predicates...),
 		withScopeSets: _q.withScopeSets.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -550,6 +551,9 @@ func (_q *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -567,6 +571,12 @@ func (_q *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *GroupQuery) Modify(modifiers ...func(s *sql.Selector)) *GroupSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WithNamedScopeSets tells the query-builder to eager-load the nodes that are connected to the "scope_sets"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *GroupQuery) WithNamedScopeSets(name string, opts ...func(*ScopeSetQuery)) *GroupQuery {
@@ -670,3 +680,9 @@ func (_s *GroupSelect) sqlScan(ctx context.Context, root *GroupQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *GroupSelect) Modify(modifiers ...func(s *sql.Selector)) *GroupSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -2,4 +2,4 @@
 
 package internal
 
-const IncrementStarts = "{\"cheat_records\":34359738368,\"databases\":12884901888,\"events\":21474836480,\"groups\":4294967296,\"points\":25769803776,\"questions\":17179869184,\"scope_sets\":8589934592,\"submissions\":30064771072,\"users\":0}"
+const IncrementStarts = "{\"archived_events\":51539607552,\"audit_logs\":73014444032,\"cheat_records\":34359738368,\"databases\":12884901888,\"event_daily_counts\":55834574848,\"event_outboxes\":38654705664,\"events\":21474836480,\"groups\":4294967296,\"job_runs\":60129542144,\"persisted_queries\":68719476736,\"points\":25769803776,\"questions\":17179869184,\"rank_snapshots\":64424509440,\"scope_sets\":8589934592,\"submissions\":30064771072,\"users\":0,\"webhook_deliveries\":42949672960,\"webhook_subscriptions\":47244640256}"
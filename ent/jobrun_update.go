@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (_u *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetJobName sets the "job_name" field.
+func (_u *JobRunUpdate) SetJobName(v string) *JobRunUpdate {
+	_u.mutation.SetJobName(v)
+	return _u
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableJobName(v *string) *JobRunUpdate {
+	if v != nil {
+		_u.SetJobName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobRunUpdate) SetStatus(v jobrun.Status) *JobRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableStatus(v *jobrun.Status) *JobRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetInstance sets the "instance" field.
+func (_u *JobRunUpdate) SetInstance(v string) *JobRunUpdate {
+	_u.mutation.SetInstance(v)
+	return _u
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableInstance(v *string) *JobRunUpdate {
+	if v != nil {
+		_u.SetInstance(*v)
+	}
+	return _u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (_u *JobRunUpdate) SetScheduledAt(v time.Time) *JobRunUpdate {
+	_u.mutation.SetScheduledAt(v)
+	return _u
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableScheduledAt(v *time.Time) *JobRunUpdate {
+	if v != nil {
+		_u.SetScheduledAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *JobRunUpdate) SetStartedAt(v time.Time) *JobRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableStartedAt(v *time.Time) *JobRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobRunUpdate) SetFinishedAt(v time.Time) *JobRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableFinishedAt(v *time.Time) *JobRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *JobRunUpdate) ClearFinishedAt() *JobRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *JobRunUpdate) SetDurationMs(v int) *JobRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableDurationMs(v *int) *JobRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *JobRunUpdate) AddDurationMs(v int) *JobRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *JobRunUpdate) ClearDurationMs() *JobRunUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobRunUpdate) SetError(v string) *JobRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobRunUpdate) SetNillableError(v *string) *JobRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobRunUpdate) ClearError() *JobRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (_u *JobRunUpdate) Mutation() *JobRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobRunUpdate) check() error {
+	if v, ok := _u.mutation.JobName(); ok {
+		if err := jobrun.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobRun.job_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JobRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JobRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.JobName(); ok {
+		_spec.SetField(jobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Instance(); ok {
+		_spec.SetField(jobrun.FieldInstance, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScheduledAt(); ok {
+		_spec.SetField(jobrun.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(jobrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(jobrun.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJobName sets the "job_name" field.
+func (_u *JobRunUpdateOne) SetJobName(v string) *JobRunUpdateOne {
+	_u.mutation.SetJobName(v)
+	return _u
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableJobName(v *string) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetJobName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobRunUpdateOne) SetStatus(v jobrun.Status) *JobRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableStatus(v *jobrun.Status) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetInstance sets the "instance" field.
+func (_u *JobRunUpdateOne) SetInstance(v string) *JobRunUpdateOne {
+	_u.mutation.SetInstance(v)
+	return _u
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableInstance(v *string) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetInstance(*v)
+	}
+	return _u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (_u *JobRunUpdateOne) SetScheduledAt(v time.Time) *JobRunUpdateOne {
+	_u.mutation.SetScheduledAt(v)
+	return _u
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableScheduledAt(v *time.Time) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetScheduledAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *JobRunUpdateOne) SetStartedAt(v time.Time) *JobRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableStartedAt(v *time.Time) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobRunUpdateOne) SetFinishedAt(v time.Time) *JobRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableFinishedAt(v *time.Time) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *JobRunUpdateOne) ClearFinishedAt() *JobRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *JobRunUpdateOne) SetDurationMs(v int) *JobRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableDurationMs(v *int) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *JobRunUpdateOne) AddDurationMs(v int) *JobRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *JobRunUpdateOne) ClearDurationMs() *JobRunUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobRunUpdateOne) SetError(v string) *JobRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobRunUpdateOne) SetNillableError(v *string) *JobRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobRunUpdateOne) ClearError() *JobRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (_u *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (_u *JobRunUpdateOne) Where(ps ...predicate.JobRun) *JobRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (_u *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobRunUpdateOne) check() error {
+	if v, ok := _u.mutation.JobName(); ok {
+		if err := jobrun.JobNameValidator(v); err != nil {
+			return &ValidationError{Name: "job_name", err: fmt.Errorf(`ent: validator failed for field "JobRun.job_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JobRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.JobName(); ok {
+		_spec.SetField(jobrun.FieldJobName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Instance(); ok {
+		_spec.SetField(jobrun.FieldInstance, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScheduledAt(); ok {
+		_spec.SetField(jobrun.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(jobrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(jobrun.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &JobRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
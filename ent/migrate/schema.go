@@ -9,13 +9,82 @@ import (
 )
 
 var (
+	// ArchivedEventsColumns holds the columns for the "archived_events" table.
+	ArchivedEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "triggered_at", Type: field.TypeTime},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime},
+	}
+	// ArchivedEventsTable holds the schema information for the "archived_events" table.
+	ArchivedEventsTable = &schema.Table{
+		Name:       "archived_events",
+		Columns:    ArchivedEventsColumns,
+		PrimaryKey: []*schema.Column{ArchivedEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivedevent_user_id_type",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[1], ArchivedEventsColumns[2]},
+			},
+			{
+				Name:    "archivedevent_triggered_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[3]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor_id", Type: field.TypeInt},
+		{Name: "impersonator_id", Type: field.TypeInt, Nullable: true},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString, Nullable: true},
+		{Name: "target_id", Type: field.TypeInt, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "trace_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[6]},
+			},
+		},
+	}
 	// CheatRecordsColumns holds the columns for the "cheat_records" table.
 	CheatRecordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"manual", "tab_switch", "plagiarism", "exam_violation"}, Default: "manual"},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"pending", "open", "under_review", "confirmed", "dismissed"}, Default: "open"},
 		{Name: "reason", Type: field.TypeString},
 		{Name: "resolved_reason", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "cheated_at", Type: field.TypeTime},
+		{Name: "evidence", Type: field.TypeJSON, Nullable: true},
+		{Name: "detection_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "cheat_record_reporter", Type: field.TypeInt, Nullable: true},
 		{Name: "user_cheat_records", Type: field.TypeInt},
 	}
 	// CheatRecordsTable holds the schema information for the "cheat_records" table.
@@ -24,13 +93,26 @@ var (
 		Columns:    CheatRecordsColumns,
 		PrimaryKey: []*schema.Column{CheatRecordsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cheat_records_users_reporter",
+				Columns:    []*schema.Column{CheatRecordsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "cheat_records_users_cheat_records",
-				Columns:    []*schema.Column{CheatRecordsColumns[5]},
+				Columns:    []*schema.Column{CheatRecordsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cheatrecord_state_kind",
+				Unique:  false,
+				Columns: []*schema.Column{CheatRecordsColumns[2], CheatRecordsColumns[1]},
+			},
+		},
 	}
 	// DatabasesColumns holds the columns for the "databases" table.
 	DatabasesColumns = []*schema.Column{
@@ -80,6 +162,71 @@ var (
 			},
 		},
 	}
+	// EventDailyCountsColumns holds the columns for the "event_daily_counts" table.
+	EventDailyCountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "count", Type: field.TypeInt},
+	}
+	// EventDailyCountsTable holds the schema information for the "event_daily_counts" table.
+	EventDailyCountsTable = &schema.Table{
+		Name:       "event_daily_counts",
+		Columns:    EventDailyCountsColumns,
+		PrimaryKey: []*schema.Column{EventDailyCountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventdailycount_user_id_type_date",
+				Unique:  true,
+				Columns: []*schema.Column{EventDailyCountsColumns[1], EventDailyCountsColumns[2], EventDailyCountsColumns[3]},
+			},
+			{
+				Name:    "eventdailycount_type",
+				Unique:  false,
+				Columns: []*schema.Column{EventDailyCountsColumns[2]},
+			},
+		},
+	}
+	// EventOutboxesColumns holds the columns for the "event_outboxes" table.
+	EventOutboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "handler", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "event_id", Type: field.TypeInt},
+	}
+	// EventOutboxesTable holds the schema information for the "event_outboxes" table.
+	EventOutboxesTable = &schema.Table{
+		Name:       "event_outboxes",
+		Columns:    EventOutboxesColumns,
+		PrimaryKey: []*schema.Column{EventOutboxesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_outboxes_events_outbox",
+				Columns:    []*schema.Column{EventOutboxesColumns[9]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventoutbox_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventOutboxesColumns[2], EventOutboxesColumns[4]},
+			},
+			{
+				Name:    "eventoutbox_event_id_handler",
+				Unique:  true,
+				Columns: []*schema.Column{EventOutboxesColumns[9], EventOutboxesColumns[1]},
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -95,12 +242,51 @@ var (
 		Columns:    GroupsColumns,
 		PrimaryKey: []*schema.Column{GroupsColumns[0]},
 	}
+	// JobRunsColumns holds the columns for the "job_runs" table.
+	JobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "job_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}},
+		{Name: "instance", Type: field.TypeString},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+	}
+	// JobRunsTable holds the schema information for the "job_runs" table.
+	JobRunsTable = &schema.Table{
+		Name:       "job_runs",
+		Columns:    JobRunsColumns,
+		PrimaryKey: []*schema.Column{JobRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobrun_job_name_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunsColumns[1], JobRunsColumns[5]},
+			},
+		},
+	}
+	// PersistedQueriesColumns holds the columns for the "persisted_queries" table.
+	PersistedQueriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "query", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PersistedQueriesTable holds the schema information for the "persisted_queries" table.
+	PersistedQueriesTable = &schema.Table{
+		Name:       "persisted_queries",
+		Columns:    PersistedQueriesColumns,
+		PrimaryKey: []*schema.Column{PersistedQueriesColumns[0]},
+	}
 	// PointsColumns holds the columns for the "points" table.
 	PointsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "points", Type: field.TypeInt, Default: 0},
 		{Name: "granted_at", Type: field.TypeTime},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "user_points", Type: field.TypeInt},
 	}
 	// PointsTable holds the schema information for the "points" table.
@@ -111,7 +297,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "points_users_points",
-				Columns:    []*schema.Column{PointsColumns[4]},
+				Columns:    []*schema.Column{PointsColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -154,6 +340,33 @@ var (
 			},
 		},
 	}
+	// RankSnapshotsColumns holds the columns for the "rank_snapshots" table.
+	RankSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "by", Type: field.TypeEnum, Enums: []string{"POINTS", "COMPLETED_QUESTIONS"}},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "score", Type: field.TypeInt},
+	}
+	// RankSnapshotsTable holds the schema information for the "rank_snapshots" table.
+	RankSnapshotsTable = &schema.Table{
+		Name:       "rank_snapshots",
+		Columns:    RankSnapshotsColumns,
+		PrimaryKey: []*schema.Column{RankSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ranksnapshot_user_id_by_date",
+				Unique:  true,
+				Columns: []*schema.Column{RankSnapshotsColumns[1], RankSnapshotsColumns[2], RankSnapshotsColumns[3]},
+			},
+			{
+				Name:    "ranksnapshot_by_date",
+				Unique:  false,
+				Columns: []*schema.Column{RankSnapshotsColumns[2], RankSnapshotsColumns[3]},
+			},
+		},
+	}
 	// ScopeSetsColumns holds the columns for the "scope_sets" table.
 	ScopeSetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -207,6 +420,8 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "ranking_visibility", Type: field.TypeEnum, Enums: []string{"real_name", "pseudonym", "hidden"}, Default: "pseudonym"},
+		{Name: "pseudonym", Type: field.TypeString, Nullable: true},
 		{Name: "user_group", Type: field.TypeInt},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -217,12 +432,65 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_group",
-				Columns:    []*schema.Column{UsersColumns[7]},
+				Columns:    []*schema.Column{UsersColumns[9]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeInt},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt},
+		{Name: "delivered_at", Type: field.TypeTime},
+		{Name: "webhook_subscription_deliveries", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+	}
 	// GroupScopeSetsColumns holds the columns for the "group_scope_sets" table.
 	GroupScopeSetsColumns = []*schema.Column{
 		{Name: "group_id", Type: field.TypeInt},
@@ -250,21 +518,37 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ArchivedEventsTable,
+		AuditLogsTable,
 		CheatRecordsTable,
 		DatabasesTable,
 		EventsTable,
+		EventDailyCountsTable,
+		EventOutboxesTable,
 		GroupsTable,
+		JobRunsTable,
+		PersistedQueriesTable,
 		PointsTable,
 		QuestionsTable,
+		RankSnapshotsTable,
 		ScopeSetsTable,
 		SubmissionsTable,
 		UsersTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 		GroupScopeSetsTable,
 	}
 )
 
 func init() {
+	ArchivedEventsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(51539607552),
+	}
+	AuditLogsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(73014444032),
+	}
 	CheatRecordsTable.ForeignKeys[0].RefTable = UsersTable
+	CheatRecordsTable.ForeignKeys[1].RefTable = UsersTable
 	CheatRecordsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(34359738368),
 	}
@@ -275,9 +559,22 @@ func init() {
 	EventsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(21474836480),
 	}
+	EventDailyCountsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(55834574848),
+	}
+	EventOutboxesTable.ForeignKeys[0].RefTable = EventsTable
+	EventOutboxesTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(38654705664),
+	}
 	GroupsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(4294967296),
 	}
+	JobRunsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(60129542144),
+	}
+	PersistedQueriesTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(68719476736),
+	}
 	PointsTable.ForeignKeys[0].RefTable = UsersTable
 	PointsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(25769803776),
@@ -286,6 +583,9 @@ func init() {
 	QuestionsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(17179869184),
 	}
+	RankSnapshotsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(64424509440),
+	}
 	ScopeSetsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(8589934592),
 	}
@@ -298,6 +598,13 @@ func init() {
 	UsersTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(0),
 	}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookSubscriptionsTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(42949672960),
+	}
+	WebhookSubscriptionsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(47244640256),
+	}
 	GroupScopeSetsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupScopeSetsTable.ForeignKeys[1].RefTable = ScopeSetsTable
 }
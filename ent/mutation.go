@@ -11,16 +11,25 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+	"github.com/database-playground/backend-v2/ent/auditlog"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/database"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
 	"github.com/database-playground/backend-v2/ent/group"
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
 	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/ent/predicate"
 	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
 	"github.com/database-playground/backend-v2/ent/scopeset"
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/ent/webhooksubscription"
 	"github.com/database-playground/backend-v2/models"
 )
 
@@ -33,46 +42,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCheatRecord = "CheatRecord"
-	TypeDatabase    = "Database"
-	TypeEvent       = "Event"
-	TypeGroup       = "Group"
-	TypePoint       = "Point"
-	TypeQuestion    = "Question"
-	TypeScopeSet    = "ScopeSet"
-	TypeSubmission  = "Submission"
-	TypeUser        = "User"
+	TypeArchivedEvent       = "ArchivedEvent"
+	TypeAuditLog            = "AuditLog"
+	TypeCheatRecord         = "CheatRecord"
+	TypeDatabase            = "Database"
+	TypeEvent               = "Event"
+	TypeEventDailyCount     = "EventDailyCount"
+	TypeEventOutbox         = "EventOutbox"
+	TypeGroup               = "Group"
+	TypeJobRun              = "JobRun"
+	TypePersistedQuery      = "PersistedQuery"
+	TypePoint               = "Point"
+	TypeQuestion            = "Question"
+	TypeRankSnapshot        = "RankSnapshot"
+	TypeScopeSet            = "ScopeSet"
+	TypeSubmission          = "Submission"
+	TypeUser                = "User"
+	TypeWebhookDelivery     = "WebhookDelivery"
+	TypeWebhookSubscription = "WebhookSubscription"
 )
 
-// CheatRecordMutation represents an operation that mutates the CheatRecord nodes in the graph.
-type CheatRecordMutation struct {
+// ArchivedEventMutation represents an operation that mutates the ArchivedEvent nodes in the graph.
+type ArchivedEventMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	reason          *string
-	resolved_reason *string
-	resolved_at     *time.Time
-	cheated_at      *time.Time
-	clearedFields   map[string]struct{}
-	user            *int
-	cleareduser     bool
-	done            bool
-	oldValue        func(context.Context) (*CheatRecord, error)
-	predicates      []predicate.CheatRecord
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int
+	adduser_id    *int
+	_type         *string
+	triggered_at  *time.Time
+	payload       *map[string]interface{}
+	archived_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ArchivedEvent, error)
+	predicates    []predicate.ArchivedEvent
 }
 
-var _ ent.Mutation = (*CheatRecordMutation)(nil)
+var _ ent.Mutation = (*ArchivedEventMutation)(nil)
 
-// cheatrecordOption allows management of the mutation configuration using functional options.
-type cheatrecordOption func(*CheatRecordMutation)
+// archivedeventOption allows management of the mutation configuration using functional options.
+type archivedeventOption func(*ArchivedEventMutation)
 
-// newCheatRecordMutation creates new mutation for the CheatRecord entity.
-func newCheatRecordMutation(c config, op Op, opts ...cheatrecordOption) *CheatRecordMutation {
-	m := &CheatRecordMutation{
+// newArchivedEventMutation creates new mutation for the ArchivedEvent entity.
+func newArchivedEventMutation(c config, op Op, opts ...archivedeventOption) *ArchivedEventMutation {
+	m := &ArchivedEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCheatRecord,
+		typ:           TypeArchivedEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -81,20 +99,20 @@ func newCheatRecordMutation(c config, op Op, opts ...cheatrecordOption) *CheatRe
 	return m
 }
 
-// withCheatRecordID sets the ID field of the mutation.
-func withCheatRecordID(id int) cheatrecordOption {
-	return func(m *CheatRecordMutation) {
+// withArchivedEventID sets the ID field of the mutation.
+func withArchivedEventID(id int) archivedeventOption {
+	return func(m *ArchivedEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CheatRecord
+			value *ArchivedEvent
 		)
-		m.oldValue = func(ctx context.Context) (*CheatRecord, error) {
+		m.oldValue = func(ctx context.Context) (*ArchivedEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CheatRecord.Get(ctx, id)
+					value, err = m.Client().ArchivedEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -103,10 +121,10 @@ func withCheatRecordID(id int) cheatrecordOption {
 	}
 }
 
-// withCheatRecord sets the old CheatRecord of the mutation.
-func withCheatRecord(node *CheatRecord) cheatrecordOption {
-	return func(m *CheatRecordMutation) {
-		m.oldValue = func(context.Context) (*CheatRecord, error) {
+// withArchivedEvent sets the old ArchivedEvent of the mutation.
+func withArchivedEvent(node *ArchivedEvent) archivedeventOption {
+	return func(m *ArchivedEventMutation) {
+		m.oldValue = func(context.Context) (*ArchivedEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -115,7 +133,7 @@ func withCheatRecord(node *CheatRecord) cheatrecordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CheatRecordMutation) Client() *Client {
+func (m ArchivedEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -123,7 +141,7 @@ func (m CheatRecordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CheatRecordMutation) Tx() (*Tx, error) {
+func (m ArchivedEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -132,9 +150,15 @@ func (m CheatRecordMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ArchivedEvent entities.
+func (m *ArchivedEventMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CheatRecordMutation) ID() (id int, exists bool) {
+func (m *ArchivedEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,7 +169,7 @@ func (m *CheatRecordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CheatRecordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArchivedEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -154,230 +178,234 @@ func (m *CheatRecordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CheatRecord.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchivedEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetReason sets the "reason" field.
-func (m *CheatRecordMutation) SetReason(s string) {
-	m.reason = &s
+// SetUserID sets the "user_id" field.
+func (m *ArchivedEventMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *CheatRecordMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ArchivedEventMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the CheatRecord entity.
-// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CheatRecordMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.UserID, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *CheatRecordMutation) ResetReason() {
-	m.reason = nil
+// AddUserID adds i to the "user_id" field.
+func (m *ArchivedEventMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetResolvedReason sets the "resolved_reason" field.
-func (m *CheatRecordMutation) SetResolvedReason(s string) {
-	m.resolved_reason = &s
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ArchivedEventMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResolvedReason returns the value of the "resolved_reason" field in the mutation.
-func (m *CheatRecordMutation) ResolvedReason() (r string, exists bool) {
-	v := m.resolved_reason
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ArchivedEventMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *ArchivedEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ArchivedEventMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedReason returns the old "resolved_reason" field's value of the CheatRecord entity.
-// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CheatRecordMutation) OldResolvedReason(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedReason requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.ResolvedReason, nil
-}
-
-// ClearResolvedReason clears the value of the "resolved_reason" field.
-func (m *CheatRecordMutation) ClearResolvedReason() {
-	m.resolved_reason = nil
-	m.clearedFields[cheatrecord.FieldResolvedReason] = struct{}{}
-}
-
-// ResolvedReasonCleared returns if the "resolved_reason" field was cleared in this mutation.
-func (m *CheatRecordMutation) ResolvedReasonCleared() bool {
-	_, ok := m.clearedFields[cheatrecord.FieldResolvedReason]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetResolvedReason resets all changes to the "resolved_reason" field.
-func (m *CheatRecordMutation) ResetResolvedReason() {
-	m.resolved_reason = nil
-	delete(m.clearedFields, cheatrecord.FieldResolvedReason)
+// ResetType resets all changes to the "type" field.
+func (m *ArchivedEventMutation) ResetType() {
+	m._type = nil
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *CheatRecordMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetTriggeredAt sets the "triggered_at" field.
+func (m *ArchivedEventMutation) SetTriggeredAt(t time.Time) {
+	m.triggered_at = &t
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *CheatRecordMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// TriggeredAt returns the value of the "triggered_at" field in the mutation.
+func (m *ArchivedEventMutation) TriggeredAt() (r time.Time, exists bool) {
+	v := m.triggered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the CheatRecord entity.
-// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggeredAt returns the old "triggered_at" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CheatRecordMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedEventMutation) OldTriggeredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
-}
-
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *CheatRecordMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[cheatrecord.FieldResolvedAt] = struct{}{}
-}
-
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *CheatRecordMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[cheatrecord.FieldResolvedAt]
-	return ok
+	return oldValue.TriggeredAt, nil
 }
 
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *CheatRecordMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, cheatrecord.FieldResolvedAt)
+// ResetTriggeredAt resets all changes to the "triggered_at" field.
+func (m *ArchivedEventMutation) ResetTriggeredAt() {
+	m.triggered_at = nil
 }
 
-// SetCheatedAt sets the "cheated_at" field.
-func (m *CheatRecordMutation) SetCheatedAt(t time.Time) {
-	m.cheated_at = &t
+// SetPayload sets the "payload" field.
+func (m *ArchivedEventMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// CheatedAt returns the value of the "cheated_at" field in the mutation.
-func (m *CheatRecordMutation) CheatedAt() (r time.Time, exists bool) {
-	v := m.cheated_at
+// Payload returns the value of the "payload" field in the mutation.
+func (m *ArchivedEventMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheatedAt returns the old "cheated_at" field's value of the CheatRecord entity.
-// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CheatRecordMutation) OldCheatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedEventMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.CheatedAt, nil
+	return oldValue.Payload, nil
 }
 
-// ResetCheatedAt resets all changes to the "cheated_at" field.
-func (m *CheatRecordMutation) ResetCheatedAt() {
-	m.cheated_at = nil
+// ClearPayload clears the value of the "payload" field.
+func (m *ArchivedEventMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[archivedevent.FieldPayload] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *CheatRecordMutation) SetUserID(id int) {
-	m.user = &id
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *ArchivedEventMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[archivedevent.FieldPayload]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *CheatRecordMutation) ClearUser() {
-	m.cleareduser = true
+// ResetPayload resets all changes to the "payload" field.
+func (m *ArchivedEventMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, archivedevent.FieldPayload)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *CheatRecordMutation) UserCleared() bool {
-	return m.cleareduser
+// SetArchivedAt sets the "archived_at" field.
+func (m *ArchivedEventMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *CheatRecordMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *ArchivedEventMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *CheatRecordMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldArchivedAt returns the old "archived_at" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedEventMutation) OldArchivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *CheatRecordMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *ArchivedEventMutation) ResetArchivedAt() {
+	m.archived_at = nil
 }
 
-// Where appends a list predicates to the CheatRecordMutation builder.
-func (m *CheatRecordMutation) Where(ps ...predicate.CheatRecord) {
+// Where appends a list predicates to the ArchivedEventMutation builder.
+func (m *ArchivedEventMutation) Where(ps ...predicate.ArchivedEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CheatRecordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchivedEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CheatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CheatRecord, len(ps))
+func (m *ArchivedEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchivedEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -385,36 +413,39 @@ func (m *CheatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CheatRecordMutation) Op() Op {
+func (m *ArchivedEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CheatRecordMutation) SetOp(op Op) {
+func (m *ArchivedEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CheatRecord).
-func (m *CheatRecordMutation) Type() string {
+// Type returns the node type of this mutation (ArchivedEvent).
+func (m *ArchivedEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CheatRecordMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.reason != nil {
-		fields = append(fields, cheatrecord.FieldReason)
+func (m *ArchivedEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, archivedevent.FieldUserID)
 	}
-	if m.resolved_reason != nil {
-		fields = append(fields, cheatrecord.FieldResolvedReason)
+	if m._type != nil {
+		fields = append(fields, archivedevent.FieldType)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, cheatrecord.FieldResolvedAt)
+	if m.triggered_at != nil {
+		fields = append(fields, archivedevent.FieldTriggeredAt)
 	}
-	if m.cheated_at != nil {
-		fields = append(fields, cheatrecord.FieldCheatedAt)
+	if m.payload != nil {
+		fields = append(fields, archivedevent.FieldPayload)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, archivedevent.FieldArchivedAt)
 	}
 	return fields
 }
@@ -422,16 +453,18 @@ func (m *CheatRecordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CheatRecordMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchivedEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cheatrecord.FieldReason:
-		return m.Reason()
-	case cheatrecord.FieldResolvedReason:
-		return m.ResolvedReason()
-	case cheatrecord.FieldResolvedAt:
-		return m.ResolvedAt()
-	case cheatrecord.FieldCheatedAt:
-		return m.CheatedAt()
+	case archivedevent.FieldUserID:
+		return m.UserID()
+	case archivedevent.FieldType:
+		return m.GetType()
+	case archivedevent.FieldTriggeredAt:
+		return m.TriggeredAt()
+	case archivedevent.FieldPayload:
+		return m.Payload()
+	case archivedevent.FieldArchivedAt:
+		return m.ArchivedAt()
 	}
 	return nil, false
 }
@@ -439,237 +472,238 @@ func (m *CheatRecordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CheatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchivedEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cheatrecord.FieldReason:
-		return m.OldReason(ctx)
-	case cheatrecord.FieldResolvedReason:
-		return m.OldResolvedReason(ctx)
-	case cheatrecord.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
-	case cheatrecord.FieldCheatedAt:
-		return m.OldCheatedAt(ctx)
+	case archivedevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case archivedevent.FieldType:
+		return m.OldType(ctx)
+	case archivedevent.FieldTriggeredAt:
+		return m.OldTriggeredAt(ctx)
+	case archivedevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case archivedevent.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CheatRecord field %s", name)
+	return nil, fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CheatRecordMutation) SetField(name string, value ent.Value) error {
+func (m *ArchivedEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cheatrecord.FieldReason:
-		v, ok := value.(string)
+	case archivedevent.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetUserID(v)
 		return nil
-	case cheatrecord.FieldResolvedReason:
+	case archivedevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedReason(v)
+		m.SetType(v)
 		return nil
-	case cheatrecord.FieldResolvedAt:
+	case archivedevent.FieldTriggeredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetTriggeredAt(v)
 		return nil
-	case cheatrecord.FieldCheatedAt:
+	case archivedevent.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case archivedevent.FieldArchivedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCheatedAt(v)
+		m.SetArchivedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CheatRecord field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CheatRecordMutation) AddedFields() []string {
-	return nil
+func (m *ArchivedEventMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, archivedevent.FieldUserID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CheatRecordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchivedEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case archivedevent.FieldUserID:
+		return m.AddedUserID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CheatRecordMutation) AddField(name string, value ent.Value) error {
+func (m *ArchivedEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case archivedevent.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown CheatRecord numeric field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CheatRecordMutation) ClearedFields() []string {
+func (m *ArchivedEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(cheatrecord.FieldResolvedReason) {
-		fields = append(fields, cheatrecord.FieldResolvedReason)
-	}
-	if m.FieldCleared(cheatrecord.FieldResolvedAt) {
-		fields = append(fields, cheatrecord.FieldResolvedAt)
+	if m.FieldCleared(archivedevent.FieldPayload) {
+		fields = append(fields, archivedevent.FieldPayload)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CheatRecordMutation) FieldCleared(name string) bool {
+func (m *ArchivedEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CheatRecordMutation) ClearField(name string) error {
+func (m *ArchivedEventMutation) ClearField(name string) error {
 	switch name {
-	case cheatrecord.FieldResolvedReason:
-		m.ClearResolvedReason()
-		return nil
-	case cheatrecord.FieldResolvedAt:
-		m.ClearResolvedAt()
+	case archivedevent.FieldPayload:
+		m.ClearPayload()
 		return nil
 	}
-	return fmt.Errorf("unknown CheatRecord nullable field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CheatRecordMutation) ResetField(name string) error {
+func (m *ArchivedEventMutation) ResetField(name string) error {
 	switch name {
-	case cheatrecord.FieldReason:
-		m.ResetReason()
+	case archivedevent.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case cheatrecord.FieldResolvedReason:
-		m.ResetResolvedReason()
+	case archivedevent.FieldType:
+		m.ResetType()
 		return nil
-	case cheatrecord.FieldResolvedAt:
-		m.ResetResolvedAt()
+	case archivedevent.FieldTriggeredAt:
+		m.ResetTriggeredAt()
 		return nil
-	case cheatrecord.FieldCheatedAt:
-		m.ResetCheatedAt()
+	case archivedevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case archivedevent.FieldArchivedAt:
+		m.ResetArchivedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CheatRecord field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CheatRecordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, cheatrecord.EdgeUser)
-	}
+func (m *ArchivedEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CheatRecordMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case cheatrecord.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ArchivedEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CheatRecordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ArchivedEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CheatRecordMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArchivedEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CheatRecordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, cheatrecord.EdgeUser)
-	}
+func (m *ArchivedEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CheatRecordMutation) EdgeCleared(name string) bool {
-	switch name {
-	case cheatrecord.EdgeUser:
-		return m.cleareduser
-	}
+func (m *ArchivedEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CheatRecordMutation) ClearEdge(name string) error {
-	switch name {
-	case cheatrecord.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown CheatRecord unique edge %s", name)
+func (m *ArchivedEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CheatRecordMutation) ResetEdge(name string) error {
-	switch name {
-	case cheatrecord.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown CheatRecord edge %s", name)
+func (m *ArchivedEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedEvent edge %s", name)
 }
 
-// DatabaseMutation represents an operation that mutates the Database nodes in the graph.
-type DatabaseMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	slug             *string
-	description      *string
-	schema           *string
-	relation_figure  *string
-	clearedFields    map[string]struct{}
-	questions        map[int]struct{}
-	removedquestions map[int]struct{}
-	clearedquestions bool
-	done             bool
-	oldValue         func(context.Context) (*Database, error)
-	predicates       []predicate.Database
+	op                 Op
+	typ                string
+	id                 *int
+	actor_id           *int
+	addactor_id        *int
+	impersonator_id    *int
+	addimpersonator_id *int
+	operation          *string
+	scope              *string
+	target_type        *string
+	target_id          *int
+	addtarget_id       *int
+	changes            *[]models.AuditLogChange
+	appendchanges      []models.AuditLogChange
+	trace_id           *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*AuditLog, error)
+	predicates         []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*DatabaseMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// databaseOption allows management of the mutation configuration using functional options.
-type databaseOption func(*DatabaseMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newDatabaseMutation creates new mutation for the Database entity.
-func newDatabaseMutation(c config, op Op, opts ...databaseOption) *DatabaseMutation {
-	m := &DatabaseMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDatabase,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -678,20 +712,20 @@ func newDatabaseMutation(c config, op Op, opts ...databaseOption) *DatabaseMutat
 	return m
 }
 
-// withDatabaseID sets the ID field of the mutation.
-func withDatabaseID(id int) databaseOption {
-	return func(m *DatabaseMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Database
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Database, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Database.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -700,10 +734,10 @@ func withDatabaseID(id int) databaseOption {
 	}
 }
 
-// withDatabase sets the old Database of the mutation.
-func withDatabase(node *Database) databaseOption {
-	return func(m *DatabaseMutation) {
-		m.oldValue = func(context.Context) (*Database, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -712,7 +746,7 @@ func withDatabase(node *Database) databaseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DatabaseMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -720,7 +754,7 @@ func (m DatabaseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DatabaseMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -731,7 +765,7 @@ func (m DatabaseMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DatabaseMutation) ID() (id int, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -742,7 +776,7 @@ func (m *DatabaseMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DatabaseMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -751,232 +785,488 @@ func (m *DatabaseMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Database.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSlug sets the "slug" field.
-func (m *DatabaseMutation) SetSlug(s string) {
-	m.slug = &s
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogMutation) SetActorID(i int) {
+	m.actor_id = &i
+	m.addactor_id = nil
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *DatabaseMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogMutation) ActorID() (r int, exists bool) {
+	v := m.actor_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Database entity.
-// If the Database object wasn't provided to the builder, the object is fetched from the database.
+// OldActorID returns the old "actor_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatabaseMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldActorID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldActorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.ActorID, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *DatabaseMutation) ResetSlug() {
-	m.slug = nil
+// AddActorID adds i to the "actor_id" field.
+func (m *AuditLogMutation) AddActorID(i int) {
+	if m.addactor_id != nil {
+		*m.addactor_id += i
+	} else {
+		m.addactor_id = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *DatabaseMutation) SetDescription(s string) {
-	m.description = &s
+// AddedActorID returns the value that was added to the "actor_id" field in this mutation.
+func (m *AuditLogMutation) AddedActorID() (r int, exists bool) {
+	v := m.addactor_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DatabaseMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (m *AuditLogMutation) SetImpersonatorID(i int) {
+	m.impersonator_id = &i
+	m.addimpersonator_id = nil
+}
+
+// ImpersonatorID returns the value of the "impersonator_id" field in the mutation.
+func (m *AuditLogMutation) ImpersonatorID() (r int, exists bool) {
+	v := m.impersonator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Database entity.
-// If the Database object wasn't provided to the builder, the object is fetched from the database.
+// OldImpersonatorID returns the old "impersonator_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatabaseMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldImpersonatorID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldImpersonatorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldImpersonatorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldImpersonatorID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ImpersonatorID, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *DatabaseMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[database.FieldDescription] = struct{}{}
+// AddImpersonatorID adds i to the "impersonator_id" field.
+func (m *AuditLogMutation) AddImpersonatorID(i int) {
+	if m.addimpersonator_id != nil {
+		*m.addimpersonator_id += i
+	} else {
+		m.addimpersonator_id = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DatabaseMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[database.FieldDescription]
+// AddedImpersonatorID returns the value that was added to the "impersonator_id" field in this mutation.
+func (m *AuditLogMutation) AddedImpersonatorID() (r int, exists bool) {
+	v := m.addimpersonator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (m *AuditLogMutation) ClearImpersonatorID() {
+	m.impersonator_id = nil
+	m.addimpersonator_id = nil
+	m.clearedFields[auditlog.FieldImpersonatorID] = struct{}{}
+}
+
+// ImpersonatorIDCleared returns if the "impersonator_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ImpersonatorIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldImpersonatorID]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DatabaseMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, database.FieldDescription)
+// ResetImpersonatorID resets all changes to the "impersonator_id" field.
+func (m *AuditLogMutation) ResetImpersonatorID() {
+	m.impersonator_id = nil
+	m.addimpersonator_id = nil
+	delete(m.clearedFields, auditlog.FieldImpersonatorID)
 }
 
-// SetSchema sets the "schema" field.
-func (m *DatabaseMutation) SetSchema(s string) {
-	m.schema = &s
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// Schema returns the value of the "schema" field in the mutation.
-func (m *DatabaseMutation) Schema() (r string, exists bool) {
-	v := m.schema
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchema returns the old "schema" field's value of the Database entity.
-// If the Database object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatabaseMutation) OldSchema(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchema is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchema requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.Schema, nil
+	return oldValue.Operation, nil
 }
 
-// ResetSchema resets all changes to the "schema" field.
-func (m *DatabaseMutation) ResetSchema() {
-	m.schema = nil
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// SetRelationFigure sets the "relation_figure" field.
-func (m *DatabaseMutation) SetRelationFigure(s string) {
-	m.relation_figure = &s
+// SetScope sets the "scope" field.
+func (m *AuditLogMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// RelationFigure returns the value of the "relation_figure" field in the mutation.
-func (m *DatabaseMutation) RelationFigure() (r string, exists bool) {
-	v := m.relation_figure
+// Scope returns the value of the "scope" field in the mutation.
+func (m *AuditLogMutation) Scope() (r string, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRelationFigure returns the old "relation_figure" field's value of the Database entity.
-// If the Database object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DatabaseMutation) OldRelationFigure(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRelationFigure is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRelationFigure requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRelationFigure: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.RelationFigure, nil
+	return oldValue.Scope, nil
 }
 
-// ResetRelationFigure resets all changes to the "relation_figure" field.
-func (m *DatabaseMutation) ResetRelationFigure() {
-	m.relation_figure = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *AuditLogMutation) ResetScope() {
+	m.scope = nil
 }
 
-// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
-func (m *DatabaseMutation) AddQuestionIDs(ids ...int) {
-	if m.questions == nil {
-		m.questions = make(map[int]struct{})
+// SetTargetType sets the "target_type" field.
+func (m *AuditLogMutation) SetTargetType(s string) {
+	m.target_type = &s
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditLogMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.questions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
+	return oldValue.TargetType, nil
 }
 
-// ClearQuestions clears the "questions" edge to the Question entity.
-func (m *DatabaseMutation) ClearQuestions() {
-	m.clearedquestions = true
+// ClearTargetType clears the value of the "target_type" field.
+func (m *AuditLogMutation) ClearTargetType() {
+	m.target_type = nil
+	m.clearedFields[auditlog.FieldTargetType] = struct{}{}
 }
 
-// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
-func (m *DatabaseMutation) QuestionsCleared() bool {
-	return m.clearedquestions
+// TargetTypeCleared returns if the "target_type" field was cleared in this mutation.
+func (m *AuditLogMutation) TargetTypeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTargetType]
+	return ok
 }
 
-// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
-func (m *DatabaseMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestions == nil {
-		m.removedquestions = make(map[int]struct{})
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditLogMutation) ResetTargetType() {
+	m.target_type = nil
+	delete(m.clearedFields, auditlog.FieldTargetType)
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *AuditLogMutation) SetTargetID(i int) {
+	m.target_id = &i
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *AuditLogMutation) TargetID() (r int, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.questions, ids[i])
-		m.removedquestions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
+	return oldValue.TargetID, nil
 }
 
-// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
-func (m *DatabaseMutation) RemovedQuestionsIDs() (ids []int) {
-	for id := range m.removedquestions {
-		ids = append(ids, id)
+// AddTargetID adds i to the "target_id" field.
+func (m *AuditLogMutation) AddTargetID(i int) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += i
+	} else {
+		m.addtarget_id = &i
 	}
-	return
 }
 
-// QuestionsIDs returns the "questions" edge IDs in the mutation.
-func (m *DatabaseMutation) QuestionsIDs() (ids []int) {
-	for id := range m.questions {
-		ids = append(ids, id)
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *AuditLogMutation) AddedTargetID() (r int, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetQuestions resets all changes to the "questions" edge.
-func (m *DatabaseMutation) ResetQuestions() {
-	m.questions = nil
-	m.clearedquestions = false
-	m.removedquestions = nil
+// ClearTargetID clears the value of the "target_id" field.
+func (m *AuditLogMutation) ClearTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+	m.clearedFields[auditlog.FieldTargetID] = struct{}{}
 }
 
-// Where appends a list predicates to the DatabaseMutation builder.
-func (m *DatabaseMutation) Where(ps ...predicate.Database) {
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTargetID]
+	return ok
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *AuditLogMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+	delete(m.clearedFields, auditlog.FieldTargetID)
+}
+
+// SetChanges sets the "changes" field.
+func (m *AuditLogMutation) SetChanges(mlc []models.AuditLogChange) {
+	m.changes = &mlc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditLogMutation) Changes() (r []models.AuditLogChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldChanges(ctx context.Context) (v []models.AuditLogChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds mlc to the "changes" field.
+func (m *AuditLogMutation) AppendChanges(mlc []models.AuditLogChange) {
+	m.appendchanges = append(m.appendchanges, mlc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *AuditLogMutation) AppendedChanges() ([]models.AuditLogChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *AuditLogMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[auditlog.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *AuditLogMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditLogMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, auditlog.FieldChanges)
+}
+
+// SetTraceID sets the "trace_id" field.
+func (m *AuditLogMutation) SetTraceID(s string) {
+	m.trace_id = &s
+}
+
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *AuditLogMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceID returns the old "trace_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTraceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+	}
+	return oldValue.TraceID, nil
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (m *AuditLogMutation) ClearTraceID() {
+	m.trace_id = nil
+	m.clearedFields[auditlog.FieldTraceID] = struct{}{}
+}
+
+// TraceIDCleared returns if the "trace_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TraceIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTraceID]
+	return ok
+}
+
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *AuditLogMutation) ResetTraceID() {
+	m.trace_id = nil
+	delete(m.clearedFields, auditlog.FieldTraceID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DatabaseMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DatabaseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Database, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -984,36 +1274,51 @@ func (m *DatabaseMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DatabaseMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DatabaseMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Database).
-func (m *DatabaseMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DatabaseMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.slug != nil {
-		fields = append(fields, database.FieldSlug)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.actor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
 	}
-	if m.description != nil {
-		fields = append(fields, database.FieldDescription)
+	if m.impersonator_id != nil {
+		fields = append(fields, auditlog.FieldImpersonatorID)
 	}
-	if m.schema != nil {
-		fields = append(fields, database.FieldSchema)
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
 	}
-	if m.relation_figure != nil {
-		fields = append(fields, database.FieldRelationFigure)
+	if m.scope != nil {
+		fields = append(fields, auditlog.FieldScope)
+	}
+	if m.target_type != nil {
+		fields = append(fields, auditlog.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, auditlog.FieldTargetID)
+	}
+	if m.changes != nil {
+		fields = append(fields, auditlog.FieldChanges)
+	}
+	if m.trace_id != nil {
+		fields = append(fields, auditlog.FieldTraceID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1021,16 +1326,26 @@ func (m *DatabaseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DatabaseMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case database.FieldSlug:
-		return m.Slug()
-	case database.FieldDescription:
-		return m.Description()
-	case database.FieldSchema:
-		return m.Schema()
-	case database.FieldRelationFigure:
-		return m.RelationFigure()
+	case auditlog.FieldActorID:
+		return m.ActorID()
+	case auditlog.FieldImpersonatorID:
+		return m.ImpersonatorID()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldScope:
+		return m.Scope()
+	case auditlog.FieldTargetType:
+		return m.TargetType()
+	case auditlog.FieldTargetID:
+		return m.TargetID()
+	case auditlog.FieldChanges:
+		return m.Changes()
+	case auditlog.FieldTraceID:
+		return m.TraceID()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1038,239 +1353,334 @@ func (m *DatabaseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DatabaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case database.FieldSlug:
-		return m.OldSlug(ctx)
-	case database.FieldDescription:
-		return m.OldDescription(ctx)
-	case database.FieldSchema:
-		return m.OldSchema(ctx)
-	case database.FieldRelationFigure:
-		return m.OldRelationFigure(ctx)
+	case auditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlog.FieldImpersonatorID:
+		return m.OldImpersonatorID(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldScope:
+		return m.OldScope(ctx)
+	case auditlog.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditlog.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case auditlog.FieldChanges:
+		return m.OldChanges(ctx)
+	case auditlog.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Database field %s", name)
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatabaseMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case database.FieldSlug:
+	case auditlog.FieldActorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case auditlog.FieldImpersonatorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpersonatorID(v)
+		return nil
+	case auditlog.FieldOperation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetOperation(v)
 		return nil
-	case database.FieldDescription:
+	case auditlog.FieldScope:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetScope(v)
 		return nil
-	case database.FieldSchema:
+	case auditlog.FieldTargetType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchema(v)
+		m.SetTargetType(v)
 		return nil
-	case database.FieldRelationFigure:
+	case auditlog.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case auditlog.FieldChanges:
+		v, ok := value.([]models.AuditLogChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case auditlog.FieldTraceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRelationFigure(v)
+		m.SetTraceID(v)
+		return nil
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Database field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DatabaseMutation) AddedFields() []string {
-	return nil
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addactor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.addimpersonator_id != nil {
+		fields = append(fields, auditlog.FieldImpersonatorID)
+	}
+	if m.addtarget_id != nil {
+		fields = append(fields, auditlog.FieldTargetID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DatabaseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldActorID:
+		return m.AddedActorID()
+	case auditlog.FieldImpersonatorID:
+		return m.AddedImpersonatorID()
+	case auditlog.FieldTargetID:
+		return m.AddedTargetID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DatabaseMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	}
-	return fmt.Errorf("unknown Database numeric field %s", name)
+	case auditlog.FieldActorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorID(v)
+		return nil
+	case auditlog.FieldImpersonatorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImpersonatorID(v)
+		return nil
+	case auditlog.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DatabaseMutation) ClearedFields() []string {
+func (m *AuditLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(database.FieldDescription) {
-		fields = append(fields, database.FieldDescription)
+	if m.FieldCleared(auditlog.FieldImpersonatorID) {
+		fields = append(fields, auditlog.FieldImpersonatorID)
+	}
+	if m.FieldCleared(auditlog.FieldTargetType) {
+		fields = append(fields, auditlog.FieldTargetType)
+	}
+	if m.FieldCleared(auditlog.FieldTargetID) {
+		fields = append(fields, auditlog.FieldTargetID)
+	}
+	if m.FieldCleared(auditlog.FieldChanges) {
+		fields = append(fields, auditlog.FieldChanges)
+	}
+	if m.FieldCleared(auditlog.FieldTraceID) {
+		fields = append(fields, auditlog.FieldTraceID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DatabaseMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DatabaseMutation) ClearField(name string) error {
+func (m *AuditLogMutation) ClearField(name string) error {
 	switch name {
-	case database.FieldDescription:
-		m.ClearDescription()
+	case auditlog.FieldImpersonatorID:
+		m.ClearImpersonatorID()
+		return nil
+	case auditlog.FieldTargetType:
+		m.ClearTargetType()
+		return nil
+	case auditlog.FieldTargetID:
+		m.ClearTargetID()
+		return nil
+	case auditlog.FieldChanges:
+		m.ClearChanges()
+		return nil
+	case auditlog.FieldTraceID:
+		m.ClearTraceID()
 		return nil
 	}
-	return fmt.Errorf("unknown Database nullable field %s", name)
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DatabaseMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case database.FieldSlug:
-		m.ResetSlug()
+	case auditlog.FieldActorID:
+		m.ResetActorID()
 		return nil
-	case database.FieldDescription:
-		m.ResetDescription()
+	case auditlog.FieldImpersonatorID:
+		m.ResetImpersonatorID()
 		return nil
-	case database.FieldSchema:
-		m.ResetSchema()
+	case auditlog.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case database.FieldRelationFigure:
-		m.ResetRelationFigure()
+	case auditlog.FieldScope:
+		m.ResetScope()
+		return nil
+	case auditlog.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case auditlog.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case auditlog.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case auditlog.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Database field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DatabaseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.questions != nil {
-		edges = append(edges, database.EdgeQuestions)
-	}
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DatabaseMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case database.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.questions))
-		for id := range m.questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DatabaseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedquestions != nil {
-		edges = append(edges, database.EdgeQuestions)
-	}
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DatabaseMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case database.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquestions))
-		for id := range m.removedquestions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DatabaseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedquestions {
-		edges = append(edges, database.EdgeQuestions)
-	}
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DatabaseMutation) EdgeCleared(name string) bool {
-	switch name {
-	case database.EdgeQuestions:
-		return m.clearedquestions
-	}
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DatabaseMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Database unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DatabaseMutation) ResetEdge(name string) error {
-	switch name {
-	case database.EdgeQuestions:
-		m.ResetQuestions()
-		return nil
-	}
-	return fmt.Errorf("unknown Database edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// EventMutation represents an operation that mutates the Event nodes in the graph.
-type EventMutation struct {
+// CheatRecordMutation represents an operation that mutates the CheatRecord nodes in the graph.
+type CheatRecordMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	_type         *string
-	triggered_at  *time.Time
-	payload       *map[string]interface{}
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Event, error)
-	predicates    []predicate.Event
+	op              Op
+	typ             string
+	id              *int
+	kind            *cheatrecord.Kind
+	state           *cheatrecord.State
+	reason          *string
+	resolved_reason *string
+	reviewed_at     *time.Time
+	resolved_at     *time.Time
+	cheated_at      *time.Time
+	evidence        **models.CheatEvidence
+	detection_key   *string
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	reporter        *int
+	clearedreporter bool
+	done            bool
+	oldValue        func(context.Context) (*CheatRecord, error)
+	predicates      []predicate.CheatRecord
 }
 
-var _ ent.Mutation = (*EventMutation)(nil)
+var _ ent.Mutation = (*CheatRecordMutation)(nil)
 
-// eventOption allows management of the mutation configuration using functional options.
-type eventOption func(*EventMutation)
+// cheatrecordOption allows management of the mutation configuration using functional options.
+type cheatrecordOption func(*CheatRecordMutation)
 
-// newEventMutation creates new mutation for the Event entity.
-func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
-	m := &EventMutation{
+// newCheatRecordMutation creates new mutation for the CheatRecord entity.
+func newCheatRecordMutation(c config, op Op, opts ...cheatrecordOption) *CheatRecordMutation {
+	m := &CheatRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEvent,
+		typ:           TypeCheatRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1279,20 +1689,20 @@ func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
 	return m
 }
 
-// withEventID sets the ID field of the mutation.
-func withEventID(id int) eventOption {
-	return func(m *EventMutation) {
+// withCheatRecordID sets the ID field of the mutation.
+func withCheatRecordID(id int) cheatrecordOption {
+	return func(m *CheatRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Event
+			value *CheatRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Event, error) {
+		m.oldValue = func(ctx context.Context) (*CheatRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Event.Get(ctx, id)
+					value, err = m.Client().CheatRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1301,10 +1711,10 @@ func withEventID(id int) eventOption {
 	}
 }
 
-// withEvent sets the old Event of the mutation.
-func withEvent(node *Event) eventOption {
-	return func(m *EventMutation) {
-		m.oldValue = func(context.Context) (*Event, error) {
+// withCheatRecord sets the old CheatRecord of the mutation.
+func withCheatRecord(node *CheatRecord) cheatrecordOption {
+	return func(m *CheatRecordMutation) {
+		m.oldValue = func(context.Context) (*CheatRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1313,7 +1723,7 @@ func withEvent(node *Event) eventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventMutation) Client() *Client {
+func (m CheatRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1321,7 +1731,7 @@ func (m EventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventMutation) Tx() (*Tx, error) {
+func (m CheatRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1332,7 +1742,7 @@ func (m EventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventMutation) ID() (id int, exists bool) {
+func (m *CheatRecordMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1343,7 +1753,7 @@ func (m *EventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CheatRecordMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1352,184 +1762,428 @@ func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CheatRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *EventMutation) SetUserID(i int) {
-	m.user = &i
+// SetKind sets the "kind" field.
+func (m *CheatRecordMutation) SetKind(c cheatrecord.Kind) {
+	m.kind = &c
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *EventMutation) UserID() (r int, exists bool) {
-	v := m.user
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CheatRecordMutation) Kind() (r cheatrecord.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *CheatRecordMutation) OldKind(ctx context.Context) (v cheatrecord.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Kind, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *EventMutation) ResetUserID() {
-	m.user = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *CheatRecordMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetType sets the "type" field.
-func (m *EventMutation) SetType(s string) {
-	m._type = &s
+// SetState sets the "state" field.
+func (m *CheatRecordMutation) SetState(c cheatrecord.State) {
+	m.state = &c
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *EventMutation) GetType() (r string, exists bool) {
-	v := m._type
+// State returns the value of the "state" field in the mutation.
+func (m *CheatRecordMutation) State() (r cheatrecord.State, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CheatRecordMutation) OldState(ctx context.Context) (v cheatrecord.State, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.State, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *EventMutation) ResetType() {
-	m._type = nil
+// ResetState resets all changes to the "state" field.
+func (m *CheatRecordMutation) ResetState() {
+	m.state = nil
 }
 
-// SetTriggeredAt sets the "triggered_at" field.
-func (m *EventMutation) SetTriggeredAt(t time.Time) {
-	m.triggered_at = &t
+// SetReason sets the "reason" field.
+func (m *CheatRecordMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// TriggeredAt returns the value of the "triggered_at" field in the mutation.
-func (m *EventMutation) TriggeredAt() (r time.Time, exists bool) {
-	v := m.triggered_at
+// Reason returns the value of the "reason" field in the mutation.
+func (m *CheatRecordMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTriggeredAt returns the old "triggered_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTriggeredAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheatRecordMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.TriggeredAt, nil
+	return oldValue.Reason, nil
 }
 
-// ResetTriggeredAt resets all changes to the "triggered_at" field.
-func (m *EventMutation) ResetTriggeredAt() {
-	m.triggered_at = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *CheatRecordMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetPayload sets the "payload" field.
-func (m *EventMutation) SetPayload(value map[string]interface{}) {
-	m.payload = &value
+// SetResolvedReason sets the "resolved_reason" field.
+func (m *CheatRecordMutation) SetResolvedReason(s string) {
+	m.resolved_reason = &s
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *EventMutation) Payload() (r map[string]interface{}, exists bool) {
-	v := m.payload
+// ResolvedReason returns the value of the "resolved_reason" field in the mutation.
+func (m *CheatRecordMutation) ResolvedReason() (r string, exists bool) {
+	v := m.resolved_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedReason returns the old "resolved_reason" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *CheatRecordMutation) OldResolvedReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
+		return v, errors.New("OldResolvedReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedReason: %w", err)
 	}
-	return oldValue.Payload, nil
+	return oldValue.ResolvedReason, nil
 }
 
-// ClearPayload clears the value of the "payload" field.
-func (m *EventMutation) ClearPayload() {
-	m.payload = nil
-	m.clearedFields[event.FieldPayload] = struct{}{}
+// ClearResolvedReason clears the value of the "resolved_reason" field.
+func (m *CheatRecordMutation) ClearResolvedReason() {
+	m.resolved_reason = nil
+	m.clearedFields[cheatrecord.FieldResolvedReason] = struct{}{}
 }
 
-// PayloadCleared returns if the "payload" field was cleared in this mutation.
-func (m *EventMutation) PayloadCleared() bool {
-	_, ok := m.clearedFields[event.FieldPayload]
+// ResolvedReasonCleared returns if the "resolved_reason" field was cleared in this mutation.
+func (m *CheatRecordMutation) ResolvedReasonCleared() bool {
+	_, ok := m.clearedFields[cheatrecord.FieldResolvedReason]
 	return ok
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *EventMutation) ResetPayload() {
-	m.payload = nil
-	delete(m.clearedFields, event.FieldPayload)
+// ResetResolvedReason resets all changes to the "resolved_reason" field.
+func (m *CheatRecordMutation) ResetResolvedReason() {
+	m.resolved_reason = nil
+	delete(m.clearedFields, cheatrecord.FieldResolvedReason)
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *CheatRecordMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *CheatRecordMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheatRecordMutation) OldReviewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *CheatRecordMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[cheatrecord.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *CheatRecordMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[cheatrecord.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *CheatRecordMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, cheatrecord.FieldReviewedAt)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *CheatRecordMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *CheatRecordMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheatRecordMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *CheatRecordMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[cheatrecord.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *CheatRecordMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[cheatrecord.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *CheatRecordMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, cheatrecord.FieldResolvedAt)
+}
+
+// SetCheatedAt sets the "cheated_at" field.
+func (m *CheatRecordMutation) SetCheatedAt(t time.Time) {
+	m.cheated_at = &t
+}
+
+// CheatedAt returns the value of the "cheated_at" field in the mutation.
+func (m *CheatRecordMutation) CheatedAt() (r time.Time, exists bool) {
+	v := m.cheated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheatedAt returns the old "cheated_at" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheatRecordMutation) OldCheatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheatedAt: %w", err)
+	}
+	return oldValue.CheatedAt, nil
+}
+
+// ResetCheatedAt resets all changes to the "cheated_at" field.
+func (m *CheatRecordMutation) ResetCheatedAt() {
+	m.cheated_at = nil
+}
+
+// SetEvidence sets the "evidence" field.
+func (m *CheatRecordMutation) SetEvidence(me *models.CheatEvidence) {
+	m.evidence = &me
+}
+
+// Evidence returns the value of the "evidence" field in the mutation.
+func (m *CheatRecordMutation) Evidence() (r *models.CheatEvidence, exists bool) {
+	v := m.evidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvidence returns the old "evidence" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheatRecordMutation) OldEvidence(ctx context.Context) (v *models.CheatEvidence, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvidence: %w", err)
+	}
+	return oldValue.Evidence, nil
+}
+
+// ClearEvidence clears the value of the "evidence" field.
+func (m *CheatRecordMutation) ClearEvidence() {
+	m.evidence = nil
+	m.clearedFields[cheatrecord.FieldEvidence] = struct{}{}
+}
+
+// EvidenceCleared returns if the "evidence" field was cleared in this mutation.
+func (m *CheatRecordMutation) EvidenceCleared() bool {
+	_, ok := m.clearedFields[cheatrecord.FieldEvidence]
+	return ok
+}
+
+// ResetEvidence resets all changes to the "evidence" field.
+func (m *CheatRecordMutation) ResetEvidence() {
+	m.evidence = nil
+	delete(m.clearedFields, cheatrecord.FieldEvidence)
+}
+
+// SetDetectionKey sets the "detection_key" field.
+func (m *CheatRecordMutation) SetDetectionKey(s string) {
+	m.detection_key = &s
+}
+
+// DetectionKey returns the value of the "detection_key" field in the mutation.
+func (m *CheatRecordMutation) DetectionKey() (r string, exists bool) {
+	v := m.detection_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectionKey returns the old "detection_key" field's value of the CheatRecord entity.
+// If the CheatRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheatRecordMutation) OldDetectionKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectionKey: %w", err)
+	}
+	return oldValue.DetectionKey, nil
+}
+
+// ClearDetectionKey clears the value of the "detection_key" field.
+func (m *CheatRecordMutation) ClearDetectionKey() {
+	m.detection_key = nil
+	m.clearedFields[cheatrecord.FieldDetectionKey] = struct{}{}
+}
+
+// DetectionKeyCleared returns if the "detection_key" field was cleared in this mutation.
+func (m *CheatRecordMutation) DetectionKeyCleared() bool {
+	_, ok := m.clearedFields[cheatrecord.FieldDetectionKey]
+	return ok
+}
+
+// ResetDetectionKey resets all changes to the "detection_key" field.
+func (m *CheatRecordMutation) ResetDetectionKey() {
+	m.detection_key = nil
+	delete(m.clearedFields, cheatrecord.FieldDetectionKey)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *CheatRecordMutation) SetUserID(id int) {
+	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *EventMutation) ClearUser() {
+func (m *CheatRecordMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[event.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *EventMutation) UserCleared() bool {
+func (m *CheatRecordMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
+// UserID returns the "user" edge ID in the mutation.
+func (m *CheatRecordMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *EventMutation) UserIDs() (ids []int) {
+func (m *CheatRecordMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1537,20 +2191,59 @@ func (m *EventMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *EventMutation) ResetUser() {
+func (m *CheatRecordMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the EventMutation builder.
-func (m *EventMutation) Where(ps ...predicate.Event) {
+// SetReporterID sets the "reporter" edge to the User entity by id.
+func (m *CheatRecordMutation) SetReporterID(id int) {
+	m.reporter = &id
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (m *CheatRecordMutation) ClearReporter() {
+	m.clearedreporter = true
+}
+
+// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
+func (m *CheatRecordMutation) ReporterCleared() bool {
+	return m.clearedreporter
+}
+
+// ReporterID returns the "reporter" edge ID in the mutation.
+func (m *CheatRecordMutation) ReporterID() (id int, exists bool) {
+	if m.reporter != nil {
+		return *m.reporter, true
+	}
+	return
+}
+
+// ReporterIDs returns the "reporter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReporterID instead. It exists only for internal usage by the builders.
+func (m *CheatRecordMutation) ReporterIDs() (ids []int) {
+	if id := m.reporter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReporter resets all changes to the "reporter" edge.
+func (m *CheatRecordMutation) ResetReporter() {
+	m.reporter = nil
+	m.clearedreporter = false
+}
+
+// Where appends a list predicates to the CheatRecordMutation builder.
+func (m *CheatRecordMutation) Where(ps ...predicate.CheatRecord) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CheatRecordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Event, len(ps))
+func (m *CheatRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CheatRecord, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1558,36 +2251,51 @@ func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EventMutation) Op() Op {
+func (m *CheatRecordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EventMutation) SetOp(op Op) {
+func (m *CheatRecordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Event).
-func (m *EventMutation) Type() string {
+// Type returns the node type of this mutation (CheatRecord).
+func (m *CheatRecordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EventMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.user != nil {
-		fields = append(fields, event.FieldUserID)
+func (m *CheatRecordMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.kind != nil {
+		fields = append(fields, cheatrecord.FieldKind)
 	}
-	if m._type != nil {
-		fields = append(fields, event.FieldType)
+	if m.state != nil {
+		fields = append(fields, cheatrecord.FieldState)
 	}
-	if m.triggered_at != nil {
-		fields = append(fields, event.FieldTriggeredAt)
+	if m.reason != nil {
+		fields = append(fields, cheatrecord.FieldReason)
 	}
-	if m.payload != nil {
-		fields = append(fields, event.FieldPayload)
+	if m.resolved_reason != nil {
+		fields = append(fields, cheatrecord.FieldResolvedReason)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, cheatrecord.FieldReviewedAt)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, cheatrecord.FieldResolvedAt)
+	}
+	if m.cheated_at != nil {
+		fields = append(fields, cheatrecord.FieldCheatedAt)
+	}
+	if m.evidence != nil {
+		fields = append(fields, cheatrecord.FieldEvidence)
+	}
+	if m.detection_key != nil {
+		fields = append(fields, cheatrecord.FieldDetectionKey)
 	}
 	return fields
 }
@@ -1595,16 +2303,26 @@ func (m *EventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EventMutation) Field(name string) (ent.Value, bool) {
+func (m *CheatRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case event.FieldUserID:
-		return m.UserID()
-	case event.FieldType:
-		return m.GetType()
-	case event.FieldTriggeredAt:
-		return m.TriggeredAt()
-	case event.FieldPayload:
-		return m.Payload()
+	case cheatrecord.FieldKind:
+		return m.Kind()
+	case cheatrecord.FieldState:
+		return m.State()
+	case cheatrecord.FieldReason:
+		return m.Reason()
+	case cheatrecord.FieldResolvedReason:
+		return m.ResolvedReason()
+	case cheatrecord.FieldReviewedAt:
+		return m.ReviewedAt()
+	case cheatrecord.FieldResolvedAt:
+		return m.ResolvedAt()
+	case cheatrecord.FieldCheatedAt:
+		return m.CheatedAt()
+	case cheatrecord.FieldEvidence:
+		return m.Evidence()
+	case cheatrecord.FieldDetectionKey:
+		return m.DetectionKey()
 	}
 	return nil, false
 }
@@ -1612,235 +2330,333 @@ func (m *EventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CheatRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case event.FieldUserID:
-		return m.OldUserID(ctx)
-	case event.FieldType:
-		return m.OldType(ctx)
-	case event.FieldTriggeredAt:
-		return m.OldTriggeredAt(ctx)
-	case event.FieldPayload:
-		return m.OldPayload(ctx)
+	case cheatrecord.FieldKind:
+		return m.OldKind(ctx)
+	case cheatrecord.FieldState:
+		return m.OldState(ctx)
+	case cheatrecord.FieldReason:
+		return m.OldReason(ctx)
+	case cheatrecord.FieldResolvedReason:
+		return m.OldResolvedReason(ctx)
+	case cheatrecord.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case cheatrecord.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case cheatrecord.FieldCheatedAt:
+		return m.OldCheatedAt(ctx)
+	case cheatrecord.FieldEvidence:
+		return m.OldEvidence(ctx)
+	case cheatrecord.FieldDetectionKey:
+		return m.OldDetectionKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown Event field %s", name)
+	return nil, fmt.Errorf("unknown CheatRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) SetField(name string, value ent.Value) error {
+func (m *CheatRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldUserID:
-		v, ok := value.(int)
+	case cheatrecord.FieldKind:
+		v, ok := value.(cheatrecord.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetKind(v)
 		return nil
-	case event.FieldType:
+	case cheatrecord.FieldState:
+		v, ok := value.(cheatrecord.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case cheatrecord.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetReason(v)
 		return nil
-	case event.FieldTriggeredAt:
+	case cheatrecord.FieldResolvedReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedReason(v)
+		return nil
+	case cheatrecord.FieldReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTriggeredAt(v)
+		m.SetReviewedAt(v)
 		return nil
-	case event.FieldPayload:
-		v, ok := value.(map[string]interface{})
+	case cheatrecord.FieldResolvedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayload(v)
+		m.SetResolvedAt(v)
+		return nil
+	case cheatrecord.FieldCheatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheatedAt(v)
+		return nil
+	case cheatrecord.FieldEvidence:
+		v, ok := value.(*models.CheatEvidence)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvidence(v)
+		return nil
+	case cheatrecord.FieldDetectionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectionKey(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown CheatRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EventMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *CheatRecordMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *CheatRecordMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) AddField(name string, value ent.Value) error {
+func (m *CheatRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Event numeric field %s", name)
+	return fmt.Errorf("unknown CheatRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EventMutation) ClearedFields() []string {
+func (m *CheatRecordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(event.FieldPayload) {
-		fields = append(fields, event.FieldPayload)
+	if m.FieldCleared(cheatrecord.FieldResolvedReason) {
+		fields = append(fields, cheatrecord.FieldResolvedReason)
+	}
+	if m.FieldCleared(cheatrecord.FieldReviewedAt) {
+		fields = append(fields, cheatrecord.FieldReviewedAt)
+	}
+	if m.FieldCleared(cheatrecord.FieldResolvedAt) {
+		fields = append(fields, cheatrecord.FieldResolvedAt)
+	}
+	if m.FieldCleared(cheatrecord.FieldEvidence) {
+		fields = append(fields, cheatrecord.FieldEvidence)
+	}
+	if m.FieldCleared(cheatrecord.FieldDetectionKey) {
+		fields = append(fields, cheatrecord.FieldDetectionKey)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EventMutation) FieldCleared(name string) bool {
+func (m *CheatRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EventMutation) ClearField(name string) error {
+func (m *CheatRecordMutation) ClearField(name string) error {
 	switch name {
-	case event.FieldPayload:
-		m.ClearPayload()
+	case cheatrecord.FieldResolvedReason:
+		m.ClearResolvedReason()
+		return nil
+	case cheatrecord.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	case cheatrecord.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case cheatrecord.FieldEvidence:
+		m.ClearEvidence()
+		return nil
+	case cheatrecord.FieldDetectionKey:
+		m.ClearDetectionKey()
 		return nil
 	}
-	return fmt.Errorf("unknown Event nullable field %s", name)
+	return fmt.Errorf("unknown CheatRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EventMutation) ResetField(name string) error {
+func (m *CheatRecordMutation) ResetField(name string) error {
 	switch name {
-	case event.FieldUserID:
-		m.ResetUserID()
+	case cheatrecord.FieldKind:
+		m.ResetKind()
 		return nil
-	case event.FieldType:
-		m.ResetType()
+	case cheatrecord.FieldState:
+		m.ResetState()
 		return nil
-	case event.FieldTriggeredAt:
-		m.ResetTriggeredAt()
+	case cheatrecord.FieldReason:
+		m.ResetReason()
 		return nil
-	case event.FieldPayload:
-		m.ResetPayload()
+	case cheatrecord.FieldResolvedReason:
+		m.ResetResolvedReason()
+		return nil
+	case cheatrecord.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case cheatrecord.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case cheatrecord.FieldCheatedAt:
+		m.ResetCheatedAt()
+		return nil
+	case cheatrecord.FieldEvidence:
+		m.ResetEvidence()
+		return nil
+	case cheatrecord.FieldDetectionKey:
+		m.ResetDetectionKey()
 		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown CheatRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CheatRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, event.EdgeUser)
+		edges = append(edges, cheatrecord.EdgeUser)
+	}
+	if m.reporter != nil {
+		edges = append(edges, cheatrecord.EdgeReporter)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EventMutation) AddedIDs(name string) []ent.Value {
+func (m *CheatRecordMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case event.EdgeUser:
+	case cheatrecord.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case cheatrecord.EdgeReporter:
+		if id := m.reporter; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CheatRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+func (m *CheatRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CheatRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, event.EdgeUser)
+		edges = append(edges, cheatrecord.EdgeUser)
+	}
+	if m.clearedreporter {
+		edges = append(edges, cheatrecord.EdgeReporter)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EventMutation) EdgeCleared(name string) bool {
+func (m *CheatRecordMutation) EdgeCleared(name string) bool {
 	switch name {
-	case event.EdgeUser:
+	case cheatrecord.EdgeUser:
 		return m.cleareduser
+	case cheatrecord.EdgeReporter:
+		return m.clearedreporter
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EventMutation) ClearEdge(name string) error {
+func (m *CheatRecordMutation) ClearEdge(name string) error {
 	switch name {
-	case event.EdgeUser:
+	case cheatrecord.EdgeUser:
 		m.ClearUser()
 		return nil
+	case cheatrecord.EdgeReporter:
+		m.ClearReporter()
+		return nil
 	}
-	return fmt.Errorf("unknown Event unique edge %s", name)
+	return fmt.Errorf("unknown CheatRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EventMutation) ResetEdge(name string) error {
+func (m *CheatRecordMutation) ResetEdge(name string) error {
 	switch name {
-	case event.EdgeUser:
+	case cheatrecord.EdgeUser:
 		m.ResetUser()
 		return nil
+	case cheatrecord.EdgeReporter:
+		m.ResetReporter()
+		return nil
 	}
-	return fmt.Errorf("unknown Event edge %s", name)
+	return fmt.Errorf("unknown CheatRecord edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// DatabaseMutation represents an operation that mutates the Database nodes in the graph.
+type DatabaseMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	created_at        *time.Time
-	updated_at        *time.Time
-	deleted_at        *time.Time
-	name              *string
-	description       *string
-	clearedFields     map[string]struct{}
-	scope_sets        map[int]struct{}
-	removedscope_sets map[int]struct{}
-	clearedscope_sets bool
-	done              bool
-	oldValue          func(context.Context) (*Group, error)
-	predicates        []predicate.Group
+	op               Op
+	typ              string
+	id               *int
+	slug             *string
+	description      *string
+	schema           *string
+	relation_figure  *string
+	clearedFields    map[string]struct{}
+	questions        map[int]struct{}
+	removedquestions map[int]struct{}
+	clearedquestions bool
+	done             bool
+	oldValue         func(context.Context) (*Database, error)
+	predicates       []predicate.Database
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*DatabaseMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// databaseOption allows management of the mutation configuration using functional options.
+type databaseOption func(*DatabaseMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newDatabaseMutation creates new mutation for the Database entity.
+func newDatabaseMutation(c config, op Op, opts ...databaseOption) *DatabaseMutation {
+	m := &DatabaseMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeDatabase,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1849,20 +2665,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withDatabaseID sets the ID field of the mutation.
+func withDatabaseID(id int) databaseOption {
+	return func(m *DatabaseMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *Database
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*Database, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().Database.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1871,10 +2687,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withDatabase sets the old Database of the mutation.
+func withDatabase(node *Database) databaseOption {
+	return func(m *DatabaseMutation) {
+		m.oldValue = func(context.Context) (*Database, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1883,7 +2699,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m DatabaseMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1891,7 +2707,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m DatabaseMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1902,7 +2718,7 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *DatabaseMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1913,7 +2729,7 @@ func (m *GroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DatabaseMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1922,281 +2738,232 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Database.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetSlug sets the "slug" field.
+func (m *DatabaseMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Slug returns the value of the "slug" field in the mutation.
+func (m *DatabaseMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the Database entity.
+// If the Database object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DatabaseMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Slug, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetSlug resets all changes to the "slug" field.
+func (m *DatabaseMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *GroupMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetDescription sets the "description" field.
+func (m *DatabaseMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Description returns the value of the "description" field in the mutation.
+func (m *DatabaseMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Database entity.
+// If the Database object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DatabaseMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Description, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *GroupMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[group.FieldDeletedAt] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *DatabaseMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[database.FieldDescription] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *GroupMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[group.FieldDeletedAt]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DatabaseMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[database.FieldDescription]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *GroupMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, group.FieldDeletedAt)
+// ResetDescription resets all changes to the "description" field.
+func (m *DatabaseMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, database.FieldDescription)
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetSchema sets the "schema" field.
+func (m *DatabaseMutation) SetSchema(s string) {
+	m.schema = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Schema returns the value of the "schema" field in the mutation.
+func (m *DatabaseMutation) Schema() (r string, exists bool) {
+	v := m.schema
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldSchema returns the old "schema" field's value of the Database entity.
+// If the Database object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DatabaseMutation) OldSchema(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSchema is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSchema requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Schema, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+// ResetSchema resets all changes to the "schema" field.
+func (m *DatabaseMutation) ResetSchema() {
+	m.schema = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *GroupMutation) SetDescription(s string) {
-	m.description = &s
+// SetRelationFigure sets the "relation_figure" field.
+func (m *DatabaseMutation) SetRelationFigure(s string) {
+	m.relation_figure = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *GroupMutation) Description() (r string, exists bool) {
-	v := m.description
+// RelationFigure returns the value of the "relation_figure" field in the mutation.
+func (m *DatabaseMutation) RelationFigure() (r string, exists bool) {
+	v := m.relation_figure
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldRelationFigure returns the old "relation_figure" field's value of the Database entity.
+// If the Database object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *DatabaseMutation) OldRelationFigure(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldRelationFigure is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldRelationFigure requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldRelationFigure: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *GroupMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[group.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *GroupMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[group.FieldDescription]
-	return ok
+	return oldValue.RelationFigure, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *GroupMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, group.FieldDescription)
+// ResetRelationFigure resets all changes to the "relation_figure" field.
+func (m *DatabaseMutation) ResetRelationFigure() {
+	m.relation_figure = nil
 }
 
-// AddScopeSetIDs adds the "scope_sets" edge to the ScopeSet entity by ids.
-func (m *GroupMutation) AddScopeSetIDs(ids ...int) {
-	if m.scope_sets == nil {
-		m.scope_sets = make(map[int]struct{})
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *DatabaseMutation) AddQuestionIDs(ids ...int) {
+	if m.questions == nil {
+		m.questions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.scope_sets[ids[i]] = struct{}{}
+		m.questions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearScopeSets clears the "scope_sets" edge to the ScopeSet entity.
-func (m *GroupMutation) ClearScopeSets() {
-	m.clearedscope_sets = true
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *DatabaseMutation) ClearQuestions() {
+	m.clearedquestions = true
 }
 
-// ScopeSetsCleared reports if the "scope_sets" edge to the ScopeSet entity was cleared.
-func (m *GroupMutation) ScopeSetsCleared() bool {
-	return m.clearedscope_sets
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *DatabaseMutation) QuestionsCleared() bool {
+	return m.clearedquestions
 }
 
-// RemoveScopeSetIDs removes the "scope_sets" edge to the ScopeSet entity by IDs.
-func (m *GroupMutation) RemoveScopeSetIDs(ids ...int) {
-	if m.removedscope_sets == nil {
-		m.removedscope_sets = make(map[int]struct{})
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *DatabaseMutation) RemoveQuestionIDs(ids ...int) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.scope_sets, ids[i])
-		m.removedscope_sets[ids[i]] = struct{}{}
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedScopeSets returns the removed IDs of the "scope_sets" edge to the ScopeSet entity.
-func (m *GroupMutation) RemovedScopeSetsIDs() (ids []int) {
-	for id := range m.removedscope_sets {
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *DatabaseMutation) RemovedQuestionsIDs() (ids []int) {
+	for id := range m.removedquestions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ScopeSetsIDs returns the "scope_sets" edge IDs in the mutation.
-func (m *GroupMutation) ScopeSetsIDs() (ids []int) {
-	for id := range m.scope_sets {
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *DatabaseMutation) QuestionsIDs() (ids []int) {
+	for id := range m.questions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetScopeSets resets all changes to the "scope_sets" edge.
-func (m *GroupMutation) ResetScopeSets() {
-	m.scope_sets = nil
-	m.clearedscope_sets = false
-	m.removedscope_sets = nil
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *DatabaseMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// Where appends a list predicates to the DatabaseMutation builder.
+func (m *DatabaseMutation) Where(ps ...predicate.Database) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DatabaseMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *DatabaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Database, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2204,39 +2971,36 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *DatabaseMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *DatabaseMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (Database).
+func (m *DatabaseMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+func (m *DatabaseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.slug != nil {
+		fields = append(fields, database.FieldSlug)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, group.FieldDeletedAt)
+	if m.description != nil {
+		fields = append(fields, database.FieldDescription)
 	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+	if m.schema != nil {
+		fields = append(fields, database.FieldSchema)
 	}
-	if m.description != nil {
-		fields = append(fields, group.FieldDescription)
+	if m.relation_figure != nil {
+		fields = append(fields, database.FieldRelationFigure)
 	}
 	return fields
 }
@@ -2244,18 +3008,16 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *DatabaseMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldCreatedAt:
-		return m.CreatedAt()
-	case group.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case group.FieldDeletedAt:
-		return m.DeletedAt()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldDescription:
+	case database.FieldSlug:
+		return m.Slug()
+	case database.FieldDescription:
 		return m.Description()
+	case database.FieldSchema:
+		return m.Schema()
+	case database.FieldRelationFigure:
+		return m.RelationFigure()
 	}
 	return nil, false
 }
@@ -2263,161 +3025,143 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DatabaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case group.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldDescription:
+	case database.FieldSlug:
+		return m.OldSlug(ctx)
+	case database.FieldDescription:
 		return m.OldDescription(ctx)
+	case database.FieldSchema:
+		return m.OldSchema(ctx)
+	case database.FieldRelationFigure:
+		return m.OldRelationFigure(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown Database field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *DatabaseMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case group.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case database.FieldSlug:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetSlug(v)
 		return nil
-	case group.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case database.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetDescription(v)
 		return nil
-	case group.FieldName:
+	case database.FieldSchema:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSchema(v)
 		return nil
-	case group.FieldDescription:
+	case database.FieldRelationFigure:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetRelationFigure(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Database field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *DatabaseMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DatabaseMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *DatabaseMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown Database numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
+func (m *DatabaseMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(group.FieldDeletedAt) {
-		fields = append(fields, group.FieldDeletedAt)
-	}
-	if m.FieldCleared(group.FieldDescription) {
-		fields = append(fields, group.FieldDescription)
+	if m.FieldCleared(database.FieldDescription) {
+		fields = append(fields, database.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *DatabaseMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
+func (m *DatabaseMutation) ClearField(name string) error {
 	switch name {
-	case group.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case group.FieldDescription:
+	case database.FieldDescription:
 		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	return fmt.Errorf("unknown Database nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *DatabaseMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case group.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case database.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case group.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case database.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case group.FieldName:
-		m.ResetName()
+	case database.FieldSchema:
+		m.ResetSchema()
 		return nil
-	case group.FieldDescription:
-		m.ResetDescription()
+	case database.FieldRelationFigure:
+		m.ResetRelationFigure()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Database field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
+func (m *DatabaseMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.scope_sets != nil {
-		edges = append(edges, group.EdgeScopeSets)
+	if m.questions != nil {
+		edges = append(edges, database.EdgeQuestions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *DatabaseMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeScopeSets:
-		ids := make([]ent.Value, 0, len(m.scope_sets))
-		for id := range m.scope_sets {
+	case database.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2426,21 +3170,21 @@ func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
+func (m *DatabaseMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedscope_sets != nil {
-		edges = append(edges, group.EdgeScopeSets)
+	if m.removedquestions != nil {
+		edges = append(edges, database.EdgeQuestions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *DatabaseMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeScopeSets:
-		ids := make([]ent.Value, 0, len(m.removedscope_sets))
-		for id := range m.removedscope_sets {
+	case database.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2449,72 +3193,74 @@ func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
+func (m *DatabaseMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedscope_sets {
-		edges = append(edges, group.EdgeScopeSets)
+	if m.clearedquestions {
+		edges = append(edges, database.EdgeQuestions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *DatabaseMutation) EdgeCleared(name string) bool {
 	switch name {
-	case group.EdgeScopeSets:
-		return m.clearedscope_sets
+	case database.EdgeQuestions:
+		return m.clearedquestions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
+func (m *DatabaseMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+	return fmt.Errorf("unknown Database unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
+func (m *DatabaseMutation) ResetEdge(name string) error {
 	switch name {
-	case group.EdgeScopeSets:
-		m.ResetScopeSets()
+	case database.EdgeQuestions:
+		m.ResetQuestions()
 		return nil
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	return fmt.Errorf("unknown Database edge %s", name)
 }
 
-// PointMutation represents an operation that mutates the Point nodes in the graph.
-type PointMutation struct {
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	points        *int
-	addpoints     *int
-	granted_at    *time.Time
-	description   *string
+	_type         *string
+	triggered_at  *time.Time
+	payload       *map[string]interface{}
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
+	outbox        map[int]struct{}
+	removedoutbox map[int]struct{}
+	clearedoutbox bool
 	done          bool
-	oldValue      func(context.Context) (*Point, error)
-	predicates    []predicate.Point
+	oldValue      func(context.Context) (*Event, error)
+	predicates    []predicate.Event
 }
 
-var _ ent.Mutation = (*PointMutation)(nil)
+var _ ent.Mutation = (*EventMutation)(nil)
 
-// pointOption allows management of the mutation configuration using functional options.
-type pointOption func(*PointMutation)
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
 
-// newPointMutation creates new mutation for the Point entity.
-func newPointMutation(c config, op Op, opts ...pointOption) *PointMutation {
-	m := &PointMutation{
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePoint,
+		typ:           TypeEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2523,20 +3269,20 @@ func newPointMutation(c config, op Op, opts ...pointOption) *PointMutation {
 	return m
 }
 
-// withPointID sets the ID field of the mutation.
-func withPointID(id int) pointOption {
-	return func(m *PointMutation) {
+// withEventID sets the ID field of the mutation.
+func withEventID(id int) eventOption {
+	return func(m *EventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Point
+			value *Event
 		)
-		m.oldValue = func(ctx context.Context) (*Point, error) {
+		m.oldValue = func(ctx context.Context) (*Event, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Point.Get(ctx, id)
+					value, err = m.Client().Event.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2545,10 +3291,10 @@ func withPointID(id int) pointOption {
 	}
 }
 
-// withPoint sets the old Point of the mutation.
-func withPoint(node *Point) pointOption {
-	return func(m *PointMutation) {
-		m.oldValue = func(context.Context) (*Point, error) {
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2557,7 +3303,7 @@ func withPoint(node *Point) pointOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PointMutation) Client() *Client {
+func (m EventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2565,7 +3311,7 @@ func (m PointMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PointMutation) Tx() (*Tx, error) {
+func (m EventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2576,7 +3322,7 @@ func (m PointMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PointMutation) ID() (id int, exists bool) {
+func (m *EventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2587,7 +3333,7 @@ func (m *PointMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PointMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2596,180 +3342,184 @@ func (m *PointMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Point.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPoints sets the "points" field.
-func (m *PointMutation) SetPoints(i int) {
-	m.points = &i
-	m.addpoints = nil
+// SetUserID sets the "user_id" field.
+func (m *EventMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Points returns the value of the "points" field in the mutation.
-func (m *PointMutation) Points() (r int, exists bool) {
-	v := m.points
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EventMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPoints returns the old "points" field's value of the Point entity.
-// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PointMutation) OldPoints(ctx context.Context) (v int, err error) {
+func (m *EventMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPoints requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Points, nil
+	return oldValue.UserID, nil
 }
 
-// AddPoints adds i to the "points" field.
-func (m *PointMutation) AddPoints(i int) {
-	if m.addpoints != nil {
-		*m.addpoints += i
-	} else {
-		m.addpoints = &i
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EventMutation) ResetUserID() {
+	m.user = nil
 }
 
-// AddedPoints returns the value that was added to the "points" field in this mutation.
-func (m *PointMutation) AddedPoints() (r int, exists bool) {
-	v := m.addpoints
+// SetType sets the "type" field.
+func (m *EventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPoints resets all changes to the "points" field.
-func (m *PointMutation) ResetPoints() {
-	m.points = nil
-	m.addpoints = nil
+// OldType returns the old "type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// SetGrantedAt sets the "granted_at" field.
-func (m *PointMutation) SetGrantedAt(t time.Time) {
-	m.granted_at = &t
+// ResetType resets all changes to the "type" field.
+func (m *EventMutation) ResetType() {
+	m._type = nil
 }
 
-// GrantedAt returns the value of the "granted_at" field in the mutation.
-func (m *PointMutation) GrantedAt() (r time.Time, exists bool) {
-	v := m.granted_at
+// SetTriggeredAt sets the "triggered_at" field.
+func (m *EventMutation) SetTriggeredAt(t time.Time) {
+	m.triggered_at = &t
+}
+
+// TriggeredAt returns the value of the "triggered_at" field in the mutation.
+func (m *EventMutation) TriggeredAt() (r time.Time, exists bool) {
+	v := m.triggered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGrantedAt returns the old "granted_at" field's value of the Point entity.
-// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggeredAt returns the old "triggered_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PointMutation) OldGrantedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventMutation) OldTriggeredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGrantedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGrantedAt requires an ID field in the mutation")
+		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGrantedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
 	}
-	return oldValue.GrantedAt, nil
+	return oldValue.TriggeredAt, nil
 }
 
-// ResetGrantedAt resets all changes to the "granted_at" field.
-func (m *PointMutation) ResetGrantedAt() {
-	m.granted_at = nil
+// ResetTriggeredAt resets all changes to the "triggered_at" field.
+func (m *EventMutation) ResetTriggeredAt() {
+	m.triggered_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *PointMutation) SetDescription(s string) {
-	m.description = &s
+// SetPayload sets the "payload" field.
+func (m *EventMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PointMutation) Description() (r string, exists bool) {
-	v := m.description
+// Payload returns the value of the "payload" field in the mutation.
+func (m *EventMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Point entity.
-// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PointMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *EventMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Payload, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PointMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[point.FieldDescription] = struct{}{}
+// ClearPayload clears the value of the "payload" field.
+func (m *EventMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[event.FieldPayload] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PointMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[point.FieldDescription]
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *EventMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[event.FieldPayload]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PointMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, point.FieldDescription)
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PointMutation) SetUserID(id int) {
-	m.user = &id
+// ResetPayload resets all changes to the "payload" field.
+func (m *EventMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, event.FieldPayload)
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *PointMutation) ClearUser() {
+func (m *EventMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[event.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PointMutation) UserCleared() bool {
+func (m *EventMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *PointMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *PointMutation) UserIDs() (ids []int) {
+func (m *EventMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2777,20 +3527,74 @@ func (m *PointMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *PointMutation) ResetUser() {
+func (m *EventMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the PointMutation builder.
-func (m *PointMutation) Where(ps ...predicate.Point) {
+// AddOutboxIDs adds the "outbox" edge to the EventOutbox entity by ids.
+func (m *EventMutation) AddOutboxIDs(ids ...int) {
+	if m.outbox == nil {
+		m.outbox = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.outbox[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOutbox clears the "outbox" edge to the EventOutbox entity.
+func (m *EventMutation) ClearOutbox() {
+	m.clearedoutbox = true
+}
+
+// OutboxCleared reports if the "outbox" edge to the EventOutbox entity was cleared.
+func (m *EventMutation) OutboxCleared() bool {
+	return m.clearedoutbox
+}
+
+// RemoveOutboxIDs removes the "outbox" edge to the EventOutbox entity by IDs.
+func (m *EventMutation) RemoveOutboxIDs(ids ...int) {
+	if m.removedoutbox == nil {
+		m.removedoutbox = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.outbox, ids[i])
+		m.removedoutbox[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOutbox returns the removed IDs of the "outbox" edge to the EventOutbox entity.
+func (m *EventMutation) RemovedOutboxIDs() (ids []int) {
+	for id := range m.removedoutbox {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OutboxIDs returns the "outbox" edge IDs in the mutation.
+func (m *EventMutation) OutboxIDs() (ids []int) {
+	for id := range m.outbox {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOutbox resets all changes to the "outbox" edge.
+func (m *EventMutation) ResetOutbox() {
+	m.outbox = nil
+	m.clearedoutbox = false
+	m.removedoutbox = nil
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PointMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PointMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Point, len(ps))
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2798,33 +3602,36 @@ func (m *PointMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PointMutation) Op() Op {
+func (m *EventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PointMutation) SetOp(op Op) {
+func (m *EventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Point).
-func (m *PointMutation) Type() string {
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PointMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.points != nil {
-		fields = append(fields, point.FieldPoints)
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, event.FieldUserID)
 	}
-	if m.granted_at != nil {
-		fields = append(fields, point.FieldGrantedAt)
+	if m._type != nil {
+		fields = append(fields, event.FieldType)
 	}
-	if m.description != nil {
-		fields = append(fields, point.FieldDescription)
+	if m.triggered_at != nil {
+		fields = append(fields, event.FieldTriggeredAt)
+	}
+	if m.payload != nil {
+		fields = append(fields, event.FieldPayload)
 	}
 	return fields
 }
@@ -2832,14 +3639,16 @@ func (m *PointMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PointMutation) Field(name string) (ent.Value, bool) {
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case point.FieldPoints:
-		return m.Points()
-	case point.FieldGrantedAt:
-		return m.GrantedAt()
-	case point.FieldDescription:
-		return m.Description()
+	case event.FieldUserID:
+		return m.UserID()
+	case event.FieldType:
+		return m.GetType()
+	case event.FieldTriggeredAt:
+		return m.TriggeredAt()
+	case event.FieldPayload:
+		return m.Payload()
 	}
 	return nil, false
 }
@@ -2847,65 +3656,69 @@ func (m *PointMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case point.FieldPoints:
-		return m.OldPoints(ctx)
-	case point.FieldGrantedAt:
-		return m.OldGrantedAt(ctx)
-	case point.FieldDescription:
-		return m.OldDescription(ctx)
+	case event.FieldUserID:
+		return m.OldUserID(ctx)
+	case event.FieldType:
+		return m.OldType(ctx)
+	case event.FieldTriggeredAt:
+		return m.OldTriggeredAt(ctx)
+	case event.FieldPayload:
+		return m.OldPayload(ctx)
 	}
-	return nil, fmt.Errorf("unknown Point field %s", name)
+	return nil, fmt.Errorf("unknown Event field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PointMutation) SetField(name string, value ent.Value) error {
+func (m *EventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case point.FieldPoints:
+	case event.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPoints(v)
+		m.SetUserID(v)
 		return nil
-	case point.FieldGrantedAt:
+	case event.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case event.FieldTriggeredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGrantedAt(v)
+		m.SetTriggeredAt(v)
 		return nil
-	case point.FieldDescription:
-		v, ok := value.(string)
+	case event.FieldPayload:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetPayload(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Point field %s", name)
+	return fmt.Errorf("unknown Event field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PointMutation) AddedFields() []string {
+func (m *EventMutation) AddedFields() []string {
 	var fields []string
-	if m.addpoints != nil {
-		fields = append(fields, point.FieldPoints)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PointMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case point.FieldPoints:
-		return m.AddedPoints()
 	}
 	return nil, false
 }
@@ -2913,172 +3726,191 @@ func (m *PointMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PointMutation) AddField(name string, value ent.Value) error {
+func (m *EventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case point.FieldPoints:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPoints(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Point numeric field %s", name)
+	return fmt.Errorf("unknown Event numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PointMutation) ClearedFields() []string {
+func (m *EventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(point.FieldDescription) {
-		fields = append(fields, point.FieldDescription)
+	if m.FieldCleared(event.FieldPayload) {
+		fields = append(fields, event.FieldPayload)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PointMutation) FieldCleared(name string) bool {
+func (m *EventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PointMutation) ClearField(name string) error {
+func (m *EventMutation) ClearField(name string) error {
 	switch name {
-	case point.FieldDescription:
-		m.ClearDescription()
+	case event.FieldPayload:
+		m.ClearPayload()
 		return nil
 	}
-	return fmt.Errorf("unknown Point nullable field %s", name)
+	return fmt.Errorf("unknown Event nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PointMutation) ResetField(name string) error {
+func (m *EventMutation) ResetField(name string) error {
 	switch name {
-	case point.FieldPoints:
-		m.ResetPoints()
+	case event.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case point.FieldGrantedAt:
-		m.ResetGrantedAt()
+	case event.FieldType:
+		m.ResetType()
 		return nil
-	case point.FieldDescription:
-		m.ResetDescription()
+	case event.FieldTriggeredAt:
+		m.ResetTriggeredAt()
+		return nil
+	case event.FieldPayload:
+		m.ResetPayload()
 		return nil
 	}
-	return fmt.Errorf("unknown Point field %s", name)
+	return fmt.Errorf("unknown Event field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PointMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, point.EdgeUser)
+		edges = append(edges, event.EdgeUser)
+	}
+	if m.outbox != nil {
+		edges = append(edges, event.EdgeOutbox)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PointMutation) AddedIDs(name string) []ent.Value {
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case point.EdgeUser:
+	case event.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case event.EdgeOutbox:
+		ids := make([]ent.Value, 0, len(m.outbox))
+		for id := range m.outbox {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PointMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedoutbox != nil {
+		edges = append(edges, event.EdgeOutbox)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PointMutation) RemovedIDs(name string) []ent.Value {
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeOutbox:
+		ids := make([]ent.Value, 0, len(m.removedoutbox))
+		for id := range m.removedoutbox {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PointMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, point.EdgeUser)
+		edges = append(edges, event.EdgeUser)
+	}
+	if m.clearedoutbox {
+		edges = append(edges, event.EdgeOutbox)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PointMutation) EdgeCleared(name string) bool {
+func (m *EventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case point.EdgeUser:
+	case event.EdgeUser:
 		return m.cleareduser
+	case event.EdgeOutbox:
+		return m.clearedoutbox
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PointMutation) ClearEdge(name string) error {
+func (m *EventMutation) ClearEdge(name string) error {
 	switch name {
-	case point.EdgeUser:
+	case event.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Point unique edge %s", name)
+	return fmt.Errorf("unknown Event unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PointMutation) ResetEdge(name string) error {
+func (m *EventMutation) ResetEdge(name string) error {
 	switch name {
-	case point.EdgeUser:
+	case event.EdgeUser:
 		m.ResetUser()
 		return nil
+	case event.EdgeOutbox:
+		m.ResetOutbox()
+		return nil
 	}
-	return fmt.Errorf("unknown Point edge %s", name)
+	return fmt.Errorf("unknown Event edge %s", name)
 }
 
-// QuestionMutation represents an operation that mutates the Question nodes in the graph.
-type QuestionMutation struct {
+// EventDailyCountMutation represents an operation that mutates the EventDailyCount nodes in the graph.
+type EventDailyCountMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	category           *string
-	difficulty         *question.Difficulty
-	title              *string
-	description        *string
-	reference_answer   *string
-	visible_scope      *string
-	clearedFields      map[string]struct{}
-	database           *int
-	cleareddatabase    bool
-	submissions        map[int]struct{}
-	removedsubmissions map[int]struct{}
-	clearedsubmissions bool
-	done               bool
-	oldValue           func(context.Context) (*Question, error)
-	predicates         []predicate.Question
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int
+	adduser_id    *int
+	_type         *string
+	date          *time.Time
+	count         *int
+	addcount      *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventDailyCount, error)
+	predicates    []predicate.EventDailyCount
 }
 
-var _ ent.Mutation = (*QuestionMutation)(nil)
+var _ ent.Mutation = (*EventDailyCountMutation)(nil)
 
-// questionOption allows management of the mutation configuration using functional options.
-type questionOption func(*QuestionMutation)
+// eventdailycountOption allows management of the mutation configuration using functional options.
+type eventdailycountOption func(*EventDailyCountMutation)
 
-// newQuestionMutation creates new mutation for the Question entity.
-func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
-	m := &QuestionMutation{
+// newEventDailyCountMutation creates new mutation for the EventDailyCount entity.
+func newEventDailyCountMutation(c config, op Op, opts ...eventdailycountOption) *EventDailyCountMutation {
+	m := &EventDailyCountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestion,
+		typ:           TypeEventDailyCount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3087,20 +3919,20 @@ func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutat
 	return m
 }
 
-// withQuestionID sets the ID field of the mutation.
-func withQuestionID(id int) questionOption {
-	return func(m *QuestionMutation) {
+// withEventDailyCountID sets the ID field of the mutation.
+func withEventDailyCountID(id int) eventdailycountOption {
+	return func(m *EventDailyCountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Question
+			value *EventDailyCount
 		)
-		m.oldValue = func(ctx context.Context) (*Question, error) {
+		m.oldValue = func(ctx context.Context) (*EventDailyCount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Question.Get(ctx, id)
+					value, err = m.Client().EventDailyCount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3109,10 +3941,10 @@ func withQuestionID(id int) questionOption {
 	}
 }
 
-// withQuestion sets the old Question of the mutation.
-func withQuestion(node *Question) questionOption {
-	return func(m *QuestionMutation) {
-		m.oldValue = func(context.Context) (*Question, error) {
+// withEventDailyCount sets the old EventDailyCount of the mutation.
+func withEventDailyCount(node *EventDailyCount) eventdailycountOption {
+	return func(m *EventDailyCountMutation) {
+		m.oldValue = func(context.Context) (*EventDailyCount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3121,7 +3953,7 @@ func withQuestion(node *Question) questionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionMutation) Client() *Client {
+func (m EventDailyCountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3129,7 +3961,7 @@ func (m QuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionMutation) Tx() (*Tx, error) {
+func (m EventDailyCountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3140,7 +3972,7 @@ func (m QuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionMutation) ID() (id int, exists bool) {
+func (m *EventDailyCountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3151,7 +3983,7 @@ func (m *QuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EventDailyCountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3160,343 +3992,205 @@ func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EventDailyCount.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCategory sets the "category" field.
-func (m *QuestionMutation) SetCategory(s string) {
-	m.category = &s
+// SetUserID sets the "user_id" field.
+func (m *EventDailyCountMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *QuestionMutation) Category() (r string, exists bool) {
-	v := m.category
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EventDailyCountMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the EventDailyCount entity.
+// If the EventDailyCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *EventDailyCountMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *QuestionMutation) ResetCategory() {
-	m.category = nil
+// AddUserID adds i to the "user_id" field.
+func (m *EventDailyCountMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetDifficulty sets the "difficulty" field.
-func (m *QuestionMutation) SetDifficulty(q question.Difficulty) {
-	m.difficulty = &q
-}
-
-// Difficulty returns the value of the "difficulty" field in the mutation.
-func (m *QuestionMutation) Difficulty() (r question.Difficulty, exists bool) {
-	v := m.difficulty
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *EventDailyCountMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDifficulty returns the old "difficulty" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldDifficulty(ctx context.Context) (v question.Difficulty, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDifficulty requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
-	}
-	return oldValue.Difficulty, nil
-}
-
-// ResetDifficulty resets all changes to the "difficulty" field.
-func (m *QuestionMutation) ResetDifficulty() {
-	m.difficulty = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EventDailyCountMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *QuestionMutation) SetTitle(s string) {
-	m.title = &s
+// SetType sets the "type" field.
+func (m *EventDailyCountMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *QuestionMutation) Title() (r string, exists bool) {
-	v := m.title
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventDailyCountMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the EventDailyCount entity.
+// If the EventDailyCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *EventDailyCountMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Type, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *QuestionMutation) ResetTitle() {
-	m.title = nil
+// ResetType resets all changes to the "type" field.
+func (m *EventDailyCountMutation) ResetType() {
+	m._type = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *QuestionMutation) SetDescription(s string) {
-	m.description = &s
+// SetDate sets the "date" field.
+func (m *EventDailyCountMutation) SetDate(t time.Time) {
+	m.date = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *QuestionMutation) Description() (r string, exists bool) {
-	v := m.description
+// Date returns the value of the "date" field in the mutation.
+func (m *EventDailyCountMutation) Date() (r time.Time, exists bool) {
+	v := m.date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldDate returns the old "date" field's value of the EventDailyCount entity.
+// If the EventDailyCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *EventDailyCountMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Date, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *QuestionMutation) ResetDescription() {
-	m.description = nil
+// ResetDate resets all changes to the "date" field.
+func (m *EventDailyCountMutation) ResetDate() {
+	m.date = nil
 }
 
-// SetReferenceAnswer sets the "reference_answer" field.
-func (m *QuestionMutation) SetReferenceAnswer(s string) {
-	m.reference_answer = &s
+// SetCount sets the "count" field.
+func (m *EventDailyCountMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// ReferenceAnswer returns the value of the "reference_answer" field in the mutation.
-func (m *QuestionMutation) ReferenceAnswer() (r string, exists bool) {
-	v := m.reference_answer
+// Count returns the value of the "count" field in the mutation.
+func (m *EventDailyCountMutation) Count() (r int, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReferenceAnswer returns the old "reference_answer" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the EventDailyCount entity.
+// If the EventDailyCount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldReferenceAnswer(ctx context.Context) (v string, err error) {
+func (m *EventDailyCountMutation) OldCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReferenceAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReferenceAnswer requires an ID field in the mutation")
+		return v, errors.New("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReferenceAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.ReferenceAnswer, nil
-}
-
-// ResetReferenceAnswer resets all changes to the "reference_answer" field.
-func (m *QuestionMutation) ResetReferenceAnswer() {
-	m.reference_answer = nil
+	return oldValue.Count, nil
 }
 
-// SetVisibleScope sets the "visible_scope" field.
-func (m *QuestionMutation) SetVisibleScope(s string) {
-	m.visible_scope = &s
+// AddCount adds i to the "count" field.
+func (m *EventDailyCountMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
 }
 
-// VisibleScope returns the value of the "visible_scope" field in the mutation.
-func (m *QuestionMutation) VisibleScope() (r string, exists bool) {
-	v := m.visible_scope
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *EventDailyCountMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVisibleScope returns the old "visible_scope" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldVisibleScope(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVisibleScope is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVisibleScope requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVisibleScope: %w", err)
-	}
-	return oldValue.VisibleScope, nil
-}
-
-// ClearVisibleScope clears the value of the "visible_scope" field.
-func (m *QuestionMutation) ClearVisibleScope() {
-	m.visible_scope = nil
-	m.clearedFields[question.FieldVisibleScope] = struct{}{}
-}
-
-// VisibleScopeCleared returns if the "visible_scope" field was cleared in this mutation.
-func (m *QuestionMutation) VisibleScopeCleared() bool {
-	_, ok := m.clearedFields[question.FieldVisibleScope]
-	return ok
-}
-
-// ResetVisibleScope resets all changes to the "visible_scope" field.
-func (m *QuestionMutation) ResetVisibleScope() {
-	m.visible_scope = nil
-	delete(m.clearedFields, question.FieldVisibleScope)
-}
-
-// SetDatabaseID sets the "database" edge to the Database entity by id.
-func (m *QuestionMutation) SetDatabaseID(id int) {
-	m.database = &id
-}
-
-// ClearDatabase clears the "database" edge to the Database entity.
-func (m *QuestionMutation) ClearDatabase() {
-	m.cleareddatabase = true
-}
-
-// DatabaseCleared reports if the "database" edge to the Database entity was cleared.
-func (m *QuestionMutation) DatabaseCleared() bool {
-	return m.cleareddatabase
-}
-
-// DatabaseID returns the "database" edge ID in the mutation.
-func (m *QuestionMutation) DatabaseID() (id int, exists bool) {
-	if m.database != nil {
-		return *m.database, true
-	}
-	return
-}
-
-// DatabaseIDs returns the "database" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DatabaseID instead. It exists only for internal usage by the builders.
-func (m *QuestionMutation) DatabaseIDs() (ids []int) {
-	if id := m.database; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetDatabase resets all changes to the "database" edge.
-func (m *QuestionMutation) ResetDatabase() {
-	m.database = nil
-	m.cleareddatabase = false
-}
-
-// AddSubmissionIDs adds the "submissions" edge to the Submission entity by ids.
-func (m *QuestionMutation) AddSubmissionIDs(ids ...int) {
-	if m.submissions == nil {
-		m.submissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.submissions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearSubmissions clears the "submissions" edge to the Submission entity.
-func (m *QuestionMutation) ClearSubmissions() {
-	m.clearedsubmissions = true
-}
-
-// SubmissionsCleared reports if the "submissions" edge to the Submission entity was cleared.
-func (m *QuestionMutation) SubmissionsCleared() bool {
-	return m.clearedsubmissions
-}
-
-// RemoveSubmissionIDs removes the "submissions" edge to the Submission entity by IDs.
-func (m *QuestionMutation) RemoveSubmissionIDs(ids ...int) {
-	if m.removedsubmissions == nil {
-		m.removedsubmissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.submissions, ids[i])
-		m.removedsubmissions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedSubmissions returns the removed IDs of the "submissions" edge to the Submission entity.
-func (m *QuestionMutation) RemovedSubmissionsIDs() (ids []int) {
-	for id := range m.removedsubmissions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// SubmissionsIDs returns the "submissions" edge IDs in the mutation.
-func (m *QuestionMutation) SubmissionsIDs() (ids []int) {
-	for id := range m.submissions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetSubmissions resets all changes to the "submissions" edge.
-func (m *QuestionMutation) ResetSubmissions() {
-	m.submissions = nil
-	m.clearedsubmissions = false
-	m.removedsubmissions = nil
+// ResetCount resets all changes to the "count" field.
+func (m *EventDailyCountMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
 }
 
-// Where appends a list predicates to the QuestionMutation builder.
-func (m *QuestionMutation) Where(ps ...predicate.Question) {
+// Where appends a list predicates to the EventDailyCountMutation builder.
+func (m *EventDailyCountMutation) Where(ps ...predicate.EventDailyCount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EventDailyCountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Question, len(ps))
+func (m *EventDailyCountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventDailyCount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3504,42 +4198,36 @@ func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestionMutation) Op() Op {
+func (m *EventDailyCountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionMutation) SetOp(op Op) {
+func (m *EventDailyCountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Question).
-func (m *QuestionMutation) Type() string {
+// Type returns the node type of this mutation (EventDailyCount).
+func (m *EventDailyCountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.category != nil {
-		fields = append(fields, question.FieldCategory)
-	}
-	if m.difficulty != nil {
-		fields = append(fields, question.FieldDifficulty)
-	}
-	if m.title != nil {
-		fields = append(fields, question.FieldTitle)
+func (m *EventDailyCountMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, eventdailycount.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, question.FieldDescription)
+	if m._type != nil {
+		fields = append(fields, eventdailycount.FieldType)
 	}
-	if m.reference_answer != nil {
-		fields = append(fields, question.FieldReferenceAnswer)
+	if m.date != nil {
+		fields = append(fields, eventdailycount.FieldDate)
 	}
-	if m.visible_scope != nil {
-		fields = append(fields, question.FieldVisibleScope)
+	if m.count != nil {
+		fields = append(fields, eventdailycount.FieldCount)
 	}
 	return fields
 }
@@ -3547,20 +4235,16 @@ func (m *QuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *EventDailyCountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldCategory:
-		return m.Category()
-	case question.FieldDifficulty:
-		return m.Difficulty()
-	case question.FieldTitle:
-		return m.Title()
-	case question.FieldDescription:
-		return m.Description()
-	case question.FieldReferenceAnswer:
-		return m.ReferenceAnswer()
-	case question.FieldVisibleScope:
-		return m.VisibleScope()
+	case eventdailycount.FieldUserID:
+		return m.UserID()
+	case eventdailycount.FieldType:
+		return m.GetType()
+	case eventdailycount.FieldDate:
+		return m.Date()
+	case eventdailycount.FieldCount:
+		return m.Count()
 	}
 	return nil, false
 }
@@ -3568,283 +4252,227 @@ func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EventDailyCountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case question.FieldCategory:
-		return m.OldCategory(ctx)
-	case question.FieldDifficulty:
-		return m.OldDifficulty(ctx)
-	case question.FieldTitle:
-		return m.OldTitle(ctx)
-	case question.FieldDescription:
-		return m.OldDescription(ctx)
-	case question.FieldReferenceAnswer:
-		return m.OldReferenceAnswer(ctx)
-	case question.FieldVisibleScope:
-		return m.OldVisibleScope(ctx)
+	case eventdailycount.FieldUserID:
+		return m.OldUserID(ctx)
+	case eventdailycount.FieldType:
+		return m.OldType(ctx)
+	case eventdailycount.FieldDate:
+		return m.OldDate(ctx)
+	case eventdailycount.FieldCount:
+		return m.OldCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Question field %s", name)
+	return nil, fmt.Errorf("unknown EventDailyCount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+func (m *EventDailyCountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case question.FieldDifficulty:
-		v, ok := value.(question.Difficulty)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDifficulty(v)
-		return nil
-	case question.FieldTitle:
-		v, ok := value.(string)
+	case eventdailycount.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetUserID(v)
 		return nil
-	case question.FieldDescription:
+	case eventdailycount.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetType(v)
 		return nil
-	case question.FieldReferenceAnswer:
-		v, ok := value.(string)
+	case eventdailycount.FieldDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReferenceAnswer(v)
+		m.SetDate(v)
 		return nil
-	case question.FieldVisibleScope:
-		v, ok := value.(string)
+	case eventdailycount.FieldCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVisibleScope(v)
+		m.SetCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown EventDailyCount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionMutation) AddedFields() []string {
-	return nil
+func (m *EventDailyCountMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, eventdailycount.FieldUserID)
+	}
+	if m.addcount != nil {
+		fields = append(fields, eventdailycount.FieldCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EventDailyCountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventdailycount.FieldUserID:
+		return m.AddedUserID()
+	case eventdailycount.FieldCount:
+		return m.AddedCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+func (m *EventDailyCountMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case eventdailycount.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case eventdailycount.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Question numeric field %s", name)
+	return fmt.Errorf("unknown EventDailyCount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(question.FieldVisibleScope) {
-		fields = append(fields, question.FieldVisibleScope)
-	}
-	return fields
+func (m *EventDailyCountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestionMutation) FieldCleared(name string) bool {
+func (m *EventDailyCountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionMutation) ClearField(name string) error {
-	switch name {
-	case question.FieldVisibleScope:
-		m.ClearVisibleScope()
-		return nil
-	}
-	return fmt.Errorf("unknown Question nullable field %s", name)
+func (m *EventDailyCountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventDailyCount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionMutation) ResetField(name string) error {
+func (m *EventDailyCountMutation) ResetField(name string) error {
 	switch name {
-	case question.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case question.FieldDifficulty:
-		m.ResetDifficulty()
-		return nil
-	case question.FieldTitle:
-		m.ResetTitle()
+	case eventdailycount.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case question.FieldDescription:
-		m.ResetDescription()
+	case eventdailycount.FieldType:
+		m.ResetType()
 		return nil
-	case question.FieldReferenceAnswer:
-		m.ResetReferenceAnswer()
+	case eventdailycount.FieldDate:
+		m.ResetDate()
 		return nil
-	case question.FieldVisibleScope:
-		m.ResetVisibleScope()
+	case eventdailycount.FieldCount:
+		m.ResetCount()
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown EventDailyCount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.database != nil {
-		edges = append(edges, question.EdgeDatabase)
-	}
-	if m.submissions != nil {
-		edges = append(edges, question.EdgeSubmissions)
-	}
+func (m *EventDailyCountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeDatabase:
-		if id := m.database; id != nil {
-			return []ent.Value{*id}
-		}
-	case question.EdgeSubmissions:
-		ids := make([]ent.Value, 0, len(m.submissions))
-		for id := range m.submissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *EventDailyCountMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedsubmissions != nil {
-		edges = append(edges, question.EdgeSubmissions)
-	}
+func (m *EventDailyCountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeSubmissions:
-		ids := make([]ent.Value, 0, len(m.removedsubmissions))
-		for id := range m.removedsubmissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *EventDailyCountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareddatabase {
-		edges = append(edges, question.EdgeDatabase)
-	}
-	if m.clearedsubmissions {
-		edges = append(edges, question.EdgeSubmissions)
-	}
+func (m *EventDailyCountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case question.EdgeDatabase:
-		return m.cleareddatabase
-	case question.EdgeSubmissions:
-		return m.clearedsubmissions
-	}
+func (m *EventDailyCountMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionMutation) ClearEdge(name string) error {
-	switch name {
-	case question.EdgeDatabase:
-		m.ClearDatabase()
-		return nil
-	}
-	return fmt.Errorf("unknown Question unique edge %s", name)
+func (m *EventDailyCountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventDailyCount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionMutation) ResetEdge(name string) error {
-	switch name {
-	case question.EdgeDatabase:
-		m.ResetDatabase()
-		return nil
-	case question.EdgeSubmissions:
-		m.ResetSubmissions()
-		return nil
-	}
-	return fmt.Errorf("unknown Question edge %s", name)
+func (m *EventDailyCountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventDailyCount edge %s", name)
 }
 
-// ScopeSetMutation represents an operation that mutates the ScopeSet nodes in the graph.
-type ScopeSetMutation struct {
+// EventOutboxMutation represents an operation that mutates the EventOutbox nodes in the graph.
+type EventOutboxMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	slug          *string
-	description   *string
-	scopes        *[]string
-	appendscopes  []string
-	clearedFields map[string]struct{}
-	groups        map[int]struct{}
-	removedgroups map[int]struct{}
-	clearedgroups bool
-	done          bool
-	oldValue      func(context.Context) (*ScopeSet, error)
-	predicates    []predicate.ScopeSet
+	op              Op
+	typ             string
+	id              *int
+	handler         *string
+	status          *eventoutbox.Status
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	locked_until    *time.Time
+	last_error      *string
+	created_at      *time.Time
+	processed_at    *time.Time
+	clearedFields   map[string]struct{}
+	event           *int
+	clearedevent    bool
+	done            bool
+	oldValue        func(context.Context) (*EventOutbox, error)
+	predicates      []predicate.EventOutbox
 }
 
-var _ ent.Mutation = (*ScopeSetMutation)(nil)
+var _ ent.Mutation = (*EventOutboxMutation)(nil)
 
-// scopesetOption allows management of the mutation configuration using functional options.
-type scopesetOption func(*ScopeSetMutation)
+// eventoutboxOption allows management of the mutation configuration using functional options.
+type eventoutboxOption func(*EventOutboxMutation)
 
-// newScopeSetMutation creates new mutation for the ScopeSet entity.
-func newScopeSetMutation(c config, op Op, opts ...scopesetOption) *ScopeSetMutation {
-	m := &ScopeSetMutation{
+// newEventOutboxMutation creates new mutation for the EventOutbox entity.
+func newEventOutboxMutation(c config, op Op, opts ...eventoutboxOption) *EventOutboxMutation {
+	m := &EventOutboxMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeScopeSet,
+		typ:           TypeEventOutbox,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3853,20 +4481,20 @@ func newScopeSetMutation(c config, op Op, opts ...scopesetOption) *ScopeSetMutat
 	return m
 }
 
-// withScopeSetID sets the ID field of the mutation.
-func withScopeSetID(id int) scopesetOption {
-	return func(m *ScopeSetMutation) {
+// withEventOutboxID sets the ID field of the mutation.
+func withEventOutboxID(id int) eventoutboxOption {
+	return func(m *EventOutboxMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ScopeSet
+			value *EventOutbox
 		)
-		m.oldValue = func(ctx context.Context) (*ScopeSet, error) {
+		m.oldValue = func(ctx context.Context) (*EventOutbox, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ScopeSet.Get(ctx, id)
+					value, err = m.Client().EventOutbox.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3875,10 +4503,10 @@ func withScopeSetID(id int) scopesetOption {
 	}
 }
 
-// withScopeSet sets the old ScopeSet of the mutation.
-func withScopeSet(node *ScopeSet) scopesetOption {
-	return func(m *ScopeSetMutation) {
-		m.oldValue = func(context.Context) (*ScopeSet, error) {
+// withEventOutbox sets the old EventOutbox of the mutation.
+func withEventOutbox(node *EventOutbox) eventoutboxOption {
+	return func(m *EventOutboxMutation) {
+		m.oldValue = func(context.Context) (*EventOutbox, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3887,7 +4515,7 @@ func withScopeSet(node *ScopeSet) scopesetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ScopeSetMutation) Client() *Client {
+func (m EventOutboxMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3895,7 +4523,7 @@ func (m ScopeSetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ScopeSetMutation) Tx() (*Tx, error) {
+func (m EventOutboxMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3906,7 +4534,7 @@ func (m ScopeSetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ScopeSetMutation) ID() (id int, exists bool) {
+func (m *EventOutboxMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3917,7 +4545,7 @@ func (m *ScopeSetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ScopeSetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EventOutboxMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3926,211 +4554,6753 @@ func (m *ScopeSetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ScopeSet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EventOutbox.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSlug sets the "slug" field.
-func (m *ScopeSetMutation) SetSlug(s string) {
-	m.slug = &s
+// SetEventID sets the "event_id" field.
+func (m *EventOutboxMutation) SetEventID(i int) {
+	m.event = &i
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *ScopeSetMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventOutboxMutation) EventID() (r int, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the ScopeSet entity.
-// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScopeSetMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *EventOutboxMutation) OldEventID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.EventID, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *ScopeSetMutation) ResetSlug() {
-	m.slug = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventOutboxMutation) ResetEventID() {
+	m.event = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ScopeSetMutation) SetDescription(s string) {
-	m.description = &s
+// SetHandler sets the "handler" field.
+func (m *EventOutboxMutation) SetHandler(s string) {
+	m.handler = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ScopeSetMutation) Description() (r string, exists bool) {
-	v := m.description
+// Handler returns the value of the "handler" field in the mutation.
+func (m *EventOutboxMutation) Handler() (r string, exists bool) {
+	v := m.handler
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ScopeSet entity.
-// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScopeSetMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// OldHandler returns the old "handler" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldHandler(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandler is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandler requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandler: %w", err)
+	}
+	return oldValue.Handler, nil
+}
+
+// ResetHandler resets all changes to the "handler" field.
+func (m *EventOutboxMutation) ResetHandler() {
+	m.handler = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EventOutboxMutation) SetStatus(e eventoutbox.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventOutboxMutation) Status() (r eventoutbox.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldStatus(ctx context.Context) (v eventoutbox.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EventOutboxMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *EventOutboxMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *EventOutboxMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *EventOutboxMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *EventOutboxMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *EventOutboxMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *EventOutboxMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *EventOutboxMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *EventOutboxMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *EventOutboxMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *EventOutboxMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *EventOutboxMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[eventoutbox.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *EventOutboxMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[eventoutbox.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *EventOutboxMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, eventoutbox.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *EventOutboxMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *EventOutboxMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *EventOutboxMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[eventoutbox.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *EventOutboxMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[eventoutbox.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *EventOutboxMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, eventoutbox.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventOutboxMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventOutboxMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventOutboxMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *EventOutboxMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *EventOutboxMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the EventOutbox entity.
+// If the EventOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventOutboxMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *EventOutboxMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[eventoutbox.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *EventOutboxMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[eventoutbox.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *EventOutboxMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, eventoutbox.FieldProcessedAt)
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *EventOutboxMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[eventoutbox.FieldEventID] = struct{}{}
+}
+
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *EventOutboxMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *EventOutboxMutation) EventIDs() (ids []int) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEvent resets all changes to the "event" edge.
+func (m *EventOutboxMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
+}
+
+// Where appends a list predicates to the EventOutboxMutation builder.
+func (m *EventOutboxMutation) Where(ps ...predicate.EventOutbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventOutboxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventOutboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventOutbox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventOutboxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventOutboxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventOutbox).
+func (m *EventOutboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventOutboxMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.event != nil {
+		fields = append(fields, eventoutbox.FieldEventID)
+	}
+	if m.handler != nil {
+		fields = append(fields, eventoutbox.FieldHandler)
+	}
+	if m.status != nil {
+		fields = append(fields, eventoutbox.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, eventoutbox.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, eventoutbox.FieldNextAttemptAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, eventoutbox.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, eventoutbox.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventoutbox.FieldCreatedAt)
+	}
+	if m.processed_at != nil {
+		fields = append(fields, eventoutbox.FieldProcessedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventOutboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventoutbox.FieldEventID:
+		return m.EventID()
+	case eventoutbox.FieldHandler:
+		return m.Handler()
+	case eventoutbox.FieldStatus:
+		return m.Status()
+	case eventoutbox.FieldAttempts:
+		return m.Attempts()
+	case eventoutbox.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case eventoutbox.FieldLockedUntil:
+		return m.LockedUntil()
+	case eventoutbox.FieldLastError:
+		return m.LastError()
+	case eventoutbox.FieldCreatedAt:
+		return m.CreatedAt()
+	case eventoutbox.FieldProcessedAt:
+		return m.ProcessedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventOutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventoutbox.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventoutbox.FieldHandler:
+		return m.OldHandler(ctx)
+	case eventoutbox.FieldStatus:
+		return m.OldStatus(ctx)
+	case eventoutbox.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case eventoutbox.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case eventoutbox.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case eventoutbox.FieldLastError:
+		return m.OldLastError(ctx)
+	case eventoutbox.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case eventoutbox.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventOutbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventOutboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventoutbox.FieldEventID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventoutbox.FieldHandler:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandler(v)
+		return nil
+	case eventoutbox.FieldStatus:
+		v, ok := value.(eventoutbox.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case eventoutbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case eventoutbox.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case eventoutbox.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case eventoutbox.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case eventoutbox.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case eventoutbox.FieldProcessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventOutboxMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, eventoutbox.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventOutboxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventoutbox.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventOutboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventoutbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventOutboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventoutbox.FieldLockedUntil) {
+		fields = append(fields, eventoutbox.FieldLockedUntil)
+	}
+	if m.FieldCleared(eventoutbox.FieldLastError) {
+		fields = append(fields, eventoutbox.FieldLastError)
+	}
+	if m.FieldCleared(eventoutbox.FieldProcessedAt) {
+		fields = append(fields, eventoutbox.FieldProcessedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventOutboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventOutboxMutation) ClearField(name string) error {
+	switch name {
+	case eventoutbox.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case eventoutbox.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case eventoutbox.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventOutboxMutation) ResetField(name string) error {
+	switch name {
+	case eventoutbox.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventoutbox.FieldHandler:
+		m.ResetHandler()
+		return nil
+	case eventoutbox.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case eventoutbox.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case eventoutbox.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case eventoutbox.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case eventoutbox.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case eventoutbox.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case eventoutbox.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventOutboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.event != nil {
+		edges = append(edges, eventoutbox.EdgeEvent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventOutboxMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventoutbox.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventOutboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventOutboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventOutboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedevent {
+		edges = append(edges, eventoutbox.EdgeEvent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventOutboxMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventoutbox.EdgeEvent:
+		return m.clearedevent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventOutboxMutation) ClearEdge(name string) error {
+	switch name {
+	case eventoutbox.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventOutboxMutation) ResetEdge(name string) error {
+	switch name {
+	case eventoutbox.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown EventOutbox edge %s", name)
+}
+
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *time.Time
+	name              *string
+	description       *string
+	clearedFields     map[string]struct{}
+	scope_sets        map[int]struct{}
+	removedscope_sets map[int]struct{}
+	clearedscope_sets bool
+	done              bool
+	oldValue          func(context.Context) (*Group, error)
+	predicates        []predicate.Group
+}
+
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *GroupMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *GroupMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[group.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *GroupMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[group.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *GroupMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, group.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[group.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[group.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, group.FieldDescription)
+}
+
+// AddScopeSetIDs adds the "scope_sets" edge to the ScopeSet entity by ids.
+func (m *GroupMutation) AddScopeSetIDs(ids ...int) {
+	if m.scope_sets == nil {
+		m.scope_sets = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.scope_sets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScopeSets clears the "scope_sets" edge to the ScopeSet entity.
+func (m *GroupMutation) ClearScopeSets() {
+	m.clearedscope_sets = true
+}
+
+// ScopeSetsCleared reports if the "scope_sets" edge to the ScopeSet entity was cleared.
+func (m *GroupMutation) ScopeSetsCleared() bool {
+	return m.clearedscope_sets
+}
+
+// RemoveScopeSetIDs removes the "scope_sets" edge to the ScopeSet entity by IDs.
+func (m *GroupMutation) RemoveScopeSetIDs(ids ...int) {
+	if m.removedscope_sets == nil {
+		m.removedscope_sets = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.scope_sets, ids[i])
+		m.removedscope_sets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScopeSets returns the removed IDs of the "scope_sets" edge to the ScopeSet entity.
+func (m *GroupMutation) RemovedScopeSetsIDs() (ids []int) {
+	for id := range m.removedscope_sets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScopeSetsIDs returns the "scope_sets" edge IDs in the mutation.
+func (m *GroupMutation) ScopeSetsIDs() (ids []int) {
+	for id := range m.scope_sets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScopeSets resets all changes to the "scope_sets" edge.
+func (m *GroupMutation) ResetScopeSets() {
+	m.scope_sets = nil
+	m.clearedscope_sets = false
+	m.removedscope_sets = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, group.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case group.FieldDeletedAt:
+		return m.DeletedAt()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case group.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case group.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDeletedAt) {
+		fields = append(fields, group.FieldDeletedAt)
+	}
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case group.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case group.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.scope_sets != nil {
+		edges = append(edges, group.EdgeScopeSets)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeScopeSets:
+		ids := make([]ent.Value, 0, len(m.scope_sets))
+		for id := range m.scope_sets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedscope_sets != nil {
+		edges = append(edges, group.EdgeScopeSets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeScopeSets:
+		ids := make([]ent.Value, 0, len(m.removedscope_sets))
+		for id := range m.removedscope_sets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedscope_sets {
+		edges = append(edges, group.EdgeScopeSets)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeScopeSets:
+		return m.clearedscope_sets
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeScopeSets:
+		m.ResetScopeSets()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// JobRunMutation represents an operation that mutates the JobRun nodes in the graph.
+type JobRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	job_name       *string
+	status         *jobrun.Status
+	instance       *string
+	scheduled_at   *time.Time
+	started_at     *time.Time
+	finished_at    *time.Time
+	duration_ms    *int
+	addduration_ms *int
+	error          *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*JobRun, error)
+	predicates     []predicate.JobRun
+}
+
+var _ ent.Mutation = (*JobRunMutation)(nil)
+
+// jobrunOption allows management of the mutation configuration using functional options.
+type jobrunOption func(*JobRunMutation)
+
+// newJobRunMutation creates new mutation for the JobRun entity.
+func newJobRunMutation(c config, op Op, opts ...jobrunOption) *JobRunMutation {
+	m := &JobRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobRunID sets the ID field of the mutation.
+func withJobRunID(id int) jobrunOption {
+	return func(m *JobRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobRun
+		)
+		m.oldValue = func(ctx context.Context) (*JobRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobRun sets the old JobRun of the mutation.
+func withJobRun(node *JobRun) jobrunOption {
+	return func(m *JobRunMutation) {
+		m.oldValue = func(context.Context) (*JobRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobName sets the "job_name" field.
+func (m *JobRunMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *JobRunMutation) JobName() (r string, exists bool) {
+	v := m.job_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobName returns the old "job_name" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldJobName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
+	}
+	return oldValue.JobName, nil
+}
+
+// ResetJobName resets all changes to the "job_name" field.
+func (m *JobRunMutation) ResetJobName() {
+	m.job_name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobRunMutation) SetStatus(j jobrun.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobRunMutation) Status() (r jobrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStatus(ctx context.Context) (v jobrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetInstance sets the "instance" field.
+func (m *JobRunMutation) SetInstance(s string) {
+	m.instance = &s
+}
+
+// Instance returns the value of the "instance" field in the mutation.
+func (m *JobRunMutation) Instance() (r string, exists bool) {
+	v := m.instance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstance returns the old "instance" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldInstance(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstance: %w", err)
+	}
+	return oldValue.Instance, nil
+}
+
+// ResetInstance resets all changes to the "instance" field.
+func (m *JobRunMutation) ResetInstance() {
+	m.instance = nil
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *JobRunMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *JobRunMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *JobRunMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[jobrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, jobrun.FieldFinishedAt)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *JobRunMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *JobRunMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *JobRunMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *JobRunMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *JobRunMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[jobrun.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *JobRunMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldDurationMs]
+	return ok
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *JobRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, jobrun.FieldDurationMs)
+}
+
+// SetError sets the "error" field.
+func (m *JobRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobrun.FieldError)
+}
+
+// Where appends a list predicates to the JobRunMutation builder.
+func (m *JobRunMutation) Where(ps ...predicate.JobRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobRun).
+func (m *JobRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.job_name != nil {
+		fields = append(fields, jobrun.FieldJobName)
+	}
+	if m.status != nil {
+		fields = append(fields, jobrun.FieldStatus)
+	}
+	if m.instance != nil {
+		fields = append(fields, jobrun.FieldInstance)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, jobrun.FieldScheduledAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, jobrun.FieldDurationMs)
+	}
+	if m.error != nil {
+		fields = append(fields, jobrun.FieldError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldJobName:
+		return m.JobName()
+	case jobrun.FieldStatus:
+		return m.Status()
+	case jobrun.FieldInstance:
+		return m.Instance()
+	case jobrun.FieldScheduledAt:
+		return m.ScheduledAt()
+	case jobrun.FieldStartedAt:
+		return m.StartedAt()
+	case jobrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case jobrun.FieldDurationMs:
+		return m.DurationMs()
+	case jobrun.FieldError:
+		return m.Error()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobrun.FieldJobName:
+		return m.OldJobName(ctx)
+	case jobrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case jobrun.FieldInstance:
+		return m.OldInstance(ctx)
+	case jobrun.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case jobrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case jobrun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case jobrun.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case jobrun.FieldStatus:
+		v, ok := value.(jobrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case jobrun.FieldInstance:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstance(v)
+		return nil
+	case jobrun.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case jobrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case jobrun.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case jobrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, jobrun.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobrun.FieldFinishedAt) {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	if m.FieldCleared(jobrun.FieldDurationMs) {
+		fields = append(fields, jobrun.FieldDurationMs)
+	}
+	if m.FieldCleared(jobrun.FieldError) {
+		fields = append(fields, jobrun.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobRunMutation) ClearField(name string) error {
+	switch name {
+	case jobrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case jobrun.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case jobrun.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobRunMutation) ResetField(name string) error {
+	switch name {
+	case jobrun.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case jobrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case jobrun.FieldInstance:
+		m.ResetInstance()
+		return nil
+	case jobrun.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case jobrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case jobrun.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case jobrun.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JobRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JobRun edge %s", name)
+}
+
+// PersistedQueryMutation represents an operation that mutates the PersistedQuery nodes in the graph.
+type PersistedQueryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	hash          *string
+	query         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PersistedQuery, error)
+	predicates    []predicate.PersistedQuery
+}
+
+var _ ent.Mutation = (*PersistedQueryMutation)(nil)
+
+// persistedqueryOption allows management of the mutation configuration using functional options.
+type persistedqueryOption func(*PersistedQueryMutation)
+
+// newPersistedQueryMutation creates new mutation for the PersistedQuery entity.
+func newPersistedQueryMutation(c config, op Op, opts ...persistedqueryOption) *PersistedQueryMutation {
+	m := &PersistedQueryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePersistedQuery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPersistedQueryID sets the ID field of the mutation.
+func withPersistedQueryID(id int) persistedqueryOption {
+	return func(m *PersistedQueryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PersistedQuery
+		)
+		m.oldValue = func(ctx context.Context) (*PersistedQuery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PersistedQuery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPersistedQuery sets the old PersistedQuery of the mutation.
+func withPersistedQuery(node *PersistedQuery) persistedqueryOption {
+	return func(m *PersistedQueryMutation) {
+		m.oldValue = func(context.Context) (*PersistedQuery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersistedQueryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersistedQueryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersistedQueryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersistedQueryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PersistedQuery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *PersistedQueryMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *PersistedQueryMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the PersistedQuery entity.
+// If the PersistedQuery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersistedQueryMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *PersistedQueryMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *PersistedQueryMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *PersistedQueryMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the PersistedQuery entity.
+// If the PersistedQuery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersistedQueryMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *PersistedQueryMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PersistedQueryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersistedQueryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PersistedQuery entity.
+// If the PersistedQuery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersistedQueryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersistedQueryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PersistedQueryMutation builder.
+func (m *PersistedQueryMutation) Where(ps ...predicate.PersistedQuery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PersistedQueryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PersistedQueryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PersistedQuery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PersistedQueryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PersistedQueryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PersistedQuery).
+func (m *PersistedQueryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersistedQueryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.hash != nil {
+		fields = append(fields, persistedquery.FieldHash)
+	}
+	if m.query != nil {
+		fields = append(fields, persistedquery.FieldQuery)
+	}
+	if m.created_at != nil {
+		fields = append(fields, persistedquery.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersistedQueryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case persistedquery.FieldHash:
+		return m.Hash()
+	case persistedquery.FieldQuery:
+		return m.Query()
+	case persistedquery.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersistedQueryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case persistedquery.FieldHash:
+		return m.OldHash(ctx)
+	case persistedquery.FieldQuery:
+		return m.OldQuery(ctx)
+	case persistedquery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PersistedQuery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersistedQueryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case persistedquery.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case persistedquery.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case persistedquery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PersistedQuery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersistedQueryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersistedQueryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersistedQueryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PersistedQuery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersistedQueryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersistedQueryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersistedQueryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PersistedQuery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersistedQueryMutation) ResetField(name string) error {
+	switch name {
+	case persistedquery.FieldHash:
+		m.ResetHash()
+		return nil
+	case persistedquery.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case persistedquery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PersistedQuery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersistedQueryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersistedQueryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersistedQueryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersistedQueryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersistedQueryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersistedQueryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersistedQueryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PersistedQuery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersistedQueryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PersistedQuery edge %s", name)
+}
+
+// PointMutation represents an operation that mutates the Point nodes in the graph.
+type PointMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	points          *int
+	addpoints       *int
+	granted_at      *time.Time
+	description     *string
+	idempotency_key *string
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Point, error)
+	predicates      []predicate.Point
+}
+
+var _ ent.Mutation = (*PointMutation)(nil)
+
+// pointOption allows management of the mutation configuration using functional options.
+type pointOption func(*PointMutation)
+
+// newPointMutation creates new mutation for the Point entity.
+func newPointMutation(c config, op Op, opts ...pointOption) *PointMutation {
+	m := &PointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPointID sets the ID field of the mutation.
+func withPointID(id int) pointOption {
+	return func(m *PointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Point
+		)
+		m.oldValue = func(ctx context.Context) (*Point, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Point.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPoint sets the old Point of the mutation.
+func withPoint(node *Point) pointOption {
+	return func(m *PointMutation) {
+		m.oldValue = func(context.Context) (*Point, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PointMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PointMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Point.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPoints sets the "points" field.
+func (m *PointMutation) SetPoints(i int) {
+	m.points = &i
+	m.addpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *PointMutation) Points() (r int, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the Point entity.
+// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointMutation) OldPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
+}
+
+// AddPoints adds i to the "points" field.
+func (m *PointMutation) AddPoints(i int) {
+	if m.addpoints != nil {
+		*m.addpoints += i
+	} else {
+		m.addpoints = &i
+	}
+}
+
+// AddedPoints returns the value that was added to the "points" field in this mutation.
+func (m *PointMutation) AddedPoints() (r int, exists bool) {
+	v := m.addpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPoints resets all changes to the "points" field.
+func (m *PointMutation) ResetPoints() {
+	m.points = nil
+	m.addpoints = nil
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (m *PointMutation) SetGrantedAt(t time.Time) {
+	m.granted_at = &t
+}
+
+// GrantedAt returns the value of the "granted_at" field in the mutation.
+func (m *PointMutation) GrantedAt() (r time.Time, exists bool) {
+	v := m.granted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedAt returns the old "granted_at" field's value of the Point entity.
+// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointMutation) OldGrantedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedAt: %w", err)
+	}
+	return oldValue.GrantedAt, nil
+}
+
+// ResetGrantedAt resets all changes to the "granted_at" field.
+func (m *PointMutation) ResetGrantedAt() {
+	m.granted_at = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PointMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PointMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Point entity.
+// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PointMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[point.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PointMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[point.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PointMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, point.FieldDescription)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *PointMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *PointMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the Point entity.
+// If the Point object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *PointMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[point.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *PointMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[point.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *PointMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, point.FieldIdempotencyKey)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PointMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PointMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PointMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PointMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PointMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PointMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PointMutation builder.
+func (m *PointMutation) Where(ps ...predicate.Point) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Point, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Point).
+func (m *PointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PointMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.points != nil {
+		fields = append(fields, point.FieldPoints)
+	}
+	if m.granted_at != nil {
+		fields = append(fields, point.FieldGrantedAt)
+	}
+	if m.description != nil {
+		fields = append(fields, point.FieldDescription)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, point.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case point.FieldPoints:
+		return m.Points()
+	case point.FieldGrantedAt:
+		return m.GrantedAt()
+	case point.FieldDescription:
+		return m.Description()
+	case point.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case point.FieldPoints:
+		return m.OldPoints(ctx)
+	case point.FieldGrantedAt:
+		return m.OldGrantedAt(ctx)
+	case point.FieldDescription:
+		return m.OldDescription(ctx)
+	case point.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Point field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case point.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	case point.FieldGrantedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedAt(v)
+		return nil
+	case point.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case point.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Point field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PointMutation) AddedFields() []string {
+	var fields []string
+	if m.addpoints != nil {
+		fields = append(fields, point.FieldPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PointMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case point.FieldPoints:
+		return m.AddedPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case point.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Point numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(point.FieldDescription) {
+		fields = append(fields, point.FieldDescription)
+	}
+	if m.FieldCleared(point.FieldIdempotencyKey) {
+		fields = append(fields, point.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PointMutation) ClearField(name string) error {
+	switch name {
+	case point.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case point.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Point nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PointMutation) ResetField(name string) error {
+	switch name {
+	case point.FieldPoints:
+		m.ResetPoints()
+		return nil
+	case point.FieldGrantedAt:
+		m.ResetGrantedAt()
+		return nil
+	case point.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case point.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Point field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, point.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case point.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, point.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case point.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PointMutation) ClearEdge(name string) error {
+	switch name {
+	case point.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Point unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PointMutation) ResetEdge(name string) error {
+	switch name {
+	case point.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Point edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	category           *string
+	difficulty         *question.Difficulty
+	title              *string
+	description        *string
+	reference_answer   *string
+	visible_scope      *string
+	clearedFields      map[string]struct{}
+	database           *int
+	cleareddatabase    bool
+	submissions        map[int]struct{}
+	removedsubmissions map[int]struct{}
+	clearedsubmissions bool
+	done               bool
+	oldValue           func(context.Context) (*Question, error)
+	predicates         []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id int) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCategory sets the "category" field.
+func (m *QuestionMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *QuestionMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *QuestionMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *QuestionMutation) SetDifficulty(q question.Difficulty) {
+	m.difficulty = &q
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *QuestionMutation) Difficulty() (r question.Difficulty, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldDifficulty(ctx context.Context) (v question.Difficulty, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *QuestionMutation) ResetDifficulty() {
+	m.difficulty = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *QuestionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuestionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuestionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QuestionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QuestionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QuestionMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetReferenceAnswer sets the "reference_answer" field.
+func (m *QuestionMutation) SetReferenceAnswer(s string) {
+	m.reference_answer = &s
+}
+
+// ReferenceAnswer returns the value of the "reference_answer" field in the mutation.
+func (m *QuestionMutation) ReferenceAnswer() (r string, exists bool) {
+	v := m.reference_answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceAnswer returns the old "reference_answer" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldReferenceAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceAnswer: %w", err)
+	}
+	return oldValue.ReferenceAnswer, nil
+}
+
+// ResetReferenceAnswer resets all changes to the "reference_answer" field.
+func (m *QuestionMutation) ResetReferenceAnswer() {
+	m.reference_answer = nil
+}
+
+// SetVisibleScope sets the "visible_scope" field.
+func (m *QuestionMutation) SetVisibleScope(s string) {
+	m.visible_scope = &s
+}
+
+// VisibleScope returns the value of the "visible_scope" field in the mutation.
+func (m *QuestionMutation) VisibleScope() (r string, exists bool) {
+	v := m.visible_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibleScope returns the old "visible_scope" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldVisibleScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibleScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibleScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibleScope: %w", err)
+	}
+	return oldValue.VisibleScope, nil
+}
+
+// ClearVisibleScope clears the value of the "visible_scope" field.
+func (m *QuestionMutation) ClearVisibleScope() {
+	m.visible_scope = nil
+	m.clearedFields[question.FieldVisibleScope] = struct{}{}
+}
+
+// VisibleScopeCleared returns if the "visible_scope" field was cleared in this mutation.
+func (m *QuestionMutation) VisibleScopeCleared() bool {
+	_, ok := m.clearedFields[question.FieldVisibleScope]
+	return ok
+}
+
+// ResetVisibleScope resets all changes to the "visible_scope" field.
+func (m *QuestionMutation) ResetVisibleScope() {
+	m.visible_scope = nil
+	delete(m.clearedFields, question.FieldVisibleScope)
+}
+
+// SetDatabaseID sets the "database" edge to the Database entity by id.
+func (m *QuestionMutation) SetDatabaseID(id int) {
+	m.database = &id
+}
+
+// ClearDatabase clears the "database" edge to the Database entity.
+func (m *QuestionMutation) ClearDatabase() {
+	m.cleareddatabase = true
+}
+
+// DatabaseCleared reports if the "database" edge to the Database entity was cleared.
+func (m *QuestionMutation) DatabaseCleared() bool {
+	return m.cleareddatabase
+}
+
+// DatabaseID returns the "database" edge ID in the mutation.
+func (m *QuestionMutation) DatabaseID() (id int, exists bool) {
+	if m.database != nil {
+		return *m.database, true
+	}
+	return
+}
+
+// DatabaseIDs returns the "database" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DatabaseID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) DatabaseIDs() (ids []int) {
+	if id := m.database; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDatabase resets all changes to the "database" edge.
+func (m *QuestionMutation) ResetDatabase() {
+	m.database = nil
+	m.cleareddatabase = false
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the Submission entity by ids.
+func (m *QuestionMutation) AddSubmissionIDs(ids ...int) {
+	if m.submissions == nil {
+		m.submissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.submissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubmissions clears the "submissions" edge to the Submission entity.
+func (m *QuestionMutation) ClearSubmissions() {
+	m.clearedsubmissions = true
+}
+
+// SubmissionsCleared reports if the "submissions" edge to the Submission entity was cleared.
+func (m *QuestionMutation) SubmissionsCleared() bool {
+	return m.clearedsubmissions
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to the Submission entity by IDs.
+func (m *QuestionMutation) RemoveSubmissionIDs(ids ...int) {
+	if m.removedsubmissions == nil {
+		m.removedsubmissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.submissions, ids[i])
+		m.removedsubmissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubmissions returns the removed IDs of the "submissions" edge to the Submission entity.
+func (m *QuestionMutation) RemovedSubmissionsIDs() (ids []int) {
+	for id := range m.removedsubmissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubmissionsIDs returns the "submissions" edge IDs in the mutation.
+func (m *QuestionMutation) SubmissionsIDs() (ids []int) {
+	for id := range m.submissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubmissions resets all changes to the "submissions" edge.
+func (m *QuestionMutation) ResetSubmissions() {
+	m.submissions = nil
+	m.clearedsubmissions = false
+	m.removedsubmissions = nil
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.category != nil {
+		fields = append(fields, question.FieldCategory)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, question.FieldDifficulty)
+	}
+	if m.title != nil {
+		fields = append(fields, question.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, question.FieldDescription)
+	}
+	if m.reference_answer != nil {
+		fields = append(fields, question.FieldReferenceAnswer)
+	}
+	if m.visible_scope != nil {
+		fields = append(fields, question.FieldVisibleScope)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCategory:
+		return m.Category()
+	case question.FieldDifficulty:
+		return m.Difficulty()
+	case question.FieldTitle:
+		return m.Title()
+	case question.FieldDescription:
+		return m.Description()
+	case question.FieldReferenceAnswer:
+		return m.ReferenceAnswer()
+	case question.FieldVisibleScope:
+		return m.VisibleScope()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldCategory:
+		return m.OldCategory(ctx)
+	case question.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case question.FieldTitle:
+		return m.OldTitle(ctx)
+	case question.FieldDescription:
+		return m.OldDescription(ctx)
+	case question.FieldReferenceAnswer:
+		return m.OldReferenceAnswer(ctx)
+	case question.FieldVisibleScope:
+		return m.OldVisibleScope(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case question.FieldDifficulty:
+		v, ok := value.(question.Difficulty)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case question.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case question.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case question.FieldReferenceAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceAnswer(v)
+		return nil
+	case question.FieldVisibleScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibleScope(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldVisibleScope) {
+		fields = append(fields, question.FieldVisibleScope)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldVisibleScope:
+		m.ClearVisibleScope()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case question.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case question.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case question.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case question.FieldReferenceAnswer:
+		m.ResetReferenceAnswer()
+		return nil
+	case question.FieldVisibleScope:
+		m.ResetVisibleScope()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.database != nil {
+		edges = append(edges, question.EdgeDatabase)
+	}
+	if m.submissions != nil {
+		edges = append(edges, question.EdgeSubmissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeDatabase:
+		if id := m.database; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeSubmissions:
+		ids := make([]ent.Value, 0, len(m.submissions))
+		for id := range m.submissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsubmissions != nil {
+		edges = append(edges, question.EdgeSubmissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeSubmissions:
+		ids := make([]ent.Value, 0, len(m.removedsubmissions))
+		for id := range m.removedsubmissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddatabase {
+		edges = append(edges, question.EdgeDatabase)
+	}
+	if m.clearedsubmissions {
+		edges = append(edges, question.EdgeSubmissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeDatabase:
+		return m.cleareddatabase
+	case question.EdgeSubmissions:
+		return m.clearedsubmissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeDatabase:
+		m.ClearDatabase()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeDatabase:
+		m.ResetDatabase()
+		return nil
+	case question.EdgeSubmissions:
+		m.ResetSubmissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// RankSnapshotMutation represents an operation that mutates the RankSnapshot nodes in the graph.
+type RankSnapshotMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int
+	adduser_id    *int
+	by            *ranksnapshot.By
+	date          *time.Time
+	rank          *int
+	addrank       *int
+	score         *int
+	addscore      *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RankSnapshot, error)
+	predicates    []predicate.RankSnapshot
+}
+
+var _ ent.Mutation = (*RankSnapshotMutation)(nil)
+
+// ranksnapshotOption allows management of the mutation configuration using functional options.
+type ranksnapshotOption func(*RankSnapshotMutation)
+
+// newRankSnapshotMutation creates new mutation for the RankSnapshot entity.
+func newRankSnapshotMutation(c config, op Op, opts ...ranksnapshotOption) *RankSnapshotMutation {
+	m := &RankSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRankSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRankSnapshotID sets the ID field of the mutation.
+func withRankSnapshotID(id int) ranksnapshotOption {
+	return func(m *RankSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RankSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*RankSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RankSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRankSnapshot sets the old RankSnapshot of the mutation.
+func withRankSnapshot(node *RankSnapshot) ranksnapshotOption {
+	return func(m *RankSnapshotMutation) {
+		m.oldValue = func(context.Context) (*RankSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RankSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RankSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RankSnapshotMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RankSnapshotMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RankSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RankSnapshotMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RankSnapshotMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RankSnapshot entity.
+// If the RankSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RankSnapshotMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *RankSnapshotMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RankSnapshotMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RankSnapshotMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetBy sets the "by" field.
+func (m *RankSnapshotMutation) SetBy(r ranksnapshot.By) {
+	m.by = &r
+}
+
+// By returns the value of the "by" field in the mutation.
+func (m *RankSnapshotMutation) By() (r ranksnapshot.By, exists bool) {
+	v := m.by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBy returns the old "by" field's value of the RankSnapshot entity.
+// If the RankSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RankSnapshotMutation) OldBy(ctx context.Context) (v ranksnapshot.By, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBy: %w", err)
+	}
+	return oldValue.By, nil
+}
+
+// ResetBy resets all changes to the "by" field.
+func (m *RankSnapshotMutation) ResetBy() {
+	m.by = nil
+}
+
+// SetDate sets the "date" field.
+func (m *RankSnapshotMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *RankSnapshotMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the RankSnapshot entity.
+// If the RankSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RankSnapshotMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *RankSnapshotMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *RankSnapshotMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *RankSnapshotMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the RankSnapshot entity.
+// If the RankSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RankSnapshotMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *RankSnapshotMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *RankSnapshotMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *RankSnapshotMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetScore sets the "score" field.
+func (m *RankSnapshotMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *RankSnapshotMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the RankSnapshot entity.
+// If the RankSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RankSnapshotMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *RankSnapshotMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *RankSnapshotMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *RankSnapshotMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// Where appends a list predicates to the RankSnapshotMutation builder.
+func (m *RankSnapshotMutation) Where(ps ...predicate.RankSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RankSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RankSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RankSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RankSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RankSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RankSnapshot).
+func (m *RankSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RankSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, ranksnapshot.FieldUserID)
+	}
+	if m.by != nil {
+		fields = append(fields, ranksnapshot.FieldBy)
+	}
+	if m.date != nil {
+		fields = append(fields, ranksnapshot.FieldDate)
+	}
+	if m.rank != nil {
+		fields = append(fields, ranksnapshot.FieldRank)
+	}
+	if m.score != nil {
+		fields = append(fields, ranksnapshot.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RankSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		return m.UserID()
+	case ranksnapshot.FieldBy:
+		return m.By()
+	case ranksnapshot.FieldDate:
+		return m.Date()
+	case ranksnapshot.FieldRank:
+		return m.Rank()
+	case ranksnapshot.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RankSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		return m.OldUserID(ctx)
+	case ranksnapshot.FieldBy:
+		return m.OldBy(ctx)
+	case ranksnapshot.FieldDate:
+		return m.OldDate(ctx)
+	case ranksnapshot.FieldRank:
+		return m.OldRank(ctx)
+	case ranksnapshot.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown RankSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RankSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case ranksnapshot.FieldBy:
+		v, ok := value.(ranksnapshot.By)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBy(v)
+		return nil
+	case ranksnapshot.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case ranksnapshot.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case ranksnapshot.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RankSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RankSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, ranksnapshot.FieldUserID)
+	}
+	if m.addrank != nil {
+		fields = append(fields, ranksnapshot.FieldRank)
+	}
+	if m.addscore != nil {
+		fields = append(fields, ranksnapshot.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RankSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		return m.AddedUserID()
+	case ranksnapshot.FieldRank:
+		return m.AddedRank()
+	case ranksnapshot.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RankSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case ranksnapshot.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case ranksnapshot.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RankSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RankSnapshotMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RankSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RankSnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RankSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RankSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case ranksnapshot.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case ranksnapshot.FieldBy:
+		m.ResetBy()
+		return nil
+	case ranksnapshot.FieldDate:
+		m.ResetDate()
+		return nil
+	case ranksnapshot.FieldRank:
+		m.ResetRank()
+		return nil
+	case ranksnapshot.FieldScore:
+		m.ResetScore()
+		return nil
+	}
+	return fmt.Errorf("unknown RankSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RankSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RankSnapshotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RankSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RankSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RankSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RankSnapshotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RankSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RankSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RankSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RankSnapshot edge %s", name)
+}
+
+// ScopeSetMutation represents an operation that mutates the ScopeSet nodes in the graph.
+type ScopeSetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	slug          *string
+	description   *string
+	scopes        *[]string
+	appendscopes  []string
+	clearedFields map[string]struct{}
+	groups        map[int]struct{}
+	removedgroups map[int]struct{}
+	clearedgroups bool
+	done          bool
+	oldValue      func(context.Context) (*ScopeSet, error)
+	predicates    []predicate.ScopeSet
+}
+
+var _ ent.Mutation = (*ScopeSetMutation)(nil)
+
+// scopesetOption allows management of the mutation configuration using functional options.
+type scopesetOption func(*ScopeSetMutation)
+
+// newScopeSetMutation creates new mutation for the ScopeSet entity.
+func newScopeSetMutation(c config, op Op, opts ...scopesetOption) *ScopeSetMutation {
+	m := &ScopeSetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScopeSet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScopeSetID sets the ID field of the mutation.
+func withScopeSetID(id int) scopesetOption {
+	return func(m *ScopeSetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScopeSet
+		)
+		m.oldValue = func(ctx context.Context) (*ScopeSet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScopeSet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScopeSet sets the old ScopeSet of the mutation.
+func withScopeSet(node *ScopeSet) scopesetOption {
+	return func(m *ScopeSetMutation) {
+		m.oldValue = func(context.Context) (*ScopeSet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScopeSetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScopeSetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScopeSetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScopeSetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScopeSet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *ScopeSetMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *ScopeSetMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the ScopeSet entity.
+// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeSetMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *ScopeSetMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ScopeSetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ScopeSetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ScopeSet entity.
+// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeSetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ScopeSetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[scopeset.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ScopeSetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[scopeset.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ScopeSetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, scopeset.FieldDescription)
+}
+
+// SetScopes sets the "scopes" field.
+func (m *ScopeSetMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ScopeSetMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the ScopeSet entity.
+// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeSetMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ScopeSetMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ScopeSetMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ScopeSetMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *ScopeSetMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *ScopeSetMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *ScopeSetMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *ScopeSetMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *ScopeSetMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *ScopeSetMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *ScopeSetMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// Where appends a list predicates to the ScopeSetMutation builder.
+func (m *ScopeSetMutation) Where(ps ...predicate.ScopeSet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScopeSetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScopeSetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScopeSet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScopeSetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScopeSetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScopeSet).
+func (m *ScopeSetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScopeSetMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.slug != nil {
+		fields = append(fields, scopeset.FieldSlug)
+	}
+	if m.description != nil {
+		fields = append(fields, scopeset.FieldDescription)
+	}
+	if m.scopes != nil {
+		fields = append(fields, scopeset.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScopeSetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scopeset.FieldSlug:
+		return m.Slug()
+	case scopeset.FieldDescription:
+		return m.Description()
+	case scopeset.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScopeSetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scopeset.FieldSlug:
+		return m.OldSlug(ctx)
+	case scopeset.FieldDescription:
+		return m.OldDescription(ctx)
+	case scopeset.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScopeSet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScopeSetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scopeset.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case scopeset.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case scopeset.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScopeSet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScopeSetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScopeSetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScopeSetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScopeSet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScopeSetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scopeset.FieldDescription) {
+		fields = append(fields, scopeset.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScopeSetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScopeSetMutation) ClearField(name string) error {
+	switch name {
+	case scopeset.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown ScopeSet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScopeSetMutation) ResetField(name string) error {
+	switch name {
+	case scopeset.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case scopeset.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case scopeset.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown ScopeSet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScopeSetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.groups != nil {
+		edges = append(edges, scopeset.EdgeGroups)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScopeSetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scopeset.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScopeSetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgroups != nil {
+		edges = append(edges, scopeset.EdgeGroups)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScopeSetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scopeset.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScopeSetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroups {
+		edges = append(edges, scopeset.EdgeGroups)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScopeSetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scopeset.EdgeGroups:
+		return m.clearedgroups
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScopeSetMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScopeSet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScopeSetMutation) ResetEdge(name string) error {
+	switch name {
+	case scopeset.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown ScopeSet edge %s", name)
+}
+
+// SubmissionMutation represents an operation that mutates the Submission nodes in the graph.
+type SubmissionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	submitted_code  *string
+	status          *submission.Status
+	query_result    **models.UserSQLExecutionResult
+	error           *string
+	submitted_at    *time.Time
+	clearedFields   map[string]struct{}
+	question        *int
+	clearedquestion bool
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Submission, error)
+	predicates      []predicate.Submission
+}
+
+var _ ent.Mutation = (*SubmissionMutation)(nil)
+
+// submissionOption allows management of the mutation configuration using functional options.
+type submissionOption func(*SubmissionMutation)
+
+// newSubmissionMutation creates new mutation for the Submission entity.
+func newSubmissionMutation(c config, op Op, opts ...submissionOption) *SubmissionMutation {
+	m := &SubmissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubmission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubmissionID sets the ID field of the mutation.
+func withSubmissionID(id int) submissionOption {
+	return func(m *SubmissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Submission
+		)
+		m.oldValue = func(ctx context.Context) (*Submission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Submission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubmission sets the old Submission of the mutation.
+func withSubmission(node *Submission) submissionOption {
+	return func(m *SubmissionMutation) {
+		m.oldValue = func(context.Context) (*Submission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubmissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubmissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubmissionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubmissionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Submission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubmittedCode sets the "submitted_code" field.
+func (m *SubmissionMutation) SetSubmittedCode(s string) {
+	m.submitted_code = &s
+}
+
+// SubmittedCode returns the value of the "submitted_code" field in the mutation.
+func (m *SubmissionMutation) SubmittedCode() (r string, exists bool) {
+	v := m.submitted_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedCode returns the old "submitted_code" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldSubmittedCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedCode: %w", err)
+	}
+	return oldValue.SubmittedCode, nil
+}
+
+// ResetSubmittedCode resets all changes to the "submitted_code" field.
+func (m *SubmissionMutation) ResetSubmittedCode() {
+	m.submitted_code = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SubmissionMutation) SetStatus(s submission.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SubmissionMutation) Status() (r submission.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldStatus(ctx context.Context) (v submission.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SubmissionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetQueryResult sets the "query_result" field.
+func (m *SubmissionMutation) SetQueryResult(mser *models.UserSQLExecutionResult) {
+	m.query_result = &mser
+}
+
+// QueryResult returns the value of the "query_result" field in the mutation.
+func (m *SubmissionMutation) QueryResult() (r *models.UserSQLExecutionResult, exists bool) {
+	v := m.query_result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueryResult returns the old "query_result" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldQueryResult(ctx context.Context) (v *models.UserSQLExecutionResult, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueryResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueryResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueryResult: %w", err)
+	}
+	return oldValue.QueryResult, nil
+}
+
+// ClearQueryResult clears the value of the "query_result" field.
+func (m *SubmissionMutation) ClearQueryResult() {
+	m.query_result = nil
+	m.clearedFields[submission.FieldQueryResult] = struct{}{}
+}
+
+// QueryResultCleared returns if the "query_result" field was cleared in this mutation.
+func (m *SubmissionMutation) QueryResultCleared() bool {
+	_, ok := m.clearedFields[submission.FieldQueryResult]
+	return ok
+}
+
+// ResetQueryResult resets all changes to the "query_result" field.
+func (m *SubmissionMutation) ResetQueryResult() {
+	m.query_result = nil
+	delete(m.clearedFields, submission.FieldQueryResult)
+}
+
+// SetError sets the "error" field.
+func (m *SubmissionMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *SubmissionMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *SubmissionMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[submission.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *SubmissionMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[submission.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *SubmissionMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, submission.FieldError)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *SubmissionMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *SubmissionMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *SubmissionMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by id.
+func (m *SubmissionMutation) SetQuestionID(id int) {
+	m.question = &id
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *SubmissionMutation) ClearQuestion() {
+	m.clearedquestion = true
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *SubmissionMutation) QuestionCleared() bool {
+	return m.clearedquestion
+}
+
+// QuestionID returns the "question" edge ID in the mutation.
+func (m *SubmissionMutation) QuestionID() (id int, exists bool) {
+	if m.question != nil {
+		return *m.question, true
+	}
+	return
+}
+
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *SubmissionMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestion resets all changes to the "question" edge.
+func (m *SubmissionMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SubmissionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SubmissionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SubmissionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SubmissionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SubmissionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SubmissionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SubmissionMutation builder.
+func (m *SubmissionMutation) Where(ps ...predicate.Submission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SubmissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SubmissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Submission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SubmissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SubmissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Submission).
+func (m *SubmissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SubmissionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.submitted_code != nil {
+		fields = append(fields, submission.FieldSubmittedCode)
+	}
+	if m.status != nil {
+		fields = append(fields, submission.FieldStatus)
+	}
+	if m.query_result != nil {
+		fields = append(fields, submission.FieldQueryResult)
+	}
+	if m.error != nil {
+		fields = append(fields, submission.FieldError)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, submission.FieldSubmittedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case submission.FieldSubmittedCode:
+		return m.SubmittedCode()
+	case submission.FieldStatus:
+		return m.Status()
+	case submission.FieldQueryResult:
+		return m.QueryResult()
+	case submission.FieldError:
+		return m.Error()
+	case submission.FieldSubmittedAt:
+		return m.SubmittedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SubmissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case submission.FieldSubmittedCode:
+		return m.OldSubmittedCode(ctx)
+	case submission.FieldStatus:
+		return m.OldStatus(ctx)
+	case submission.FieldQueryResult:
+		return m.OldQueryResult(ctx)
+	case submission.FieldError:
+		return m.OldError(ctx)
+	case submission.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Submission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubmissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case submission.FieldSubmittedCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedCode(v)
+		return nil
+	case submission.FieldStatus:
+		v, ok := value.(submission.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case submission.FieldQueryResult:
+		v, ok := value.(*models.UserSQLExecutionResult)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueryResult(v)
+		return nil
+	case submission.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case submission.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Submission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SubmissionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SubmissionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubmissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Submission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SubmissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(submission.FieldQueryResult) {
+		fields = append(fields, submission.FieldQueryResult)
+	}
+	if m.FieldCleared(submission.FieldError) {
+		fields = append(fields, submission.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SubmissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SubmissionMutation) ClearField(name string) error {
+	switch name {
+	case submission.FieldQueryResult:
+		m.ClearQueryResult()
+		return nil
+	case submission.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SubmissionMutation) ResetField(name string) error {
+	switch name {
+	case submission.FieldSubmittedCode:
+		m.ResetSubmittedCode()
+		return nil
+	case submission.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case submission.FieldQueryResult:
+		m.ResetQueryResult()
+		return nil
+	case submission.FieldError:
+		m.ResetError()
+		return nil
+	case submission.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SubmissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.question != nil {
+		edges = append(edges, submission.EdgeQuestion)
+	}
+	if m.user != nil {
+		edges = append(edges, submission.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case submission.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
+		}
+	case submission.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SubmissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SubmissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SubmissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquestion {
+		edges = append(edges, submission.EdgeQuestion)
+	}
+	if m.cleareduser {
+		edges = append(edges, submission.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SubmissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case submission.EdgeQuestion:
+		return m.clearedquestion
+	case submission.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SubmissionMutation) ClearEdge(name string) error {
+	switch name {
+	case submission.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case submission.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SubmissionMutation) ResetEdge(name string) error {
+	switch name {
+	case submission.EdgeQuestion:
+		m.ResetQuestion()
+		return nil
+	case submission.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	created_at           *time.Time
+	updated_at           *time.Time
+	deleted_at           *time.Time
+	name                 *string
+	email                *string
+	avatar               *string
+	ranking_visibility   *user.RankingVisibility
+	pseudonym            *string
+	clearedFields        map[string]struct{}
+	group                *int
+	clearedgroup         bool
+	points               map[int]struct{}
+	removedpoints        map[int]struct{}
+	clearedpoints        bool
+	events               map[int]struct{}
+	removedevents        map[int]struct{}
+	clearedevents        bool
+	submissions          map[int]struct{}
+	removedsubmissions   map[int]struct{}
+	clearedsubmissions   bool
+	cheat_records        map[int]struct{}
+	removedcheat_records map[int]struct{}
+	clearedcheat_records bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+	predicates           []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetRankingVisibility sets the "ranking_visibility" field.
+func (m *UserMutation) SetRankingVisibility(uv user.RankingVisibility) {
+	m.ranking_visibility = &uv
+}
+
+// RankingVisibility returns the value of the "ranking_visibility" field in the mutation.
+func (m *UserMutation) RankingVisibility() (r user.RankingVisibility, exists bool) {
+	v := m.ranking_visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRankingVisibility returns the old "ranking_visibility" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRankingVisibility(ctx context.Context) (v user.RankingVisibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRankingVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRankingVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRankingVisibility: %w", err)
+	}
+	return oldValue.RankingVisibility, nil
+}
+
+// ResetRankingVisibility resets all changes to the "ranking_visibility" field.
+func (m *UserMutation) ResetRankingVisibility() {
+	m.ranking_visibility = nil
+}
+
+// SetPseudonym sets the "pseudonym" field.
+func (m *UserMutation) SetPseudonym(s string) {
+	m.pseudonym = &s
+}
+
+// Pseudonym returns the value of the "pseudonym" field in the mutation.
+func (m *UserMutation) Pseudonym() (r string, exists bool) {
+	v := m.pseudonym
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPseudonym returns the old "pseudonym" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPseudonym(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPseudonym is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPseudonym requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPseudonym: %w", err)
+	}
+	return oldValue.Pseudonym, nil
+}
+
+// ClearPseudonym clears the value of the "pseudonym" field.
+func (m *UserMutation) ClearPseudonym() {
+	m.pseudonym = nil
+	m.clearedFields[user.FieldPseudonym] = struct{}{}
+}
+
+// PseudonymCleared returns if the "pseudonym" field was cleared in this mutation.
+func (m *UserMutation) PseudonymCleared() bool {
+	_, ok := m.clearedFields[user.FieldPseudonym]
+	return ok
+}
+
+// ResetPseudonym resets all changes to the "pseudonym" field.
+func (m *UserMutation) ResetPseudonym() {
+	m.pseudonym = nil
+	delete(m.clearedFields, user.FieldPseudonym)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *UserMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *UserMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *UserMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *UserMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *UserMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddPointIDs adds the "points" edge to the Point entity by ids.
+func (m *UserMutation) AddPointIDs(ids ...int) {
+	if m.points == nil {
+		m.points = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.points[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPoints clears the "points" edge to the Point entity.
+func (m *UserMutation) ClearPoints() {
+	m.clearedpoints = true
+}
+
+// PointsCleared reports if the "points" edge to the Point entity was cleared.
+func (m *UserMutation) PointsCleared() bool {
+	return m.clearedpoints
+}
+
+// RemovePointIDs removes the "points" edge to the Point entity by IDs.
+func (m *UserMutation) RemovePointIDs(ids ...int) {
+	if m.removedpoints == nil {
+		m.removedpoints = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.points, ids[i])
+		m.removedpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPoints returns the removed IDs of the "points" edge to the Point entity.
+func (m *UserMutation) RemovedPointsIDs() (ids []int) {
+	for id := range m.removedpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PointsIDs returns the "points" edge IDs in the mutation.
+func (m *UserMutation) PointsIDs() (ids []int) {
+	for id := range m.points {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPoints resets all changes to the "points" edge.
+func (m *UserMutation) ResetPoints() {
+	m.points = nil
+	m.clearedpoints = false
+	m.removedpoints = nil
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by ids.
+func (m *UserMutation) AddEventIDs(ids ...int) {
+	if m.events == nil {
+		m.events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the Event entity.
+func (m *UserMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the Event entity was cleared.
+func (m *UserMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
+func (m *UserMutation) RemoveEventIDs(ids ...int) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
+func (m *UserMutation) RemovedEventsIDs() (ids []int) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
 	}
-	return oldValue.Description, nil
+	return
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ScopeSetMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[scopeset.FieldDescription] = struct{}{}
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *UserMutation) EventsIDs() (ids []int) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ScopeSetMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[scopeset.FieldDescription]
-	return ok
+// ResetEvents resets all changes to the "events" edge.
+func (m *UserMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ScopeSetMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, scopeset.FieldDescription)
+// AddSubmissionIDs adds the "submissions" edge to the Submission entity by ids.
+func (m *UserMutation) AddSubmissionIDs(ids ...int) {
+	if m.submissions == nil {
+		m.submissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.submissions[ids[i]] = struct{}{}
+	}
 }
 
-// SetScopes sets the "scopes" field.
-func (m *ScopeSetMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// ClearSubmissions clears the "submissions" edge to the Submission entity.
+func (m *UserMutation) ClearSubmissions() {
+	m.clearedsubmissions = true
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *ScopeSetMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
-	if v == nil {
-		return
-	}
-	return *v, true
+// SubmissionsCleared reports if the "submissions" edge to the Submission entity was cleared.
+func (m *UserMutation) SubmissionsCleared() bool {
+	return m.clearedsubmissions
 }
 
-// OldScopes returns the old "scopes" field's value of the ScopeSet entity.
-// If the ScopeSet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScopeSetMutation) OldScopes(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+// RemoveSubmissionIDs removes the "submissions" edge to the Submission entity by IDs.
+func (m *UserMutation) RemoveSubmissionIDs(ids ...int) {
+	if m.removedsubmissions == nil {
+		m.removedsubmissions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	for i := range ids {
+		delete(m.submissions, ids[i])
+		m.removedsubmissions[ids[i]] = struct{}{}
 	}
-	return oldValue.Scopes, nil
 }
 
-// AppendScopes adds s to the "scopes" field.
-func (m *ScopeSetMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
+// RemovedSubmissions returns the removed IDs of the "submissions" edge to the Submission entity.
+func (m *UserMutation) RemovedSubmissionsIDs() (ids []int) {
+	for id := range m.removedsubmissions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *ScopeSetMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
+// SubmissionsIDs returns the "submissions" edge IDs in the mutation.
+func (m *UserMutation) SubmissionsIDs() (ids []int) {
+	for id := range m.submissions {
+		ids = append(ids, id)
 	}
-	return m.appendscopes, true
+	return
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *ScopeSetMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
+// ResetSubmissions resets all changes to the "submissions" edge.
+func (m *UserMutation) ResetSubmissions() {
+	m.submissions = nil
+	m.clearedsubmissions = false
+	m.removedsubmissions = nil
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *ScopeSetMutation) AddGroupIDs(ids ...int) {
-	if m.groups == nil {
-		m.groups = make(map[int]struct{})
+// AddCheatRecordIDs adds the "cheat_records" edge to the CheatRecord entity by ids.
+func (m *UserMutation) AddCheatRecordIDs(ids ...int) {
+	if m.cheat_records == nil {
+		m.cheat_records = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
+		m.cheat_records[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *ScopeSetMutation) ClearGroups() {
-	m.clearedgroups = true
+// ClearCheatRecords clears the "cheat_records" edge to the CheatRecord entity.
+func (m *UserMutation) ClearCheatRecords() {
+	m.clearedcheat_records = true
 }
 
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *ScopeSetMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// CheatRecordsCleared reports if the "cheat_records" edge to the CheatRecord entity was cleared.
+func (m *UserMutation) CheatRecordsCleared() bool {
+	return m.clearedcheat_records
 }
 
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *ScopeSetMutation) RemoveGroupIDs(ids ...int) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[int]struct{})
+// RemoveCheatRecordIDs removes the "cheat_records" edge to the CheatRecord entity by IDs.
+func (m *UserMutation) RemoveCheatRecordIDs(ids ...int) {
+	if m.removedcheat_records == nil {
+		m.removedcheat_records = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+		delete(m.cheat_records, ids[i])
+		m.removedcheat_records[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *ScopeSetMutation) RemovedGroupsIDs() (ids []int) {
-	for id := range m.removedgroups {
+// RemovedCheatRecords returns the removed IDs of the "cheat_records" edge to the CheatRecord entity.
+func (m *UserMutation) RemovedCheatRecordsIDs() (ids []int) {
+	for id := range m.removedcheat_records {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *ScopeSetMutation) GroupsIDs() (ids []int) {
-	for id := range m.groups {
+// CheatRecordsIDs returns the "cheat_records" edge IDs in the mutation.
+func (m *UserMutation) CheatRecordsIDs() (ids []int) {
+	for id := range m.cheat_records {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *ScopeSetMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// ResetCheatRecords resets all changes to the "cheat_records" edge.
+func (m *UserMutation) ResetCheatRecords() {
+	m.cheat_records = nil
+	m.clearedcheat_records = false
+	m.removedcheat_records = nil
 }
 
-// Where appends a list predicates to the ScopeSetMutation builder.
-func (m *ScopeSetMutation) Where(ps ...predicate.ScopeSet) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ScopeSetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ScopeSetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ScopeSet, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4138,33 +11308,48 @@ func (m *ScopeSetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ScopeSetMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ScopeSetMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ScopeSet).
-func (m *ScopeSetMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ScopeSetMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.slug != nil {
-		fields = append(fields, scopeset.FieldSlug)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
-	if m.description != nil {
-		fields = append(fields, scopeset.FieldDescription)
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
 	}
-	if m.scopes != nil {
-		fields = append(fields, scopeset.FieldScopes)
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.ranking_visibility != nil {
+		fields = append(fields, user.FieldRankingVisibility)
+	}
+	if m.pseudonym != nil {
+		fields = append(fields, user.FieldPseudonym)
 	}
 	return fields
 }
@@ -4172,14 +11357,24 @@ func (m *ScopeSetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ScopeSetMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case scopeset.FieldSlug:
-		return m.Slug()
-	case scopeset.FieldDescription:
-		return m.Description()
-	case scopeset.FieldScopes:
-		return m.Scopes()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldRankingVisibility:
+		return m.RankingVisibility()
+	case user.FieldPseudonym:
+		return m.Pseudonym()
 	}
 	return nil, false
 }
@@ -4187,131 +11382,237 @@ func (m *ScopeSetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ScopeSetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case scopeset.FieldSlug:
-		return m.OldSlug(ctx)
-	case scopeset.FieldDescription:
-		return m.OldDescription(ctx)
-	case scopeset.FieldScopes:
-		return m.OldScopes(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldRankingVisibility:
+		return m.OldRankingVisibility(ctx)
+	case user.FieldPseudonym:
+		return m.OldPseudonym(ctx)
 	}
-	return nil, fmt.Errorf("unknown ScopeSet field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ScopeSetMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case scopeset.FieldSlug:
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetName(v)
 		return nil
-	case scopeset.FieldDescription:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetEmail(v)
 		return nil
-	case scopeset.FieldScopes:
-		v, ok := value.([]string)
+	case user.FieldAvatar:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetAvatar(v)
+		return nil
+	case user.FieldRankingVisibility:
+		v, ok := value.(user.RankingVisibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRankingVisibility(v)
+		return nil
+	case user.FieldPseudonym:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPseudonym(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ScopeSet field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ScopeSetMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ScopeSetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ScopeSetMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ScopeSet numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ScopeSetMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(scopeset.FieldDescription) {
-		fields = append(fields, scopeset.FieldDescription)
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldPseudonym) {
+		fields = append(fields, user.FieldPseudonym)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ScopeSetMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ScopeSetMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case scopeset.FieldDescription:
-		m.ClearDescription()
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldPseudonym:
+		m.ClearPseudonym()
 		return nil
 	}
-	return fmt.Errorf("unknown ScopeSet nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ScopeSetMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case scopeset.FieldSlug:
-		m.ResetSlug()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case scopeset.FieldDescription:
-		m.ResetDescription()
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case scopeset.FieldScopes:
-		m.ResetScopes()
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldRankingVisibility:
+		m.ResetRankingVisibility()
+		return nil
+	case user.FieldPseudonym:
+		m.ResetPseudonym()
 		return nil
 	}
-	return fmt.Errorf("unknown ScopeSet field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ScopeSetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.groups != nil {
-		edges = append(edges, scopeset.EdgeGroups)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.group != nil {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.points != nil {
+		edges = append(edges, user.EdgePoints)
+	}
+	if m.events != nil {
+		edges = append(edges, user.EdgeEvents)
+	}
+	if m.submissions != nil {
+		edges = append(edges, user.EdgeSubmissions)
+	}
+	if m.cheat_records != nil {
+		edges = append(edges, user.EdgeCheatRecords)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ScopeSetMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case scopeset.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
+	case user.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgePoints:
+		ids := make([]ent.Value, 0, len(m.points))
+		for id := range m.points {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSubmissions:
+		ids := make([]ent.Value, 0, len(m.submissions))
+		for id := range m.submissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCheatRecords:
+		ids := make([]ent.Value, 0, len(m.cheat_records))
+		for id := range m.cheat_records {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4320,21 +11621,48 @@ func (m *ScopeSetMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ScopeSetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedgroups != nil {
-		edges = append(edges, scopeset.EdgeGroups)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedpoints != nil {
+		edges = append(edges, user.EdgePoints)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, user.EdgeEvents)
+	}
+	if m.removedsubmissions != nil {
+		edges = append(edges, user.EdgeSubmissions)
+	}
+	if m.removedcheat_records != nil {
+		edges = append(edges, user.EdgeCheatRecords)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ScopeSetMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case scopeset.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
+	case user.EdgePoints:
+		ids := make([]ent.Value, 0, len(m.removedpoints))
+		for id := range m.removedpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSubmissions:
+		ids := make([]ent.Value, 0, len(m.removedsubmissions))
+		for id := range m.removedsubmissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCheatRecords:
+		ids := make([]ent.Value, 0, len(m.removedcheat_records))
+		for id := range m.removedcheat_records {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4343,75 +11671,115 @@ func (m *ScopeSetMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ScopeSetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedgroups {
-		edges = append(edges, scopeset.EdgeGroups)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedgroup {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.clearedpoints {
+		edges = append(edges, user.EdgePoints)
+	}
+	if m.clearedevents {
+		edges = append(edges, user.EdgeEvents)
+	}
+	if m.clearedsubmissions {
+		edges = append(edges, user.EdgeSubmissions)
+	}
+	if m.clearedcheat_records {
+		edges = append(edges, user.EdgeCheatRecords)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ScopeSetMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case scopeset.EdgeGroups:
-		return m.clearedgroups
+	case user.EdgeGroup:
+		return m.clearedgroup
+	case user.EdgePoints:
+		return m.clearedpoints
+	case user.EdgeEvents:
+		return m.clearedevents
+	case user.EdgeSubmissions:
+		return m.clearedsubmissions
+	case user.EdgeCheatRecords:
+		return m.clearedcheat_records
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ScopeSetMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeGroup:
+		m.ClearGroup()
+		return nil
 	}
-	return fmt.Errorf("unknown ScopeSet unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ScopeSetMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case scopeset.EdgeGroups:
-		m.ResetGroups()
+	case user.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case user.EdgePoints:
+		m.ResetPoints()
+		return nil
+	case user.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case user.EdgeSubmissions:
+		m.ResetSubmissions()
+		return nil
+	case user.EdgeCheatRecords:
+		m.ResetCheatRecords()
 		return nil
 	}
-	return fmt.Errorf("unknown ScopeSet edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SubmissionMutation represents an operation that mutates the Submission nodes in the graph.
-type SubmissionMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	submitted_code  *string
-	status          *submission.Status
-	query_result    **models.UserSQLExecutionResult
-	error           *string
-	submitted_at    *time.Time
-	clearedFields   map[string]struct{}
-	question        *int
-	clearedquestion bool
-	user            *int
-	cleareduser     bool
-	done            bool
-	oldValue        func(context.Context) (*Submission, error)
-	predicates      []predicate.Submission
-}
-
-var _ ent.Mutation = (*SubmissionMutation)(nil)
-
-// submissionOption allows management of the mutation configuration using functional options.
-type submissionOption func(*SubmissionMutation)
-
-// newSubmissionMutation creates new mutation for the Submission entity.
-func newSubmissionMutation(c config, op Op, opts ...submissionOption) *SubmissionMutation {
-	m := &SubmissionMutation{
+	op                  Op
+	typ                 string
+	id                  *int
+	event_id            *int
+	addevent_id         *int
+	event_type          *string
+	attempt             *int
+	addattempt          *int
+	status              *webhookdelivery.Status
+	response_status     *int
+	addresponse_status  *int
+	error               *string
+	duration_ms         *int
+	addduration_ms      *int
+	delivered_at        *time.Time
+	clearedFields       map[string]struct{}
+	subscription        *int
+	clearedsubscription bool
+	done                bool
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubmission,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4420,20 +11788,20 @@ func newSubmissionMutation(c config, op Op, opts ...submissionOption) *Submissio
 	return m
 }
 
-// withSubmissionID sets the ID field of the mutation.
-func withSubmissionID(id int) submissionOption {
-	return func(m *SubmissionMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Submission
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Submission, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Submission.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4442,10 +11810,10 @@ func withSubmissionID(id int) submissionOption {
 	}
 }
 
-// withSubmission sets the old Submission of the mutation.
-func withSubmission(node *Submission) submissionOption {
-	return func(m *SubmissionMutation) {
-		m.oldValue = func(context.Context) (*Submission, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4454,7 +11822,7 @@ func withSubmission(node *Submission) submissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubmissionMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4462,7 +11830,7 @@ func (m SubmissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubmissionMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4473,7 +11841,7 @@ func (m SubmissionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubmissionMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4484,7 +11852,7 @@ func (m *SubmissionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubmissionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4493,55 +11861,167 @@ func (m *SubmissionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Submission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSubmittedCode sets the "submitted_code" field.
-func (m *SubmissionMutation) SetSubmittedCode(s string) {
-	m.submitted_code = &s
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(i int) {
+	m.event_id = &i
+	m.addevent_id = nil
 }
 
-// SubmittedCode returns the value of the "submitted_code" field in the mutation.
-func (m *SubmissionMutation) SubmittedCode() (r string, exists bool) {
-	v := m.submitted_code
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r int, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubmittedCode returns the old "submitted_code" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldSubmittedCode(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubmittedCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubmittedCode requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubmittedCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.SubmittedCode, nil
+	return oldValue.EventID, nil
 }
 
-// ResetSubmittedCode resets all changes to the "submitted_code" field.
-func (m *SubmissionMutation) ResetSubmittedCode() {
-	m.submitted_code = nil
+// AddEventID adds i to the "event_id" field.
+func (m *WebhookDeliveryMutation) AddEventID(i int) {
+	if m.addevent_id != nil {
+		*m.addevent_id += i
+	} else {
+		m.addevent_id = &i
+	}
+}
+
+// AddedEventID returns the value that was added to the "event_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedEventID() (r int, exists bool) {
+	v := m.addevent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+	m.addevent_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *WebhookDeliveryMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *WebhookDeliveryMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *WebhookDeliveryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
 }
 
 // SetStatus sets the "status" field.
-func (m *SubmissionMutation) SetStatus(s submission.Status) {
-	m.status = &s
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *SubmissionMutation) Status() (r submission.Status, exists bool) {
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -4549,10 +12029,10 @@ func (m *SubmissionMutation) Status() (r submission.Status, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldStatus(ctx context.Context) (v submission.Status, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -4567,66 +12047,87 @@ func (m *SubmissionMutation) OldStatus(ctx context.Context) (v submission.Status
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *SubmissionMutation) ResetStatus() {
+func (m *WebhookDeliveryMutation) ResetStatus() {
 	m.status = nil
 }
 
-// SetQueryResult sets the "query_result" field.
-func (m *SubmissionMutation) SetQueryResult(mser *models.UserSQLExecutionResult) {
-	m.query_result = &mser
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// QueryResult returns the value of the "query_result" field in the mutation.
-func (m *SubmissionMutation) QueryResult() (r *models.UserSQLExecutionResult, exists bool) {
-	v := m.query_result
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQueryResult returns the old "query_result" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldQueryResult(ctx context.Context) (v *models.UserSQLExecutionResult, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQueryResult is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQueryResult requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQueryResult: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.QueryResult, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ClearQueryResult clears the value of the "query_result" field.
-func (m *SubmissionMutation) ClearQueryResult() {
-	m.query_result = nil
-	m.clearedFields[submission.FieldQueryResult] = struct{}{}
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// QueryResultCleared returns if the "query_result" field was cleared in this mutation.
-func (m *SubmissionMutation) QueryResultCleared() bool {
-	_, ok := m.clearedFields[submission.FieldQueryResult]
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
 	return ok
 }
 
-// ResetQueryResult resets all changes to the "query_result" field.
-func (m *SubmissionMutation) ResetQueryResult() {
-	m.query_result = nil
-	delete(m.clearedFields, submission.FieldQueryResult)
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
 // SetError sets the "error" field.
-func (m *SubmissionMutation) SetError(s string) {
+func (m *WebhookDeliveryMutation) SetError(s string) {
 	m.error = &s
 }
 
 // Error returns the value of the "error" field in the mutation.
-func (m *SubmissionMutation) Error() (r string, exists bool) {
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
 	v := m.error
 	if v == nil {
 		return
@@ -4634,10 +12135,10 @@ func (m *SubmissionMutation) Error() (r string, exists bool) {
 	return *v, true
 }
 
-// OldError returns the old "error" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldError(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
@@ -4652,146 +12153,163 @@ func (m *SubmissionMutation) OldError(ctx context.Context) (v *string, err error
 }
 
 // ClearError clears the value of the "error" field.
-func (m *SubmissionMutation) ClearError() {
+func (m *WebhookDeliveryMutation) ClearError() {
 	m.error = nil
-	m.clearedFields[submission.FieldError] = struct{}{}
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
 // ErrorCleared returns if the "error" field was cleared in this mutation.
-func (m *SubmissionMutation) ErrorCleared() bool {
-	_, ok := m.clearedFields[submission.FieldError]
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
 	return ok
 }
 
 // ResetError resets all changes to the "error" field.
-func (m *SubmissionMutation) ResetError() {
+func (m *WebhookDeliveryMutation) ResetError() {
 	m.error = nil
-	delete(m.clearedFields, submission.FieldError)
+	delete(m.clearedFields, webhookdelivery.FieldError)
 }
 
-// SetSubmittedAt sets the "submitted_at" field.
-func (m *SubmissionMutation) SetSubmittedAt(t time.Time) {
-	m.submitted_at = &t
+// SetDurationMs sets the "duration_ms" field.
+func (m *WebhookDeliveryMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// SubmittedAt returns the value of the "submitted_at" field in the mutation.
-func (m *SubmissionMutation) SubmittedAt() (r time.Time, exists bool) {
-	v := m.submitted_at
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WebhookDeliveryMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubmittedAt returns the old "submitted_at" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldDurationMs(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.SubmittedAt, nil
+	return oldValue.DurationMs, nil
 }
 
-// ResetSubmittedAt resets all changes to the "submitted_at" field.
-func (m *SubmissionMutation) ResetSubmittedAt() {
-	m.submitted_at = nil
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// SetQuestionID sets the "question" edge to the Question entity by id.
-func (m *SubmissionMutation) SetQuestionID(id int) {
-	m.question = &id
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *SubmissionMutation) ClearQuestion() {
-	m.clearedquestion = true
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *SubmissionMutation) QuestionCleared() bool {
-	return m.clearedquestion
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// QuestionID returns the "question" edge ID in the mutation.
-func (m *SubmissionMutation) QuestionID() (id int, exists bool) {
-	if m.question != nil {
-		return *m.question, true
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// QuestionIDs returns the "question" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QuestionID instead. It exists only for internal usage by the builders.
-func (m *SubmissionMutation) QuestionIDs() (ids []int) {
-	if id := m.question; id != nil {
-		ids = append(ids, *id)
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetQuestion resets all changes to the "question" edge.
-func (m *SubmissionMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *SubmissionMutation) SetUserID(id int) {
-	m.user = &id
+// SetSubscriptionID sets the "subscription" edge to the WebhookSubscription entity by id.
+func (m *WebhookDeliveryMutation) SetSubscriptionID(id int) {
+	m.subscription = &id
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SubmissionMutation) ClearUser() {
-	m.cleareduser = true
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (m *WebhookDeliveryMutation) ClearSubscription() {
+	m.clearedsubscription = true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SubmissionMutation) UserCleared() bool {
-	return m.cleareduser
+// SubscriptionCleared reports if the "subscription" edge to the WebhookSubscription entity was cleared.
+func (m *WebhookDeliveryMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
 }
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *SubmissionMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+
+// SubscriptionID returns the "subscription" edge ID in the mutation.
+func (m *WebhookDeliveryMutation) SubscriptionID() (id int, exists bool) {
+	if m.subscription != nil {
+		return *m.subscription, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SubmissionMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) SubscriptionIDs() (ids []int) {
+	if id := m.subscription; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SubmissionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *WebhookDeliveryMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
 }
 
-// Where appends a list predicates to the SubmissionMutation builder.
-func (m *SubmissionMutation) Where(ps ...predicate.Submission) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubmissionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubmissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Submission, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4799,39 +12317,48 @@ func (m *SubmissionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubmissionMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubmissionMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Submission).
-func (m *SubmissionMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubmissionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.submitted_code != nil {
-		fields = append(fields, submission.FieldSubmittedCode)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.attempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
 	}
 	if m.status != nil {
-		fields = append(fields, submission.FieldStatus)
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.query_result != nil {
-		fields = append(fields, submission.FieldQueryResult)
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
 	if m.error != nil {
-		fields = append(fields, submission.FieldError)
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	if m.submitted_at != nil {
-		fields = append(fields, submission.FieldSubmittedAt)
+	if m.duration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -4839,18 +12366,24 @@ func (m *SubmissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case submission.FieldSubmittedCode:
-		return m.SubmittedCode()
-	case submission.FieldStatus:
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldAttempt:
+		return m.Attempt()
+	case webhookdelivery.FieldStatus:
 		return m.Status()
-	case submission.FieldQueryResult:
-		return m.QueryResult()
-	case submission.FieldError:
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldError:
 		return m.Error()
-	case submission.FieldSubmittedAt:
-		return m.SubmittedAt()
+	case webhookdelivery.FieldDurationMs:
+		return m.DurationMs()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -4858,167 +12391,247 @@ func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubmissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case submission.FieldSubmittedCode:
-		return m.OldSubmittedCode(ctx)
-	case submission.FieldStatus:
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case webhookdelivery.FieldStatus:
 		return m.OldStatus(ctx)
-	case submission.FieldQueryResult:
-		return m.OldQueryResult(ctx)
-	case submission.FieldError:
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldError:
 		return m.OldError(ctx)
-	case submission.FieldSubmittedAt:
-		return m.OldSubmittedAt(ctx)
+	case webhookdelivery.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Submission field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubmissionMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case submission.FieldSubmittedCode:
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubmittedCode(v)
+		m.SetEventType(v)
 		return nil
-	case submission.FieldStatus:
-		v, ok := value.(submission.Status)
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case submission.FieldQueryResult:
-		v, ok := value.(*models.UserSQLExecutionResult)
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQueryResult(v)
+		m.SetResponseStatus(v)
 		return nil
-	case submission.FieldError:
+	case webhookdelivery.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetError(v)
 		return nil
-	case submission.FieldSubmittedAt:
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubmittedAt(v)
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Submission field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubmissionMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.addattempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubmissionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldEventID:
+		return m.AddedEventID()
+	case webhookdelivery.FieldAttempt:
+		return m.AddedAttempt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubmissionMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventID(v)
+		return nil
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Submission numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubmissionMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(submission.FieldQueryResult) {
-		fields = append(fields, submission.FieldQueryResult)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.FieldCleared(submission.FieldError) {
-		fields = append(fields, submission.FieldError)
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubmissionMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubmissionMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case submission.FieldQueryResult:
-		m.ClearQueryResult()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
 		return nil
-	case submission.FieldError:
+	case webhookdelivery.FieldError:
 		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubmissionMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case submission.FieldSubmittedCode:
-		m.ResetSubmittedCode()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case submission.FieldStatus:
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case webhookdelivery.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case submission.FieldQueryResult:
-		m.ResetQueryResult()
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
 		return nil
-	case submission.FieldError:
+	case webhookdelivery.FieldError:
 		m.ResetError()
 		return nil
-	case submission.FieldSubmittedAt:
-		m.ResetSubmittedAt()
+	case webhookdelivery.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubmissionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.question != nil {
-		edges = append(edges, submission.EdgeQuestion)
-	}
-	if m.user != nil {
-		edges = append(edges, submission.EdgeUser)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscription != nil {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case submission.EdgeQuestion:
-		if id := m.question; id != nil {
-			return []ent.Value{*id}
-		}
-	case submission.EdgeUser:
-		if id := m.user; id != nil {
+	case webhookdelivery.EdgeSubscription:
+		if id := m.subscription; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5026,112 +12639,94 @@ func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubmissionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubmissionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubmissionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquestion {
-		edges = append(edges, submission.EdgeQuestion)
-	}
-	if m.cleareduser {
-		edges = append(edges, submission.EdgeUser)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscription {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubmissionMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case submission.EdgeQuestion:
-		return m.clearedquestion
-	case submission.EdgeUser:
-		return m.cleareduser
+	case webhookdelivery.EdgeSubscription:
+		return m.clearedsubscription
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubmissionMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case submission.EdgeQuestion:
-		m.ClearQuestion()
-		return nil
-	case submission.EdgeUser:
-		m.ClearUser()
+	case webhookdelivery.EdgeSubscription:
+		m.ClearSubscription()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubmissionMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case submission.EdgeQuestion:
-		m.ResetQuestion()
-		return nil
-	case submission.EdgeUser:
-		m.ResetUser()
+	case webhookdelivery.EdgeSubscription:
+		m.ResetSubscription()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookSubscriptionMutation represents an operation that mutates the WebhookSubscription nodes in the graph.
+type WebhookSubscriptionMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	created_at           *time.Time
-	updated_at           *time.Time
-	deleted_at           *time.Time
-	name                 *string
-	email                *string
-	avatar               *string
-	clearedFields        map[string]struct{}
-	group                *int
-	clearedgroup         bool
-	points               map[int]struct{}
-	removedpoints        map[int]struct{}
-	clearedpoints        bool
-	events               map[int]struct{}
-	removedevents        map[int]struct{}
-	clearedevents        bool
-	submissions          map[int]struct{}
-	removedsubmissions   map[int]struct{}
-	clearedsubmissions   bool
-	cheat_records        map[int]struct{}
-	removedcheat_records map[int]struct{}
-	clearedcheat_records bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *time.Time
+	name              *string
+	url               *string
+	secret            *string
+	event_types       *[]string
+	appendevent_types []string
+	enabled           *bool
+	description       *string
+	clearedFields     map[string]struct{}
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookSubscription, error)
+	predicates        []predicate.WebhookSubscription
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*WebhookSubscriptionMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// webhooksubscriptionOption allows management of the mutation configuration using functional options.
+type webhooksubscriptionOption func(*WebhookSubscriptionMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newWebhookSubscriptionMutation creates new mutation for the WebhookSubscription entity.
+func newWebhookSubscriptionMutation(c config, op Op, opts ...webhooksubscriptionOption) *WebhookSubscriptionMutation {
+	m := &WebhookSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5140,20 +12735,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withWebhookSubscriptionID sets the ID field of the mutation.
+func withWebhookSubscriptionID(id int) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5162,10 +12757,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookSubscription sets the old WebhookSubscription of the mutation.
+func withWebhookSubscription(node *WebhookSubscription) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebhookSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5174,7 +12769,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5182,7 +12777,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5193,7 +12788,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *WebhookSubscriptionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5204,7 +12799,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5213,19 +12808,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5233,10 +12828,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5251,17 +12846,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WebhookSubscriptionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5269,10 +12864,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5287,17 +12882,17 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *WebhookSubscriptionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -5305,10 +12900,10 @@ func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -5323,30 +12918,30 @@ func (m *UserMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
+func (m *WebhookSubscriptionMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+	m.clearedFields[webhooksubscription.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
+func (m *WebhookSubscriptionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[webhooksubscription.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
+func (m *WebhookSubscriptionMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
+	delete(m.clearedFields, webhooksubscription.FieldDeletedAt)
 }
 
 // SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
+func (m *WebhookSubscriptionMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
+func (m *WebhookSubscriptionMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -5354,10 +12949,10 @@ func (m *UserMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookSubscriptionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -5372,359 +12967,281 @@ func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
+func (m *WebhookSubscriptionMutation) ResetName() {
 	m.name = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetURL sets the "url" field.
+func (m *WebhookSubscriptionMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.URL, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSubscriptionMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *WebhookSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Avatar, nil
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
-}
-
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
-}
-
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
-}
-
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *UserMutation) SetGroupID(id int) {
-	m.group = &id
+	return oldValue.Secret, nil
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *UserMutation) ClearGroup() {
-	m.clearedgroup = true
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSubscriptionMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *UserMutation) GroupCleared() bool {
-	return m.clearedgroup
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookSubscriptionMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *UserMutation) GroupID() (id int, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookSubscriptionMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) GroupIDs() (ids []int) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// OldEventTypes returns the old "event_types" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetGroup resets all changes to the "group" edge.
-func (m *UserMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
-}
-
-// AddPointIDs adds the "points" edge to the Point entity by ids.
-func (m *UserMutation) AddPointIDs(ids ...int) {
-	if m.points == nil {
-		m.points = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.points[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
+	return oldValue.EventTypes, nil
 }
 
-// ClearPoints clears the "points" edge to the Point entity.
-func (m *UserMutation) ClearPoints() {
-	m.clearedpoints = true
-}
-
-// PointsCleared reports if the "points" edge to the Point entity was cleared.
-func (m *UserMutation) PointsCleared() bool {
-	return m.clearedpoints
-}
-
-// RemovePointIDs removes the "points" edge to the Point entity by IDs.
-func (m *UserMutation) RemovePointIDs(ids ...int) {
-	if m.removedpoints == nil {
-		m.removedpoints = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.points, ids[i])
-		m.removedpoints[ids[i]] = struct{}{}
-	}
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookSubscriptionMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// RemovedPoints returns the removed IDs of the "points" edge to the Point entity.
-func (m *UserMutation) RemovedPointsIDs() (ids []int) {
-	for id := range m.removedpoints {
-		ids = append(ids, id)
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookSubscriptionMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendevent_types, true
 }
 
-// PointsIDs returns the "points" edge IDs in the mutation.
-func (m *UserMutation) PointsIDs() (ids []int) {
-	for id := range m.points {
-		ids = append(ids, id)
-	}
-	return
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookSubscriptionMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
 }
 
-// ResetPoints resets all changes to the "points" edge.
-func (m *UserMutation) ResetPoints() {
-	m.points = nil
-	m.clearedpoints = false
-	m.removedpoints = nil
+// SetEnabled sets the "enabled" field.
+func (m *WebhookSubscriptionMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// AddEventIDs adds the "events" edge to the Event entity by ids.
-func (m *UserMutation) AddEventIDs(ids ...int) {
-	if m.events == nil {
-		m.events = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.events[ids[i]] = struct{}{}
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookSubscriptionMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearEvents clears the "events" edge to the Event entity.
-func (m *UserMutation) ClearEvents() {
-	m.clearedevents = true
-}
-
-// EventsCleared reports if the "events" edge to the Event entity was cleared.
-func (m *UserMutation) EventsCleared() bool {
-	return m.clearedevents
-}
-
-// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
-func (m *UserMutation) RemoveEventIDs(ids ...int) {
-	if m.removedevents == nil {
-		m.removedevents = make(map[int]struct{})
+// OldEnabled returns the old "enabled" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.events, ids[i])
-		m.removedevents[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
-}
-
-// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
-func (m *UserMutation) RemovedEventsIDs() (ids []int) {
-	for id := range m.removedevents {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return
+	return oldValue.Enabled, nil
 }
 
-// EventsIDs returns the "events" edge IDs in the mutation.
-func (m *UserMutation) EventsIDs() (ids []int) {
-	for id := range m.events {
-		ids = append(ids, id)
-	}
-	return
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookSubscriptionMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// ResetEvents resets all changes to the "events" edge.
-func (m *UserMutation) ResetEvents() {
-	m.events = nil
-	m.clearedevents = false
-	m.removedevents = nil
+// SetDescription sets the "description" field.
+func (m *WebhookSubscriptionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// AddSubmissionIDs adds the "submissions" edge to the Submission entity by ids.
-func (m *UserMutation) AddSubmissionIDs(ids ...int) {
-	if m.submissions == nil {
-		m.submissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.submissions[ids[i]] = struct{}{}
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookSubscriptionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSubmissions clears the "submissions" edge to the Submission entity.
-func (m *UserMutation) ClearSubmissions() {
-	m.clearedsubmissions = true
-}
-
-// SubmissionsCleared reports if the "submissions" edge to the Submission entity was cleared.
-func (m *UserMutation) SubmissionsCleared() bool {
-	return m.clearedsubmissions
-}
-
-// RemoveSubmissionIDs removes the "submissions" edge to the Submission entity by IDs.
-func (m *UserMutation) RemoveSubmissionIDs(ids ...int) {
-	if m.removedsubmissions == nil {
-		m.removedsubmissions = make(map[int]struct{})
+// OldDescription returns the old "description" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.submissions, ids[i])
-		m.removedsubmissions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
+	return oldValue.Description, nil
 }
 
-// RemovedSubmissions returns the removed IDs of the "submissions" edge to the Submission entity.
-func (m *UserMutation) RemovedSubmissionsIDs() (ids []int) {
-	for id := range m.removedsubmissions {
-		ids = append(ids, id)
-	}
-	return
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookSubscriptionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhooksubscription.FieldDescription] = struct{}{}
 }
 
-// SubmissionsIDs returns the "submissions" edge IDs in the mutation.
-func (m *UserMutation) SubmissionsIDs() (ids []int) {
-	for id := range m.submissions {
-		ids = append(ids, id)
-	}
-	return
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookSubscriptionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhooksubscription.FieldDescription]
+	return ok
 }
 
-// ResetSubmissions resets all changes to the "submissions" edge.
-func (m *UserMutation) ResetSubmissions() {
-	m.submissions = nil
-	m.clearedsubmissions = false
-	m.removedsubmissions = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookSubscriptionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhooksubscription.FieldDescription)
 }
 
-// AddCheatRecordIDs adds the "cheat_records" edge to the CheatRecord entity by ids.
-func (m *UserMutation) AddCheatRecordIDs(ids ...int) {
-	if m.cheat_records == nil {
-		m.cheat_records = make(map[int]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookSubscriptionMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.cheat_records[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCheatRecords clears the "cheat_records" edge to the CheatRecord entity.
-func (m *UserMutation) ClearCheatRecords() {
-	m.clearedcheat_records = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// CheatRecordsCleared reports if the "cheat_records" edge to the CheatRecord entity was cleared.
-func (m *UserMutation) CheatRecordsCleared() bool {
-	return m.clearedcheat_records
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookSubscriptionMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveCheatRecordIDs removes the "cheat_records" edge to the CheatRecord entity by IDs.
-func (m *UserMutation) RemoveCheatRecordIDs(ids ...int) {
-	if m.removedcheat_records == nil {
-		m.removedcheat_records = make(map[int]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookSubscriptionMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.cheat_records, ids[i])
-		m.removedcheat_records[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCheatRecords returns the removed IDs of the "cheat_records" edge to the CheatRecord entity.
-func (m *UserMutation) RemovedCheatRecordsIDs() (ids []int) {
-	for id := range m.removedcheat_records {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CheatRecordsIDs returns the "cheat_records" edge IDs in the mutation.
-func (m *UserMutation) CheatRecordsIDs() (ids []int) {
-	for id := range m.cheat_records {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookSubscriptionMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCheatRecords resets all changes to the "cheat_records" edge.
-func (m *UserMutation) ResetCheatRecords() {
-	m.cheat_records = nil
-	m.clearedcheat_records = false
-	m.removedcheat_records = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookSubscriptionMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookSubscriptionMutation builder.
+func (m *WebhookSubscriptionMutation) Where(ps ...predicate.WebhookSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5732,42 +13249,51 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookSubscription).
+func (m *WebhookSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *WebhookSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webhooksubscription.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, webhooksubscription.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
+		fields = append(fields, webhooksubscription.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, user.FieldName)
+		fields = append(fields, webhooksubscription.FieldName)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.url != nil {
+		fields = append(fields, webhooksubscription.FieldURL)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.secret != nil {
+		fields = append(fields, webhooksubscription.FieldSecret)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhooksubscription.FieldEventTypes)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhooksubscription.FieldEnabled)
+	}
+	if m.description != nil {
+		fields = append(fields, webhooksubscription.FieldDescription)
 	}
 	return fields
 }
@@ -5775,20 +13301,26 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		return m.DeletedAt()
-	case user.FieldName:
+	case webhooksubscription.FieldName:
 		return m.Name()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldAvatar:
-		return m.Avatar()
+	case webhooksubscription.FieldURL:
+		return m.URL()
+	case webhooksubscription.FieldSecret:
+		return m.Secret()
+	case webhooksubscription.FieldEventTypes:
+		return m.EventTypes()
+	case webhooksubscription.FieldEnabled:
+		return m.Enabled()
+	case webhooksubscription.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -5796,207 +13328,209 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case user.FieldName:
+	case webhooksubscription.FieldName:
 		return m.OldName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
+	case webhooksubscription.FieldURL:
+		return m.OldURL(ctx)
+	case webhooksubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhooksubscription.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhooksubscription.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhooksubscription.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case user.FieldName:
+	case webhooksubscription.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case user.FieldEmail:
+	case webhooksubscription.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetURL(v)
 		return nil
-	case user.FieldAvatar:
+	case webhooksubscription.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetSecret(v)
+		return nil
+	case webhooksubscription.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhooksubscription.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhooksubscription.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookSubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookSubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldDeletedAt) {
-		fields = append(fields, user.FieldDeletedAt)
+	if m.FieldCleared(webhooksubscription.FieldDeletedAt) {
+		fields = append(fields, webhooksubscription.FieldDeletedAt)
 	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	if m.FieldCleared(webhooksubscription.FieldDescription) {
+		fields = append(fields, webhooksubscription.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebhookSubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
+	case webhooksubscription.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case user.FieldName:
+	case webhooksubscription.FieldName:
 		m.ResetName()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhooksubscription.FieldURL:
+		m.ResetURL()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case webhooksubscription.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhooksubscription.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhooksubscription.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhooksubscription.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.group != nil {
-		edges = append(edges, user.EdgeGroup)
-	}
-	if m.points != nil {
-		edges = append(edges, user.EdgePoints)
-	}
-	if m.events != nil {
-		edges = append(edges, user.EdgeEvents)
-	}
-	if m.submissions != nil {
-		edges = append(edges, user.EdgeSubmissions)
-	}
-	if m.cheat_records != nil {
-		edges = append(edges, user.EdgeCheatRecords)
+func (m *WebhookSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgePoints:
-		ids := make([]ent.Value, 0, len(m.points))
-		for id := range m.points {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.events))
-		for id := range m.events {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSubmissions:
-		ids := make([]ent.Value, 0, len(m.submissions))
-		for id := range m.submissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCheatRecords:
-		ids := make([]ent.Value, 0, len(m.cheat_records))
-		for id := range m.cheat_records {
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6005,48 +13539,21 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedpoints != nil {
-		edges = append(edges, user.EdgePoints)
-	}
-	if m.removedevents != nil {
-		edges = append(edges, user.EdgeEvents)
-	}
-	if m.removedsubmissions != nil {
-		edges = append(edges, user.EdgeSubmissions)
-	}
-	if m.removedcheat_records != nil {
-		edges = append(edges, user.EdgeCheatRecords)
+func (m *WebhookSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgePoints:
-		ids := make([]ent.Value, 0, len(m.removedpoints))
-		for id := range m.removedpoints {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.removedevents))
-		for id := range m.removedevents {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSubmissions:
-		ids := make([]ent.Value, 0, len(m.removedsubmissions))
-		for id := range m.removedsubmissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCheatRecords:
-		ids := make([]ent.Value, 0, len(m.removedcheat_records))
-		for id := range m.removedcheat_records {
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6055,74 +13562,39 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedgroup {
-		edges = append(edges, user.EdgeGroup)
-	}
-	if m.clearedpoints {
-		edges = append(edges, user.EdgePoints)
-	}
-	if m.clearedevents {
-		edges = append(edges, user.EdgeEvents)
-	}
-	if m.clearedsubmissions {
-		edges = append(edges, user.EdgeSubmissions)
-	}
-	if m.clearedcheat_records {
-		edges = append(edges, user.EdgeCheatRecords)
+func (m *WebhookSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeGroup:
-		return m.clearedgroup
-	case user.EdgePoints:
-		return m.clearedpoints
-	case user.EdgeEvents:
-		return m.clearedevents
-	case user.EdgeSubmissions:
-		return m.clearedsubmissions
-	case user.EdgeCheatRecords:
-		return m.clearedcheat_records
+	case webhooksubscription.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ClearGroup()
-		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case user.EdgePoints:
-		m.ResetPoints()
-		return nil
-	case user.EdgeEvents:
-		m.ResetEvents()
-		return nil
-	case user.EdgeSubmissions:
-		m.ResetSubmissions()
-		return nil
-	case user.EdgeCheatRecords:
-		m.ResetCheatRecords()
+	case webhooksubscription.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription edge %s", name)
 }
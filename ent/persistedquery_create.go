@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
+)
+
+// PersistedQueryCreate is the builder for creating a PersistedQuery entity.
+type PersistedQueryCreate struct {
+	config
+	mutation *PersistedQueryMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (_c *PersistedQueryCreate) SetHash(v string) *PersistedQueryCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *PersistedQueryCreate) SetQuery(v string) *PersistedQueryCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PersistedQueryCreate) SetCreatedAt(v time.Time) *PersistedQueryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PersistedQueryCreate) SetNillableCreatedAt(v *time.Time) *PersistedQueryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PersistedQueryMutation object of the builder.
+func (_c *PersistedQueryCreate) Mutation() *PersistedQueryMutation {
+	return _c.mutation
+}
+
+// Save creates the PersistedQuery in the database.
+func (_c *PersistedQueryCreate) Save(ctx context.Context) (*PersistedQuery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PersistedQueryCreate) SaveX(ctx context.Context) *PersistedQuery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersistedQueryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersistedQueryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PersistedQueryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := persistedquery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PersistedQueryCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "PersistedQuery.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := persistedquery.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "PersistedQuery.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "PersistedQuery.query"`)}
+	}
+	if v, ok := _c.mutation.Query(); ok {
+		if err := persistedquery.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "PersistedQuery.query": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersistedQuery.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PersistedQueryCreate) sqlSave(ctx context.Context) (*PersistedQuery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PersistedQueryCreate) createSpec() (*PersistedQuery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersistedQuery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(persistedquery.Table, sqlgraph.NewFieldSpec(persistedquery.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(persistedquery.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(persistedquery.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(persistedquery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PersistedQueryCreateBulk is the builder for creating many PersistedQuery entities in bulk.
+type PersistedQueryCreateBulk struct {
+	config
+	err      error
+	builders []*PersistedQueryCreate
+}
+
+// Save creates the PersistedQuery entities in the database.
+func (_c *PersistedQueryCreateBulk) Save(ctx context.Context) ([]*PersistedQuery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PersistedQuery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersistedQueryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PersistedQueryCreateBulk) SaveX(ctx context.Context) []*PersistedQuery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersistedQueryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersistedQueryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
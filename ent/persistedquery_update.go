@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// PersistedQueryUpdate is the builder for updating PersistedQuery entities.
+type PersistedQueryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PersistedQueryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PersistedQueryUpdate builder.
+func (_u *PersistedQueryUpdate) Where(ps ...predicate.PersistedQuery) *PersistedQueryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PersistedQueryMutation object of the builder.
+func (_u *PersistedQueryUpdate) Mutation() *PersistedQueryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PersistedQueryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersistedQueryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PersistedQueryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersistedQueryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PersistedQueryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersistedQueryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PersistedQueryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(persistedquery.Table, persistedquery.Columns, sqlgraph.NewFieldSpec(persistedquery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{persistedquery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PersistedQueryUpdateOne is the builder for updating a single PersistedQuery entity.
+type PersistedQueryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PersistedQueryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the PersistedQueryMutation object of the builder.
+func (_u *PersistedQueryUpdateOne) Mutation() *PersistedQueryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PersistedQueryUpdate builder.
+func (_u *PersistedQueryUpdateOne) Where(ps ...predicate.PersistedQuery) *PersistedQueryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PersistedQueryUpdateOne) Select(field string, fields ...string) *PersistedQueryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PersistedQuery entity.
+func (_u *PersistedQueryUpdateOne) Save(ctx context.Context) (*PersistedQuery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersistedQueryUpdateOne) SaveX(ctx context.Context) *PersistedQuery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PersistedQueryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersistedQueryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PersistedQueryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersistedQueryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PersistedQueryUpdateOne) sqlSave(ctx context.Context) (_node *PersistedQuery, err error) {
+	_spec := sqlgraph.NewUpdateSpec(persistedquery.Table, persistedquery.Columns, sqlgraph.NewFieldSpec(persistedquery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersistedQuery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, persistedquery.FieldID)
+		for _, f := range fields {
+			if !persistedquery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != persistedquery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PersistedQuery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{persistedquery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
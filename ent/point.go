@@ -24,6 +24,8 @@ type Point struct {
 	GrantedAt time.Time `json:"granted_at,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PointQuery when eager-loading is set.
 	Edges        PointEdges `json:"edges"`
@@ -60,7 +62,7 @@ func (*Point) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case point.FieldID, point.FieldPoints:
 			values[i] = new(sql.NullInt64)
-		case point.FieldDescription:
+		case point.FieldDescription, point.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
 		case point.FieldGrantedAt:
 			values[i] = new(sql.NullTime)
@@ -105,6 +107,13 @@ func (_m *Point) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case point.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
+			}
 		case point.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_points", value)
@@ -161,6 +170,11 @@ func (_m *Point) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -63,6 +63,20 @@ func (_c *PointCreate) SetNillableDescription(v *string) *PointCreate {
 	return _c
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *PointCreate) SetIdempotencyKey(v string) *PointCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_c *PointCreate) SetNillableIdempotencyKey(v *string) *PointCreate {
+	if v != nil {
+		_c.SetIdempotencyKey(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *PointCreate) SetUserID(id int) *PointCreate {
 	_c.mutation.SetUserID(id)
@@ -168,6 +182,10 @@ func (_c *PointCreate) createSpec() (*Point, *sqlgraph.CreateSpec) {
 		_spec.SetField(point.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(point.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
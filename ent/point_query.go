@@ -25,8 +25,8 @@ type PointQuery struct {
 	predicates []predicate.Point
 	withUser   *UserQuery
 	withFKs    bool
-	modifiers  []func(*sql.Selector)
 	loadTotal  []func(context.Context, []*Point) error
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -279,8 +279,9 @@ func (_q *PointQuery) Clone() *PointQuery {
 		predicates: append([]predicate.Point{}, _q.predicates...),
 		withUser:   _q.withUser.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -519,6 +520,9 @@ func (_q *PointQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -536,6 +540,12 @@ func (_q *PointQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *PointQuery) Modify(modifiers ...func(s *sql.Selector)) *PointSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // PointGroupBy is the group-by builder for Point entities.
 type PointGroupBy struct {
 	selector
@@ -625,3 +635,9 @@ func (_s *PointSelect) sqlScan(ctx context.Context, root *PointQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *PointSelect) Modify(modifiers ...func(s *sql.Selector)) *PointSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
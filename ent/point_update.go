@@ -19,8 +19,9 @@ import (
 // PointUpdate is the builder for updating Point entities.
 type PointUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PointMutation
+	hooks     []Hook
+	mutation  *PointMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PointUpdate builder.
@@ -141,6 +142,12 @@ func (_u *PointUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PointUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PointUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -168,6 +175,9 @@ func (_u *PointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(point.FieldDescription, field.TypeString)
 	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(point.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -197,6 +207,7 @@ func (_u *PointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{point.Label}
@@ -212,9 +223,10 @@ func (_u *PointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // PointUpdateOne is the builder for updating a single Point entity.
 type PointUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PointMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PointMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPoints sets the "points" field.
@@ -342,6 +354,12 @@ func (_u *PointUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PointUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PointUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PointUpdateOne) sqlSave(ctx context.Context) (_node *Point, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -386,6 +404,9 @@ func (_u *PointUpdateOne) sqlSave(ctx context.Context) (_node *Point, err error)
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(point.FieldDescription, field.TypeString)
 	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(point.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -415,6 +436,7 @@ func (_u *PointUpdateOne) sqlSave(ctx context.Context) (_node *Point, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Point{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ArchivedEvent is the predicate function for archivedevent builders.
+type ArchivedEvent func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // CheatRecord is the predicate function for cheatrecord builders.
 type CheatRecord func(*sql.Selector)
 
@@ -15,15 +21,30 @@ type Database func(*sql.Selector)
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
+// EventDailyCount is the predicate function for eventdailycount builders.
+type EventDailyCount func(*sql.Selector)
+
+// EventOutbox is the predicate function for eventoutbox builders.
+type EventOutbox func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// PersistedQuery is the predicate function for persistedquery builders.
+type PersistedQuery func(*sql.Selector)
+
 // Point is the predicate function for point builders.
 type Point func(*sql.Selector)
 
 // Question is the predicate function for question builders.
 type Question func(*sql.Selector)
 
+// RankSnapshot is the predicate function for ranksnapshot builders.
+type RankSnapshot func(*sql.Selector)
+
 // ScopeSet is the predicate function for scopeset builders.
 type ScopeSet func(*sql.Selector)
 
@@ -32,3 +53,9 @@ type Submission func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
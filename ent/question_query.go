@@ -28,8 +28,8 @@ type QuestionQuery struct {
 	withDatabase         *DatabaseQuery
 	withSubmissions      *SubmissionQuery
 	withFKs              bool
-	modifiers            []func(*sql.Selector)
 	loadTotal            []func(context.Context, []*Question) error
+	modifiers            []func(*sql.Selector)
 	withNamedSubmissions map[string]*SubmissionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -306,8 +306,9 @@ func (_q *QuestionQuery) Clone() *QuestionQuery {
 		withDatabase:    _q.withDatabase.Clone(),
 		withSubmissions: _q.withSubmissions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -603,6 +604,9 @@ func (_q *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -620,6 +624,12 @@ func (_q *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *QuestionQuery) Modify(modifiers ...func(s *sql.Selector)) *QuestionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WithNamedSubmissions tells the query-builder to eager-load the nodes that are connected to the "submissions"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *QuestionQuery) WithNamedSubmissions(name string, opts ...func(*SubmissionQuery)) *QuestionQuery {
@@ -723,3 +733,9 @@ func (_s *QuestionSelect) sqlScan(ctx context.Context, root *QuestionQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *QuestionSelect) Modify(modifiers ...func(s *sql.Selector)) *QuestionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+)
+
+// RankSnapshot is the model entity for the RankSnapshot schema.
+type RankSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// The ranking, which is the same as RankingBy in GraphQL
+	By ranksnapshot.By `json:"by,omitempty"`
+	// The start of the day in the time zone of the ranking
+	Date time.Time `json:"date,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank int `json:"rank,omitempty"`
+	// Score holds the value of the "score" field.
+	Score        int `json:"score,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RankSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ranksnapshot.FieldID, ranksnapshot.FieldUserID, ranksnapshot.FieldRank, ranksnapshot.FieldScore:
+			values[i] = new(sql.NullInt64)
+		case ranksnapshot.FieldBy:
+			values[i] = new(sql.NullString)
+		case ranksnapshot.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RankSnapshot fields.
+func (_m *RankSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ranksnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ranksnapshot.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case ranksnapshot.FieldBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field by", values[i])
+			} else if value.Valid {
+				_m.By = ranksnapshot.By(value.String)
+			}
+		case ranksnapshot.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case ranksnapshot.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				_m.Rank = int(value.Int64)
+			}
+		case ranksnapshot.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RankSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *RankSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RankSnapshot.
+// Note that you need to call RankSnapshot.Unwrap() before calling this method if this RankSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RankSnapshot) Update() *RankSnapshotUpdateOne {
+	return NewRankSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RankSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RankSnapshot) Unwrap() *RankSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RankSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RankSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("RankSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.By))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RankSnapshots is a parsable slice of RankSnapshot.
+type RankSnapshots []*RankSnapshot
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ranksnapshot
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ranksnapshot type in the database.
+	Label = "rank_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldBy holds the string denoting the by field in the database.
+	FieldBy = "by"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// Table holds the table name of the ranksnapshot in the database.
+	Table = "rank_snapshots"
+)
+
+// Columns holds all SQL columns for ranksnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldBy,
+	FieldDate,
+	FieldRank,
+	FieldScore,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	RankValidator func(int) error
+)
+
+// By defines the type for the "by" enum field.
+type By string
+
+// By values.
+const (
+	ByPOINTS              By = "POINTS"
+	ByCOMPLETED_QUESTIONS By = "COMPLETED_QUESTIONS"
+)
+
+func (b By) String() string {
+	return string(b)
+}
+
+// ByValidator is a validator for the "by" field enum values. It is called by the builders before save.
+func ByValidator(b By) error {
+	switch b {
+	case ByPOINTS, ByCOMPLETED_QUESTIONS:
+		return nil
+	default:
+		return fmt.Errorf("ranksnapshot: invalid enum value for by field: %q", b)
+	}
+}
+
+// OrderOption defines the ordering options for the RankSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBy orders the results by the by field.
+func ByBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBy, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e By) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *By) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = By(str)
+	if err := ByValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid By", str)
+	}
+	return nil
+}
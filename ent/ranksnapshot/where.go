@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ranksnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldDate, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldRank, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldScore, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLTE(FieldUserID, v))
+}
+
+// ByEQ applies the EQ predicate on the "by" field.
+func ByEQ(v By) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldBy, v))
+}
+
+// ByNEQ applies the NEQ predicate on the "by" field.
+func ByNEQ(v By) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldBy, v))
+}
+
+// ByIn applies the In predicate on the "by" field.
+func ByIn(vs ...By) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldBy, vs...))
+}
+
+// ByNotIn applies the NotIn predicate on the "by" field.
+func ByNotIn(vs ...By) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldBy, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLTE(FieldDate, v))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLTE(FieldRank, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.FieldLTE(FieldScore, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RankSnapshot) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RankSnapshot) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RankSnapshot) predicate.RankSnapshot {
+	return predicate.RankSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+)
+
+// RankSnapshotCreate is the builder for creating a RankSnapshot entity.
+type RankSnapshotCreate struct {
+	config
+	mutation *RankSnapshotMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RankSnapshotCreate) SetUserID(v int) *RankSnapshotCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBy sets the "by" field.
+func (_c *RankSnapshotCreate) SetBy(v ranksnapshot.By) *RankSnapshotCreate {
+	_c.mutation.SetBy(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *RankSnapshotCreate) SetDate(v time.Time) *RankSnapshotCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetRank sets the "rank" field.
+func (_c *RankSnapshotCreate) SetRank(v int) *RankSnapshotCreate {
+	_c.mutation.SetRank(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *RankSnapshotCreate) SetScore(v int) *RankSnapshotCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// Mutation returns the RankSnapshotMutation object of the builder.
+func (_c *RankSnapshotCreate) Mutation() *RankSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the RankSnapshot in the database.
+func (_c *RankSnapshotCreate) Save(ctx context.Context) (*RankSnapshot, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RankSnapshotCreate) SaveX(ctx context.Context) *RankSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RankSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RankSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RankSnapshotCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RankSnapshot.user_id"`)}
+	}
+	if _, ok := _c.mutation.By(); !ok {
+		return &ValidationError{Name: "by", err: errors.New(`ent: missing required field "RankSnapshot.by"`)}
+	}
+	if v, ok := _c.mutation.By(); ok {
+		if err := ranksnapshot.ByValidator(v); err != nil {
+			return &ValidationError{Name: "by", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "RankSnapshot.date"`)}
+	}
+	if _, ok := _c.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "RankSnapshot.rank"`)}
+	}
+	if v, ok := _c.mutation.Rank(); ok {
+		if err := ranksnapshot.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.rank": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "RankSnapshot.score"`)}
+	}
+	return nil
+}
+
+func (_c *RankSnapshotCreate) sqlSave(ctx context.Context) (*RankSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RankSnapshotCreate) createSpec() (*RankSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RankSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ranksnapshot.Table, sqlgraph.NewFieldSpec(ranksnapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(ranksnapshot.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.By(); ok {
+		_spec.SetField(ranksnapshot.FieldBy, field.TypeEnum, value)
+		_node.By = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(ranksnapshot.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Rank(); ok {
+		_spec.SetField(ranksnapshot.FieldRank, field.TypeInt, value)
+		_node.Rank = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(ranksnapshot.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	return _node, _spec
+}
+
+// RankSnapshotCreateBulk is the builder for creating many RankSnapshot entities in bulk.
+type RankSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*RankSnapshotCreate
+}
+
+// Save creates the RankSnapshot entities in the database.
+func (_c *RankSnapshotCreateBulk) Save(ctx context.Context) ([]*RankSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RankSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RankSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RankSnapshotCreateBulk) SaveX(ctx context.Context) []*RankSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RankSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RankSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+)
+
+// RankSnapshotUpdate is the builder for updating RankSnapshot entities.
+type RankSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RankSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RankSnapshotUpdate builder.
+func (_u *RankSnapshotUpdate) Where(ps ...predicate.RankSnapshot) *RankSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RankSnapshotUpdate) SetUserID(v int) *RankSnapshotUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RankSnapshotUpdate) SetNillableUserID(v *int) *RankSnapshotUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *RankSnapshotUpdate) AddUserID(v int) *RankSnapshotUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBy sets the "by" field.
+func (_u *RankSnapshotUpdate) SetBy(v ranksnapshot.By) *RankSnapshotUpdate {
+	_u.mutation.SetBy(v)
+	return _u
+}
+
+// SetNillableBy sets the "by" field if the given value is not nil.
+func (_u *RankSnapshotUpdate) SetNillableBy(v *ranksnapshot.By) *RankSnapshotUpdate {
+	if v != nil {
+		_u.SetBy(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *RankSnapshotUpdate) SetDate(v time.Time) *RankSnapshotUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *RankSnapshotUpdate) SetNillableDate(v *time.Time) *RankSnapshotUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *RankSnapshotUpdate) SetRank(v int) *RankSnapshotUpdate {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *RankSnapshotUpdate) SetNillableRank(v *int) *RankSnapshotUpdate {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *RankSnapshotUpdate) AddRank(v int) *RankSnapshotUpdate {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *RankSnapshotUpdate) SetScore(v int) *RankSnapshotUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *RankSnapshotUpdate) SetNillableScore(v *int) *RankSnapshotUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *RankSnapshotUpdate) AddScore(v int) *RankSnapshotUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the RankSnapshotMutation object of the builder.
+func (_u *RankSnapshotUpdate) Mutation() *RankSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RankSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RankSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RankSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RankSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RankSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.By(); ok {
+		if err := ranksnapshot.ByValidator(v); err != nil {
+			return &ValidationError{Name: "by", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rank(); ok {
+		if err := ranksnapshot.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.rank": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RankSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RankSnapshotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RankSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ranksnapshot.Table, ranksnapshot.Columns, sqlgraph.NewFieldSpec(ranksnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(ranksnapshot.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(ranksnapshot.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.By(); ok {
+		_spec.SetField(ranksnapshot.FieldBy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(ranksnapshot.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(ranksnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(ranksnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(ranksnapshot.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(ranksnapshot.FieldScore, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ranksnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RankSnapshotUpdateOne is the builder for updating a single RankSnapshot entity.
+type RankSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RankSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RankSnapshotUpdateOne) SetUserID(v int) *RankSnapshotUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RankSnapshotUpdateOne) SetNillableUserID(v *int) *RankSnapshotUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *RankSnapshotUpdateOne) AddUserID(v int) *RankSnapshotUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBy sets the "by" field.
+func (_u *RankSnapshotUpdateOne) SetBy(v ranksnapshot.By) *RankSnapshotUpdateOne {
+	_u.mutation.SetBy(v)
+	return _u
+}
+
+// SetNillableBy sets the "by" field if the given value is not nil.
+func (_u *RankSnapshotUpdateOne) SetNillableBy(v *ranksnapshot.By) *RankSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBy(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *RankSnapshotUpdateOne) SetDate(v time.Time) *RankSnapshotUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *RankSnapshotUpdateOne) SetNillableDate(v *time.Time) *RankSnapshotUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *RankSnapshotUpdateOne) SetRank(v int) *RankSnapshotUpdateOne {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *RankSnapshotUpdateOne) SetNillableRank(v *int) *RankSnapshotUpdateOne {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *RankSnapshotUpdateOne) AddRank(v int) *RankSnapshotUpdateOne {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *RankSnapshotUpdateOne) SetScore(v int) *RankSnapshotUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *RankSnapshotUpdateOne) SetNillableScore(v *int) *RankSnapshotUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *RankSnapshotUpdateOne) AddScore(v int) *RankSnapshotUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the RankSnapshotMutation object of the builder.
+func (_u *RankSnapshotUpdateOne) Mutation() *RankSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RankSnapshotUpdate builder.
+func (_u *RankSnapshotUpdateOne) Where(ps ...predicate.RankSnapshot) *RankSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RankSnapshotUpdateOne) Select(field string, fields ...string) *RankSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RankSnapshot entity.
+func (_u *RankSnapshotUpdateOne) Save(ctx context.Context) (*RankSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RankSnapshotUpdateOne) SaveX(ctx context.Context) *RankSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RankSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RankSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RankSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.By(); ok {
+		if err := ranksnapshot.ByValidator(v); err != nil {
+			return &ValidationError{Name: "by", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rank(); ok {
+		if err := ranksnapshot.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "RankSnapshot.rank": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RankSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RankSnapshotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RankSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *RankSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ranksnapshot.Table, ranksnapshot.Columns, sqlgraph.NewFieldSpec(ranksnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RankSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ranksnapshot.FieldID)
+		for _, f := range fields {
+			if !ranksnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ranksnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(ranksnapshot.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(ranksnapshot.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.By(); ok {
+		_spec.SetField(ranksnapshot.FieldBy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(ranksnapshot.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(ranksnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(ranksnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(ranksnapshot.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(ranksnapshot.FieldScore, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RankSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ranksnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,26 +5,59 @@ package runtime
 import (
 	"time"
 
+	"github.com/database-playground/backend-v2/ent/archivedevent"
+	"github.com/database-playground/backend-v2/ent/auditlog"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/database"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
 	"github.com/database-playground/backend-v2/ent/group"
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
 	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
 	"github.com/database-playground/backend-v2/ent/schema"
 	"github.com/database-playground/backend-v2/ent/scopeset"
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/ent/webhooksubscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	archivedeventFields := schema.ArchivedEvent{}.Fields()
+	_ = archivedeventFields
+	// archivedeventDescType is the schema descriptor for type field.
+	archivedeventDescType := archivedeventFields[2].Descriptor()
+	// archivedevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	archivedevent.TypeValidator = archivedeventDescType.Validators[0].(func(string) error)
+	// archivedeventDescArchivedAt is the schema descriptor for archived_at field.
+	archivedeventDescArchivedAt := archivedeventFields[5].Descriptor()
+	// archivedevent.DefaultArchivedAt holds the default value on creation for the archived_at field.
+	archivedevent.DefaultArchivedAt = archivedeventDescArchivedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescOperation is the schema descriptor for operation field.
+	auditlogDescOperation := auditlogFields[2].Descriptor()
+	// auditlog.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	auditlog.OperationValidator = auditlogDescOperation.Validators[0].(func(string) error)
+	// auditlogDescScope is the schema descriptor for scope field.
+	auditlogDescScope := auditlogFields[3].Descriptor()
+	// auditlog.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	auditlog.ScopeValidator = auditlogDescScope.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[8].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
 	cheatrecordFields := schema.CheatRecord{}.Fields()
 	_ = cheatrecordFields
 	// cheatrecordDescCheatedAt is the schema descriptor for cheated_at field.
-	cheatrecordDescCheatedAt := cheatrecordFields[3].Descriptor()
+	cheatrecordDescCheatedAt := cheatrecordFields[6].Descriptor()
 	// cheatrecord.DefaultCheatedAt holds the default value on creation for the cheated_at field.
 	cheatrecord.DefaultCheatedAt = cheatrecordDescCheatedAt.Default.(func() time.Time)
 	databaseFields := schema.Database{}.Fields()
@@ -51,6 +84,34 @@ func init() {
 	eventDescTriggeredAt := eventFields[2].Descriptor()
 	// event.DefaultTriggeredAt holds the default value on creation for the triggered_at field.
 	event.DefaultTriggeredAt = eventDescTriggeredAt.Default.(func() time.Time)
+	eventdailycountFields := schema.EventDailyCount{}.Fields()
+	_ = eventdailycountFields
+	// eventdailycountDescType is the schema descriptor for type field.
+	eventdailycountDescType := eventdailycountFields[1].Descriptor()
+	// eventdailycount.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	eventdailycount.TypeValidator = eventdailycountDescType.Validators[0].(func(string) error)
+	// eventdailycountDescCount is the schema descriptor for count field.
+	eventdailycountDescCount := eventdailycountFields[3].Descriptor()
+	// eventdailycount.CountValidator is a validator for the "count" field. It is called by the builders before save.
+	eventdailycount.CountValidator = eventdailycountDescCount.Validators[0].(func(int) error)
+	eventoutboxFields := schema.EventOutbox{}.Fields()
+	_ = eventoutboxFields
+	// eventoutboxDescHandler is the schema descriptor for handler field.
+	eventoutboxDescHandler := eventoutboxFields[1].Descriptor()
+	// eventoutbox.HandlerValidator is a validator for the "handler" field. It is called by the builders before save.
+	eventoutbox.HandlerValidator = eventoutboxDescHandler.Validators[0].(func(string) error)
+	// eventoutboxDescAttempts is the schema descriptor for attempts field.
+	eventoutboxDescAttempts := eventoutboxFields[3].Descriptor()
+	// eventoutbox.DefaultAttempts holds the default value on creation for the attempts field.
+	eventoutbox.DefaultAttempts = eventoutboxDescAttempts.Default.(int)
+	// eventoutboxDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	eventoutboxDescNextAttemptAt := eventoutboxFields[4].Descriptor()
+	// eventoutbox.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	eventoutbox.DefaultNextAttemptAt = eventoutboxDescNextAttemptAt.Default.(func() time.Time)
+	// eventoutboxDescCreatedAt is the schema descriptor for created_at field.
+	eventoutboxDescCreatedAt := eventoutboxFields[7].Descriptor()
+	// eventoutbox.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventoutbox.DefaultCreatedAt = eventoutboxDescCreatedAt.Default.(func() time.Time)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinHooks0 := groupMixin[0].Hooks()
 	group.Hooks[0] = groupMixinHooks0[0]
@@ -74,6 +135,30 @@ func init() {
 	groupDescName := groupFields[0].Descriptor()
 	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescJobName is the schema descriptor for job_name field.
+	jobrunDescJobName := jobrunFields[0].Descriptor()
+	// jobrun.JobNameValidator is a validator for the "job_name" field. It is called by the builders before save.
+	jobrun.JobNameValidator = jobrunDescJobName.Validators[0].(func(string) error)
+	// jobrunDescStartedAt is the schema descriptor for started_at field.
+	jobrunDescStartedAt := jobrunFields[4].Descriptor()
+	// jobrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	jobrun.DefaultStartedAt = jobrunDescStartedAt.Default.(func() time.Time)
+	persistedqueryFields := schema.PersistedQuery{}.Fields()
+	_ = persistedqueryFields
+	// persistedqueryDescHash is the schema descriptor for hash field.
+	persistedqueryDescHash := persistedqueryFields[0].Descriptor()
+	// persistedquery.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	persistedquery.HashValidator = persistedqueryDescHash.Validators[0].(func(string) error)
+	// persistedqueryDescQuery is the schema descriptor for query field.
+	persistedqueryDescQuery := persistedqueryFields[1].Descriptor()
+	// persistedquery.QueryValidator is a validator for the "query" field. It is called by the builders before save.
+	persistedquery.QueryValidator = persistedqueryDescQuery.Validators[0].(func(string) error)
+	// persistedqueryDescCreatedAt is the schema descriptor for created_at field.
+	persistedqueryDescCreatedAt := persistedqueryFields[2].Descriptor()
+	// persistedquery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	persistedquery.DefaultCreatedAt = persistedqueryDescCreatedAt.Default.(func() time.Time)
 	pointFields := schema.Point{}.Fields()
 	_ = pointFields
 	// pointDescPoints is the schema descriptor for points field.
@@ -90,6 +175,12 @@ func init() {
 	questionDescCategory := questionFields[0].Descriptor()
 	// question.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
 	question.CategoryValidator = questionDescCategory.Validators[0].(func(string) error)
+	ranksnapshotFields := schema.RankSnapshot{}.Fields()
+	_ = ranksnapshotFields
+	// ranksnapshotDescRank is the schema descriptor for rank field.
+	ranksnapshotDescRank := ranksnapshotFields[3].Descriptor()
+	// ranksnapshot.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	ranksnapshot.RankValidator = ranksnapshotDescRank.Validators[0].(func(int) error)
 	scopesetFields := schema.ScopeSet{}.Fields()
 	_ = scopesetFields
 	// scopesetDescSlug is the schema descriptor for slug field.
@@ -137,6 +228,73 @@ func init() {
 	userDescEmail := userFields[1].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPseudonym is the schema descriptor for pseudonym field.
+	userDescPseudonym := userFields[4].Descriptor()
+	// user.DefaultPseudonym holds the default value on creation for the pseudonym field.
+	user.DefaultPseudonym = userDescPseudonym.Default.(func() string)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescDeliveredAt is the schema descriptor for delivered_at field.
+	webhookdeliveryDescDeliveredAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultDeliveredAt holds the default value on creation for the delivered_at field.
+	webhookdelivery.DefaultDeliveredAt = webhookdeliveryDescDeliveredAt.Default.(func() time.Time)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscriptionMixinHooks0 := webhooksubscriptionMixin[0].Hooks()
+	webhooksubscription.Hooks[0] = webhooksubscriptionMixinHooks0[0]
+	webhooksubscriptionMixinInters0 := webhooksubscriptionMixin[0].Interceptors()
+	webhooksubscription.Interceptors[0] = webhooksubscriptionMixinInters0[0]
+	webhooksubscriptionMixinFields0 := webhooksubscriptionMixin[0].Fields()
+	_ = webhooksubscriptionMixinFields0
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionMixinFields0[0].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionMixinFields0[1].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhooksubscriptionDescName is the schema descriptor for name field.
+	webhooksubscriptionDescName := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhooksubscription.NameValidator = webhooksubscriptionDescName.Validators[0].(func(string) error)
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = func() func(string) error {
+		validators := webhooksubscriptionDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescEventTypes is the schema descriptor for event_types field.
+	webhooksubscriptionDescEventTypes := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.DefaultEventTypes holds the default value on creation for the event_types field.
+	webhooksubscription.DefaultEventTypes = webhooksubscriptionDescEventTypes.Default.([]string)
+	// webhooksubscriptionDescEnabled is the schema descriptor for enabled field.
+	webhooksubscriptionDescEnabled := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooksubscription.DefaultEnabled = webhooksubscriptionDescEnabled.Default.(bool)
 }
 
 const (
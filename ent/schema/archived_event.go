@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArchivedEvent is an event moved out of the events table by the retention policy.
+//
+// The ID is the ID of the original event.
+type ArchivedEvent struct {
+	ent.Schema
+}
+
+func (ArchivedEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Immutable().
+			Comment("The ID of the original event"),
+		field.Int("user_id"),
+		field.String("type").
+			NotEmpty(),
+		field.Time("triggered_at"),
+		field.JSON("payload", map[string]any{}).
+			Optional(),
+		field.Time("archived_at").
+			Default(time.Now),
+	}
+}
+
+func (ArchivedEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type"),
+		index.Fields("triggered_at"),
+	}
+}
+
+func (ArchivedEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/database-playground/backend-v2/models"
+)
+
+// AuditLog records a privileged mutation: who ran it on which target,
+// and what was changed.
+//
+// The actor and the target are kept as IDs instead of edges, so that the
+// logs are kept after the users or the targets are deleted.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("actor_id").
+			Immutable().
+			Comment("The user who ran the mutation").
+			Annotations(entgql.Type("ID")),
+		field.Int("impersonator_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The user who impersonated the actor").
+			Annotations(entgql.Type("ID")),
+		field.String("operation").
+			NotEmpty().
+			Immutable().
+			Comment("The mutation field, e.g. updateUser"),
+		field.String("scope").
+			NotEmpty().
+			Immutable().
+			Comment("The scope guarding the mutation"),
+		field.String("target_type").
+			Optional().
+			Immutable().
+			Comment("The type of the target, e.g. User"),
+		field.Int("target_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(entgql.Type("ID")),
+		field.JSON("changes", []models.AuditLogChange{}).
+			Optional().
+			Immutable().
+			Comment("The fields of the target changed by the mutation"),
+		field.String("trace_id").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_id"),
+		index.Fields("target_type", "target_id"),
+	}
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField().Directives(
+			ScopeDirective("audit_log:read"),
+		),
+		entgql.RelayConnection(),
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/database-playground/backend-v2/models"
 )
 
 type CheatRecord struct {
@@ -16,10 +18,35 @@ type CheatRecord struct {
 
 func (CheatRecord) Fields() []ent.Field {
 	return []ent.Field{
+		field.Enum("kind").NamedValues(
+			"Manual", "manual",
+			"TabSwitch", "tab_switch",
+			"Plagiarism", "plagiarism",
+			"ExamViolation", "exam_violation",
+		).
+			Default("manual").
+			Comment("How the cheating is detected"),
+		field.Enum("state").NamedValues(
+			"Pending", "pending",
+			"Open", "open",
+			"UnderReview", "under_review",
+			"Confirmed", "confirmed",
+			"Dismissed", "dismissed",
+		).
+			Default("open").
+			Comment("The review state: open → under_review → confirmed / dismissed, or pending → confirmed / dismissed for the detected records"),
 		field.String("reason"),
 		field.String("resolved_reason").Optional(),
-		field.Time("resolved_at").Optional(),
+		field.Time("reviewed_at").Optional().Comment("When the record is moved to under_review"),
+		field.Time("resolved_at").Optional().Comment("When the record is confirmed or dismissed"),
 		field.Time("cheated_at").Default(time.Now),
+		field.JSON("evidence", &models.CheatEvidence{}).Optional(),
+		// The key of the detected incident, to avoid recording it twice.
+		field.String("detection_key").
+			Optional().
+			Nillable().
+			Unique().
+			Annotations(entgql.Skip(entgql.SkipAll)),
 	}
 }
 
@@ -29,6 +56,15 @@ func (CheatRecord) Edges() []ent.Edge {
 			Ref("cheat_records").
 			Unique().
 			Required(),
+		// The user who reported the record. Empty if it is reported by a detector.
+		edge.To("reporter", User.Type).
+			Unique(),
+	}
+}
+
+func (CheatRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state", "kind"),
 	}
 }
 
@@ -25,13 +25,17 @@ func (Event) Fields() []ent.Field {
 			Default(time.Now).
 			Annotations(entgql.OrderField("TRIGGERED_AT")),
 		field.JSON("payload", map[string]any{}).
-			Optional(),
+			Optional().
+			Comment("The payload encoded from the typed payload of the event type").
+			Annotations(entgql.Skip(entgql.SkipType)),
 	}
 }
 
 func (Event) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).Ref("events").Field("user_id").Unique().Required(),
+		edge.To("outbox", EventOutbox.Type).
+			Annotations(entgql.Skip(entgql.SkipAll)),
 	}
 }
 
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventDailyCount is the number of the archived events of a type
+// triggered by a user in a day (in UTC).
+//
+// It keeps the aggregates of the events removed by the retention policy.
+type EventDailyCount struct {
+	ent.Schema
+}
+
+func (EventDailyCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("type").
+			NotEmpty(),
+		field.Time("date").
+			Comment("The start of the day in UTC"),
+		field.Int("count").
+			NonNegative(),
+	}
+}
+
+func (EventDailyCount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type", "date").Unique(),
+		index.Fields("type"),
+	}
+}
+
+func (EventDailyCount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
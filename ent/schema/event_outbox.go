@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventOutbox is the outbox entry of an event for a specific handler.
+//
+// Every triggered event creates one entry per registered handler.
+// The entries are dispatched by the background workers, which
+// retry the failed ones with backoff until they are dead-lettered.
+type EventOutbox struct {
+	ent.Schema
+}
+
+func (EventOutbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("event_id"),
+		field.String("handler").
+			NotEmpty().
+			Comment("The name of the handler to dispatch this event to"),
+		field.Enum("status").
+			Values("pending", "processing", "succeeded", "dead").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of dispatch attempts made"),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("The earliest time the entry can be dispatched"),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("The lease of the worker processing this entry"),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (EventOutbox) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("outbox").
+			Field("event_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (EventOutbox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("event_id", "handler").Unique(),
+	}
+}
+
+func (EventOutbox) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
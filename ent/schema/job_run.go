@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobRun records a run of a scheduled job.
+type JobRun struct {
+	ent.Schema
+}
+
+func (JobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("job_name").
+			NotEmpty(),
+		field.Enum("status").
+			Values("running", "succeeded", "failed"),
+		field.String("instance").
+			Comment("The hostname of the replica running the job"),
+		field.Time("scheduled_at").
+			Comment("The time the run was scheduled at"),
+		field.Time("started_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("STARTED_AT")),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Int("duration_ms").
+			Optional().
+			Nillable().
+			Comment("The duration of the run in milliseconds"),
+		field.String("error").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (JobRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_name", "started_at"),
+	}
+}
+
+func (JobRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField().Directives(
+			ScopeDirective("job:read"),
+		),
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// PersistedQuery is an operation in the persisted query allowlist.
+//
+// The operations are published from the manifest of the frontend build,
+// and the strict mode of the GraphQL server only runs these operations
+// for the non-administrators.
+type PersistedQuery struct {
+	ent.Schema
+}
+
+func (PersistedQuery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").
+			Unique().
+			Immutable().
+			NotEmpty().
+			Comment("The SHA-256 hash of the query in hex"),
+		field.Text("query").
+			Immutable().
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (PersistedQuery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -24,6 +24,13 @@ func (Point) Fields() []ent.Field {
 			Annotations(entgql.OrderField("GRANTED_AT")),
 		field.String("description").
 			Optional(),
+		// The key of the automatic grant, to avoid granting it twice.
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			Annotations(entgql.Skip(entgql.SkipAll)),
 	}
 }
 
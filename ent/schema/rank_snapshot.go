@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RankSnapshot is the all-time rank and score of a user at the end of a day,
+// which makes up the rank history of the user.
+type RankSnapshot struct {
+	ent.Schema
+}
+
+func (RankSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("by").
+			Values("POINTS", "COMPLETED_QUESTIONS").
+			Comment("The ranking, which is the same as RankingBy in GraphQL"),
+		field.Time("date").
+			Comment("The start of the day in the time zone of the ranking"),
+		field.Int("rank").
+			Positive(),
+		field.Int("score"),
+	}
+}
+
+func (RankSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "by", "date").Unique(),
+		index.Fields("by", "date"),
+	}
+}
+
+func (RankSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
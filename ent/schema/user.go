@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/database-playground/backend-v2/internal/pseudonym"
 )
 
 // User is the schema for the user resource.
@@ -24,6 +25,18 @@ func (User) Fields() []ent.Field {
 			Annotations(entgql.OrderField("EMAIL")),
 		field.String("avatar").
 			Optional(),
+		field.Enum("ranking_visibility").NamedValues(
+			"RealName", "real_name",
+			"Pseudonym", "pseudonym",
+			"Hidden", "hidden",
+		).
+			Default("pseudonym").
+			Comment("How the user is shown in the rankings: the real name, the pseudonym, or hidden from the rankings"),
+		field.String("pseudonym").
+			Optional().
+			DefaultFunc(pseudonym.Generate).
+			Comment("The name shown in the rankings instead of the real name. Not exposed, so it cannot be linked to the user").
+			Annotations(entgql.Skip(entgql.SkipAll)),
 	}
 }
 
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery logs an attempt to deliver an event to a webhook subscription.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("event_id").
+			Comment("The ID of the delivered event"),
+		field.String("event_type").
+			NotEmpty(),
+		field.Int("attempt").
+			Comment("The attempt number of this event to this subscription, starting from 1"),
+		field.Enum("status").
+			Values("succeeded", "failed"),
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Comment("The HTTP status code responded by the receiver"),
+		field.String("error").
+			Optional().
+			Nillable(),
+		field.Int("duration_ms").
+			Comment("The duration of the request in milliseconds"),
+		field.Time("delivered_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("DELIVERED_AT")),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Unique().
+			Required(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id"),
+	}
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"fmt"
+	"net/url"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookSubscription is an outgoing webhook subscribed to events.
+//
+// The matched events are POSTed to the URL with an HMAC signature
+// computed from the secret.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("url").
+			NotEmpty().
+			Validate(validateWebhookURL),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("The secret to sign the payloads with HMAC-SHA256").
+			Annotations(entgql.Skip(entgql.SkipType | entgql.SkipWhereInput | entgql.SkipOrderField)),
+		field.JSON("event_types", []string{}).
+			Default([]string{}).
+			Comment("The event types to deliver. Empty means every event type.").
+			Annotations(entgql.Type("[String!]")),
+		field.Bool("enabled").
+			Default(true),
+		field.String("description").
+			Optional(),
+	}
+}
+
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimestampMixin{},
+	}
+}
+
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
+
+func (WebhookSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField().Directives(
+			ScopeDirective("webhook:read"),
+		),
+		entgql.Mutations(
+			entgql.MutationCreate(),
+			entgql.MutationUpdate(),
+		),
+		entgql.RelayConnection(),
+	}
+}
+
+// validateWebhookURL accepts only the absolute HTTP(S) URLs.
+func validateWebhookURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid webhook url: scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid webhook url: host is required")
+	}
+
+	return nil
+}
@@ -25,8 +25,8 @@ type ScopeSetQuery struct {
 	inters          []Interceptor
 	predicates      []predicate.ScopeSet
 	withGroups      *GroupQuery
-	modifiers       []func(*sql.Selector)
 	loadTotal       []func(context.Context, []*ScopeSet) error
+	modifiers       []func(*sql.Selector)
 	withNamedGroups map[string]*GroupQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -280,8 +280,9 @@ func (_q *ScopeSetQuery) Clone() *ScopeSetQuery {
 		predicates: append([]predicate.ScopeSet{}, _q.predicates...),
 		withGroups: _q.withGroups.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -550,6 +551,9 @@ func (_q *ScopeSetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -567,6 +571,12 @@ func (_q *ScopeSetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ScopeSetQuery) Modify(modifiers ...func(s *sql.Selector)) *ScopeSetSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *ScopeSetQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *ScopeSetQuery {
@@ -670,3 +680,9 @@ func (_s *ScopeSetSelect) sqlScan(ctx context.Context, root *ScopeSetQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ScopeSetSelect) Modify(modifiers ...func(s *sql.Selector)) *ScopeSetSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
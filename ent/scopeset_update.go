@@ -19,8 +19,9 @@ import (
 // ScopeSetUpdate is the builder for updating ScopeSet entities.
 type ScopeSetUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ScopeSetMutation
+	hooks     []Hook
+	mutation  *ScopeSetMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ScopeSetUpdate builder.
@@ -129,6 +130,12 @@ func (_u *ScopeSetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScopeSetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScopeSetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ScopeSetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(scopeset.Table, scopeset.Columns, sqlgraph.NewFieldSpec(scopeset.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -197,6 +204,7 @@ func (_u *ScopeSetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{scopeset.Label}
@@ -212,9 +220,10 @@ func (_u *ScopeSetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ScopeSetUpdateOne is the builder for updating a single ScopeSet entity.
 type ScopeSetUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ScopeSetMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ScopeSetMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetDescription sets the "description" field.
@@ -330,6 +339,12 @@ func (_u *ScopeSetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScopeSetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScopeSetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ScopeSetUpdateOne) sqlSave(ctx context.Context) (_node *ScopeSet, err error) {
 	_spec := sqlgraph.NewUpdateSpec(scopeset.Table, scopeset.Columns, sqlgraph.NewFieldSpec(scopeset.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -415,6 +430,7 @@ func (_u *ScopeSetUpdateOne) sqlSave(ctx context.Context) (_node *ScopeSet, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ScopeSet{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
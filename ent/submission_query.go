@@ -27,8 +27,8 @@ type SubmissionQuery struct {
 	withQuestion *QuestionQuery
 	withUser     *UserQuery
 	withFKs      bool
-	modifiers    []func(*sql.Selector)
 	loadTotal    []func(context.Context, []*Submission) error
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -304,8 +304,9 @@ func (_q *SubmissionQuery) Clone() *SubmissionQuery {
 		withQuestion: _q.withQuestion.Clone(),
 		withUser:     _q.withUser.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -594,6 +595,9 @@ func (_q *SubmissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -611,6 +615,12 @@ func (_q *SubmissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SubmissionQuery) Modify(modifiers ...func(s *sql.Selector)) *SubmissionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SubmissionGroupBy is the group-by builder for Submission entities.
 type SubmissionGroupBy struct {
 	selector
@@ -700,3 +710,9 @@ func (_s *SubmissionSelect) sqlScan(ctx context.Context, root *SubmissionQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SubmissionSelect) Modify(modifiers ...func(s *sql.Selector)) *SubmissionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -12,24 +12,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ArchivedEvent is the client for interacting with the ArchivedEvent builders.
+	ArchivedEvent *ArchivedEventClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// CheatRecord is the client for interacting with the CheatRecord builders.
 	CheatRecord *CheatRecordClient
 	// Database is the client for interacting with the Database builders.
 	Database *DatabaseClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// EventDailyCount is the client for interacting with the EventDailyCount builders.
+	EventDailyCount *EventDailyCountClient
+	// EventOutbox is the client for interacting with the EventOutbox builders.
+	EventOutbox *EventOutboxClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// PersistedQuery is the client for interacting with the PersistedQuery builders.
+	PersistedQuery *PersistedQueryClient
 	// Point is the client for interacting with the Point builders.
 	Point *PointClient
 	// Question is the client for interacting with the Question builders.
 	Question *QuestionClient
+	// RankSnapshot is the client for interacting with the RankSnapshot builders.
+	RankSnapshot *RankSnapshotClient
 	// ScopeSet is the client for interacting with the ScopeSet builders.
 	ScopeSet *ScopeSetClient
 	// Submission is the client for interacting with the Submission builders.
 	Submission *SubmissionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ArchivedEvent = NewArchivedEventClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.CheatRecord = NewCheatRecordClient(tx.config)
 	tx.Database = NewDatabaseClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
+	tx.EventDailyCount = NewEventDailyCountClient(tx.config)
+	tx.EventOutbox = NewEventOutboxClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.PersistedQuery = NewPersistedQueryClient(tx.config)
 	tx.Point = NewPointClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
+	tx.RankSnapshot = NewRankSnapshotClient(tx.config)
 	tx.ScopeSet = NewScopeSetClient(tx.config)
 	tx.Submission = NewSubmissionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: CheatRecord.QueryXXX(), the query will be executed
+// applies a query, for example: ArchivedEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -30,6 +30,10 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Avatar holds the value of the "avatar" field.
 	Avatar string `json:"avatar,omitempty"`
+	// How the user is shown in the rankings: the real name, the pseudonym, or hidden from the rankings
+	RankingVisibility user.RankingVisibility `json:"ranking_visibility,omitempty"`
+	// The name shown in the rankings instead of the real name. Not exposed, so it cannot be linked to the user
+	Pseudonym string `json:"pseudonym,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -115,7 +119,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldEmail, user.FieldAvatar:
+		case user.FieldName, user.FieldEmail, user.FieldAvatar, user.FieldRankingVisibility, user.FieldPseudonym:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -178,6 +182,18 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Avatar = value.String
 			}
+		case user.FieldRankingVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ranking_visibility", values[i])
+			} else if value.Valid {
+				_m.RankingVisibility = user.RankingVisibility(value.String)
+			}
+		case user.FieldPseudonym:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pseudonym", values[i])
+			} else if value.Valid {
+				_m.Pseudonym = value.String
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_group", value)
@@ -263,6 +279,12 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("avatar=")
 	builder.WriteString(_m.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("ranking_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RankingVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("pseudonym=")
+	builder.WriteString(_m.Pseudonym)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,9 @@
 package user
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
@@ -27,6 +30,10 @@ const (
 	FieldEmail = "email"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
+	// FieldRankingVisibility holds the string denoting the ranking_visibility field in the database.
+	FieldRankingVisibility = "ranking_visibility"
+	// FieldPseudonym holds the string denoting the pseudonym field in the database.
+	FieldPseudonym = "pseudonym"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgePoints holds the string denoting the points edge name in mutations.
@@ -85,6 +92,8 @@ var Columns = []string{
 	FieldName,
 	FieldEmail,
 	FieldAvatar,
+	FieldRankingVisibility,
+	FieldPseudonym,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
@@ -126,8 +135,37 @@ var (
 	NameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// DefaultPseudonym holds the default value on creation for the "pseudonym" field.
+	DefaultPseudonym func() string
 )
 
+// RankingVisibility defines the type for the "ranking_visibility" enum field.
+type RankingVisibility string
+
+// RankingVisibilityPseudonym is the default value of the RankingVisibility enum.
+const DefaultRankingVisibility = RankingVisibilityPseudonym
+
+// RankingVisibility values.
+const (
+	RankingVisibilityRealName  RankingVisibility = "real_name"
+	RankingVisibilityPseudonym RankingVisibility = "pseudonym"
+	RankingVisibilityHidden    RankingVisibility = "hidden"
+)
+
+func (rv RankingVisibility) String() string {
+	return string(rv)
+}
+
+// RankingVisibilityValidator is a validator for the "ranking_visibility" field enum values. It is called by the builders before save.
+func RankingVisibilityValidator(rv RankingVisibility) error {
+	switch rv {
+	case RankingVisibilityRealName, RankingVisibilityPseudonym, RankingVisibilityHidden:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for ranking_visibility field: %q", rv)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -166,6 +204,16 @@ func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
 }
 
+// ByRankingVisibility orders the results by the ranking_visibility field.
+func ByRankingVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRankingVisibility, opts...).ToFunc()
+}
+
+// ByPseudonym orders the results by the pseudonym field.
+func ByPseudonym(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPseudonym, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -263,3 +311,21 @@ func newCheatRecordsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CheatRecordsTable, CheatRecordsColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e RankingVisibility) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *RankingVisibility) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = RankingVisibility(str)
+	if err := RankingVisibilityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid RankingVisibility", str)
+	}
+	return nil
+}
@@ -85,6 +85,11 @@ func Avatar(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatar, v))
 }
 
+// Pseudonym applies equality check predicate on the "pseudonym" field. It's identical to PseudonymEQ.
+func Pseudonym(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPseudonym, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -420,6 +425,101 @@ func AvatarContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAvatar, v))
 }
 
+// RankingVisibilityEQ applies the EQ predicate on the "ranking_visibility" field.
+func RankingVisibilityEQ(v RankingVisibility) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRankingVisibility, v))
+}
+
+// RankingVisibilityNEQ applies the NEQ predicate on the "ranking_visibility" field.
+func RankingVisibilityNEQ(v RankingVisibility) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRankingVisibility, v))
+}
+
+// RankingVisibilityIn applies the In predicate on the "ranking_visibility" field.
+func RankingVisibilityIn(vs ...RankingVisibility) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRankingVisibility, vs...))
+}
+
+// RankingVisibilityNotIn applies the NotIn predicate on the "ranking_visibility" field.
+func RankingVisibilityNotIn(vs ...RankingVisibility) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRankingVisibility, vs...))
+}
+
+// PseudonymEQ applies the EQ predicate on the "pseudonym" field.
+func PseudonymEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPseudonym, v))
+}
+
+// PseudonymNEQ applies the NEQ predicate on the "pseudonym" field.
+func PseudonymNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPseudonym, v))
+}
+
+// PseudonymIn applies the In predicate on the "pseudonym" field.
+func PseudonymIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPseudonym, vs...))
+}
+
+// PseudonymNotIn applies the NotIn predicate on the "pseudonym" field.
+func PseudonymNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPseudonym, vs...))
+}
+
+// PseudonymGT applies the GT predicate on the "pseudonym" field.
+func PseudonymGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPseudonym, v))
+}
+
+// PseudonymGTE applies the GTE predicate on the "pseudonym" field.
+func PseudonymGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPseudonym, v))
+}
+
+// PseudonymLT applies the LT predicate on the "pseudonym" field.
+func PseudonymLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPseudonym, v))
+}
+
+// PseudonymLTE applies the LTE predicate on the "pseudonym" field.
+func PseudonymLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPseudonym, v))
+}
+
+// PseudonymContains applies the Contains predicate on the "pseudonym" field.
+func PseudonymContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPseudonym, v))
+}
+
+// PseudonymHasPrefix applies the HasPrefix predicate on the "pseudonym" field.
+func PseudonymHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPseudonym, v))
+}
+
+// PseudonymHasSuffix applies the HasSuffix predicate on the "pseudonym" field.
+func PseudonymHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPseudonym, v))
+}
+
+// PseudonymIsNil applies the IsNil predicate on the "pseudonym" field.
+func PseudonymIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPseudonym))
+}
+
+// PseudonymNotNil applies the NotNil predicate on the "pseudonym" field.
+func PseudonymNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPseudonym))
+}
+
+// PseudonymEqualFold applies the EqualFold predicate on the "pseudonym" field.
+func PseudonymEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPseudonym, v))
+}
+
+// PseudonymContainsFold applies the ContainsFold predicate on the "pseudonym" field.
+func PseudonymContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPseudonym, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
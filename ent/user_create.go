@@ -93,6 +93,34 @@ func (_c *UserCreate) SetNillableAvatar(v *string) *UserCreate {
 	return _c
 }
 
+// SetRankingVisibility sets the "ranking_visibility" field.
+func (_c *UserCreate) SetRankingVisibility(v user.RankingVisibility) *UserCreate {
+	_c.mutation.SetRankingVisibility(v)
+	return _c
+}
+
+// SetNillableRankingVisibility sets the "ranking_visibility" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRankingVisibility(v *user.RankingVisibility) *UserCreate {
+	if v != nil {
+		_c.SetRankingVisibility(*v)
+	}
+	return _c
+}
+
+// SetPseudonym sets the "pseudonym" field.
+func (_c *UserCreate) SetPseudonym(v string) *UserCreate {
+	_c.mutation.SetPseudonym(v)
+	return _c
+}
+
+// SetNillablePseudonym sets the "pseudonym" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePseudonym(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPseudonym(*v)
+	}
+	return _c
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_c *UserCreate) SetGroupID(id int) *UserCreate {
 	_c.mutation.SetGroupID(id)
@@ -215,6 +243,17 @@ func (_c *UserCreate) defaults() error {
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.RankingVisibility(); !ok {
+		v := user.DefaultRankingVisibility
+		_c.mutation.SetRankingVisibility(v)
+	}
+	if _, ok := _c.mutation.Pseudonym(); !ok {
+		if user.DefaultPseudonym == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultPseudonym (forgotten import ent/runtime?)")
+		}
+		v := user.DefaultPseudonym()
+		_c.mutation.SetPseudonym(v)
+	}
 	return nil
 }
 
@@ -242,6 +281,14 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.RankingVisibility(); !ok {
+		return &ValidationError{Name: "ranking_visibility", err: errors.New(`ent: missing required field "User.ranking_visibility"`)}
+	}
+	if v, ok := _c.mutation.RankingVisibility(); ok {
+		if err := user.RankingVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "ranking_visibility", err: fmt.Errorf(`ent: validator failed for field "User.ranking_visibility": %w`, err)}
+		}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "User.group"`)}
 	}
@@ -295,6 +342,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 		_node.Avatar = value
 	}
+	if value, ok := _c.mutation.RankingVisibility(); ok {
+		_spec.SetField(user.FieldRankingVisibility, field.TypeEnum, value)
+		_node.RankingVisibility = value
+	}
+	if value, ok := _c.mutation.Pseudonym(); ok {
+		_spec.SetField(user.FieldPseudonym, field.TypeString, value)
+		_node.Pseudonym = value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
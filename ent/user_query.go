@@ -34,8 +34,8 @@ type UserQuery struct {
 	withSubmissions       *SubmissionQuery
 	withCheatRecords      *CheatRecordQuery
 	withFKs               bool
-	modifiers             []func(*sql.Selector)
 	loadTotal             []func(context.Context, []*User) error
+	modifiers             []func(*sql.Selector)
 	withNamedPoints       map[string]*PointQuery
 	withNamedEvents       map[string]*EventQuery
 	withNamedSubmissions  map[string]*SubmissionQuery
@@ -384,8 +384,9 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withSubmissions:  _q.withSubmissions.Clone(),
 		withCheatRecords: _q.withCheatRecords.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -851,6 +852,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -868,6 +872,12 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WithNamedPoints tells the query-builder to eager-load the nodes that are connected to the "points"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithNamedPoints(name string, opts ...func(*PointQuery)) *UserQuery {
@@ -1013,3 +1023,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -23,8 +23,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -107,6 +108,40 @@ func (_u *UserUpdate) ClearAvatar() *UserUpdate {
 	return _u
 }
 
+// SetRankingVisibility sets the "ranking_visibility" field.
+func (_u *UserUpdate) SetRankingVisibility(v user.RankingVisibility) *UserUpdate {
+	_u.mutation.SetRankingVisibility(v)
+	return _u
+}
+
+// SetNillableRankingVisibility sets the "ranking_visibility" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRankingVisibility(v *user.RankingVisibility) *UserUpdate {
+	if v != nil {
+		_u.SetRankingVisibility(*v)
+	}
+	return _u
+}
+
+// SetPseudonym sets the "pseudonym" field.
+func (_u *UserUpdate) SetPseudonym(v string) *UserUpdate {
+	_u.mutation.SetPseudonym(v)
+	return _u
+}
+
+// SetNillablePseudonym sets the "pseudonym" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePseudonym(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPseudonym(*v)
+	}
+	return _u
+}
+
+// ClearPseudonym clears the value of the "pseudonym" field.
+func (_u *UserUpdate) ClearPseudonym() *UserUpdate {
+	_u.mutation.ClearPseudonym()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *UserUpdate) SetGroupID(id int) *UserUpdate {
 	_u.mutation.SetGroupID(id)
@@ -322,12 +357,23 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RankingVisibility(); ok {
+		if err := user.RankingVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "ranking_visibility", err: fmt.Errorf(`ent: validator failed for field "User.ranking_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "User.group"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -361,6 +407,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.RankingVisibility(); ok {
+		_spec.SetField(user.FieldRankingVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pseudonym(); ok {
+		_spec.SetField(user.FieldPseudonym, field.TypeString, value)
+	}
+	if _u.mutation.PseudonymCleared() {
+		_spec.ClearField(user.FieldPseudonym, field.TypeString)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -570,6 +625,7 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -585,9 +641,10 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -664,6 +721,40 @@ func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
 	return _u
 }
 
+// SetRankingVisibility sets the "ranking_visibility" field.
+func (_u *UserUpdateOne) SetRankingVisibility(v user.RankingVisibility) *UserUpdateOne {
+	_u.mutation.SetRankingVisibility(v)
+	return _u
+}
+
+// SetNillableRankingVisibility sets the "ranking_visibility" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRankingVisibility(v *user.RankingVisibility) *UserUpdateOne {
+	if v != nil {
+		_u.SetRankingVisibility(*v)
+	}
+	return _u
+}
+
+// SetPseudonym sets the "pseudonym" field.
+func (_u *UserUpdateOne) SetPseudonym(v string) *UserUpdateOne {
+	_u.mutation.SetPseudonym(v)
+	return _u
+}
+
+// SetNillablePseudonym sets the "pseudonym" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePseudonym(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPseudonym(*v)
+	}
+	return _u
+}
+
+// ClearPseudonym clears the value of the "pseudonym" field.
+func (_u *UserUpdateOne) ClearPseudonym() *UserUpdateOne {
+	_u.mutation.ClearPseudonym()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *UserUpdateOne) SetGroupID(id int) *UserUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -892,12 +983,23 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RankingVisibility(); ok {
+		if err := user.RankingVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "ranking_visibility", err: fmt.Errorf(`ent: validator failed for field "User.ranking_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "User.group"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -948,6 +1050,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.RankingVisibility(); ok {
+		_spec.SetField(user.FieldRankingVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pseudonym(); ok {
+		_spec.SetField(user.FieldPseudonym, field.TypeString, value)
+	}
+	if _u.mutation.PseudonymCleared() {
+		_spec.ClearField(user.FieldPseudonym, field.TypeString)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1157,6 +1268,7 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
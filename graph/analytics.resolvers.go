@@ -0,0 +1,93 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/insights"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// GroupQuestionStatistics is the resolver for the groupQuestionStatistics field.
+func (r *analyticsResolver) GroupQuestionStatistics(ctx context.Context, obj *insights.Analytics, groupID int, category *string) ([]*insights.GroupQuestionStatistics, error) {
+	ctx, span := tracer.Start(ctx, "GroupQuestionStatistics")
+	defer span.End()
+
+	statistics, err := r.insightsService.GroupQuestionStatistics(ctx, groupID, category)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get group question statistics")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Group question statistics retrieved successfully")
+	return statistics, nil
+}
+
+// InactiveStudents is the resolver for the inactiveStudents field.
+func (r *analyticsResolver) InactiveStudents(ctx context.Context, obj *insights.Analytics, groupID int, days int) ([]*insights.InactiveStudent, error) {
+	ctx, span := tracer.Start(ctx, "InactiveStudents")
+	defer span.End()
+
+	if days <= 0 {
+		span.SetStatus(otelcodes.Error, "Invalid days")
+		return nil, defs.NewErrInvalidInput("days must be positive")
+	}
+
+	inactive, err := r.insightsService.InactiveStudents(ctx, groupID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get inactive students")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Inactive students retrieved successfully")
+	return inactive, nil
+}
+
+// ActivityHeatmap is the resolver for the activityHeatmap field.
+func (r *analyticsResolver) ActivityHeatmap(ctx context.Context, obj *insights.Analytics, groupID int, from time.Time, to time.Time, timezone *string) ([]*insights.ActivityDay, error) {
+	ctx, span := tracer.Start(ctx, "ActivityHeatmap")
+	defer span.End()
+
+	location := time.UTC
+	if timezone != nil {
+		loc, err := time.LoadLocation(*timezone)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Invalid timezone")
+			return nil, defs.NewErrInvalidInput("invalid timezone: " + *timezone)
+		}
+		location = loc
+	}
+
+	days, err := r.insightsService.ActivityHeatmap(ctx, groupID, from, to, location)
+	if errors.Is(err, insights.ErrInvalidRange) || errors.Is(err, insights.ErrInvalidArgument) {
+		span.SetStatus(otelcodes.Error, "Invalid argument")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get activity heatmap")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Activity heatmap retrieved successfully")
+	return days, nil
+}
+
+// Analytics is the resolver for the analytics field.
+func (r *queryResolver) Analytics(ctx context.Context) (*insights.Analytics, error) {
+	return &insights.Analytics{}, nil
+}
+
+// Analytics returns AnalyticsResolver implementation.
+func (r *Resolver) Analytics() AnalyticsResolver { return &analyticsResolver{r} }
+
+type analyticsResolver struct{ *Resolver }
@@ -0,0 +1,88 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"errors"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/internal/useraccount"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// Actor is the resolver for the actor field.
+func (r *auditLogResolver) Actor(ctx context.Context, obj *ent.AuditLog) (*ent.User, error) {
+	ctx, span := tracer.Start(ctx, "Actor")
+	defer span.End()
+
+	user, err := r.useraccount.GetUser(ctx, obj.ActorID)
+	if err != nil {
+		if errors.Is(err, useraccount.ErrUserNotFound) {
+			span.SetStatus(otelcodes.Ok, "Actor deleted")
+			return nil, nil
+		}
+
+		span.SetStatus(otelcodes.Error, "Failed to get actor")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Actor retrieved successfully")
+	return user, nil
+}
+
+// Impersonator is the resolver for the impersonator field.
+func (r *auditLogResolver) Impersonator(ctx context.Context, obj *ent.AuditLog) (*ent.User, error) {
+	ctx, span := tracer.Start(ctx, "Impersonator")
+	defer span.End()
+
+	if obj.ImpersonatorID == nil {
+		span.SetStatus(otelcodes.Ok, "No impersonation found")
+		return nil, nil
+	}
+
+	user, err := r.useraccount.GetUser(ctx, *obj.ImpersonatorID)
+	if err != nil {
+		if errors.Is(err, useraccount.ErrUserNotFound) {
+			span.SetStatus(otelcodes.Ok, "Impersonator deleted")
+			return nil, nil
+		}
+
+		span.SetStatus(otelcodes.Error, "Failed to get impersonator")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Impersonator retrieved successfully")
+	return user, nil
+}
+
+// Target is the resolver for the target field.
+func (r *auditLogResolver) Target(ctx context.Context, obj *ent.AuditLog) (ent.Noder, error) {
+	ctx, span := tracer.Start(ctx, "Target")
+	defer span.End()
+
+	if obj.TargetID == nil {
+		span.SetStatus(otelcodes.Ok, "No target found")
+		return nil, nil
+	}
+
+	target, err := r.EntClient(ctx).Noder(ctx, *obj.TargetID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			span.SetStatus(otelcodes.Ok, "Target deleted")
+			return nil, nil
+		}
+
+		span.SetStatus(otelcodes.Error, "Failed to get target")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Target retrieved successfully")
+	return target, nil
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/auditlog"
+	"github.com/database-playground/backend-v2/graph/directive"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/graphql/audit"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/database-playground/backend-v2/internal/useraccount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newAuditTestClient creates a client of the server writing the audit logs
+// in the transaction of the mutations, like the production server.
+func newAuditTestClient(t *testing.T, entClient *ent.Client) *client.Client {
+	t.Helper()
+
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: entClient})
+	srv.Use(audit.New(entClient))
+
+	return client.New(srv)
+}
+
+func asUser(info auth.TokenInfo) client.Option {
+	return func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), info))
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	c := newAuditTestClient(t, entClient)
+	ctx := context.Background()
+
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+	admin, err := entClient.User.Create().
+		SetName("admin").
+		SetEmail("admin@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+	target, err := entClient.User.Create().
+		SetName("target").
+		SetEmail("target@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+
+	const updateUser = `mutation($id: ID!) { updateUser(id: $id, input: { name: "renamed" }) { id } }`
+
+	t.Run("update", func(t *testing.T) {
+		var resp map[string]any
+		err := c.Post(updateUser, &resp,
+			client.Var("id", target.ID),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"user:write"}}))
+		require.NoError(t, err)
+
+		log, err := entClient.AuditLog.Query().
+			Where(auditlog.OperationEQ("updateUser")).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, admin.ID, log.ActorID)
+		assert.Nil(t, log.ImpersonatorID)
+		assert.Equal(t, "user:write", log.Scope)
+		assert.Equal(t, "User", log.TargetType)
+		require.NotNil(t, log.TargetID)
+		assert.Equal(t, target.ID, *log.TargetID)
+
+		changes := make(map[string][2]string)
+		for _, change := range log.Changes {
+			require.NotNil(t, change.Before)
+			require.NotNil(t, change.After)
+			changes[change.Field] = [2]string{*change.Before, *change.After}
+		}
+		assert.Equal(t, [2]string{`"target"`, `"renamed"`}, changes["name"])
+		assert.Contains(t, changes, "updated_at")
+		assert.NotContains(t, changes, "email")
+	})
+
+	t.Run("impersonation", func(t *testing.T) {
+		var resp map[string]any
+		err := c.Post(updateUser, &resp,
+			client.Var("id", target.ID),
+			asUser(auth.TokenInfo{
+				UserID: target.ID,
+				Scopes: []string{"user:write"},
+				Meta:   map[string]string{useraccount.MetaImpersonation: strconv.Itoa(admin.ID)},
+			}))
+		require.NoError(t, err)
+
+		log, err := entClient.AuditLog.Query().
+			Where(auditlog.ActorIDEQ(target.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, log.ImpersonatorID)
+		assert.Equal(t, admin.ID, *log.ImpersonatorID)
+	})
+
+	t.Run("self-service mutations are not logged", func(t *testing.T) {
+		before, err := entClient.AuditLog.Query().Count(ctx)
+		require.NoError(t, err)
+
+		var resp map[string]any
+		err = c.Post(`mutation { updateMe(input: { name: "admin" }) { id } }`, &resp,
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"me:write"}}))
+		require.NoError(t, err)
+
+		after, err := entClient.AuditLog.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("failed mutations are not logged", func(t *testing.T) {
+		before, err := entClient.AuditLog.Query().Count(ctx)
+		require.NoError(t, err)
+
+		var resp map[string]any
+		err = c.Post(updateUser, &resp,
+			client.Var("id", 0),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"user:write"}}))
+		require.Error(t, err)
+
+		// forbidden by the scope directive
+		err = c.Post(updateUser, &resp,
+			client.Var("id", target.ID),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"me:write"}}))
+		require.Error(t, err)
+
+		after, err := entClient.AuditLog.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleted, err := entClient.User.Create().
+			SetName("deleted").
+			SetEmail("deleted@example.com").
+			SetGroup(group).
+			Save(ctx)
+		require.NoError(t, err)
+
+		var resp map[string]any
+		err = c.Post(`mutation($id: ID!) { deleteUser(id: $id) }`, &resp,
+			client.Var("id", deleted.ID),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"user:write"}}))
+		require.NoError(t, err)
+
+		log, err := entClient.AuditLog.Query().
+			Where(auditlog.OperationEQ("deleteUser")).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "User", log.TargetType)
+		require.NotNil(t, log.TargetID)
+		assert.Equal(t, deleted.ID, *log.TargetID)
+		require.NotEmpty(t, log.Changes)
+		fields := make([]string, 0, len(log.Changes))
+		for _, change := range log.Changes {
+			assert.NotNil(t, change.Before)
+			assert.Nil(t, change.After)
+			fields = append(fields, change.Field)
+		}
+		assert.Contains(t, fields, "group_id")
+		assert.NotContains(t, fields, "pseudonym")
+	})
+
+	t.Run("query", func(t *testing.T) {
+		var resp struct {
+			AuditLogs struct {
+				TotalCount int
+				Edges      []struct {
+					Node struct {
+						Operation string
+						Actor     struct{ Name string }
+						Target    *struct{ ID string }
+						Changes   []struct{ Field string }
+					}
+				}
+			}
+		}
+		query := `query {
+			auditLogs(where: { operation: "updateUser" }, orderBy: { field: CREATED_AT, direction: ASC }) {
+				totalCount
+				edges { node { operation actor { name } target { id } changes { field } } }
+			}
+		}`
+		err := c.Post(query, &resp, asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"audit_log:read"}}))
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.AuditLogs.TotalCount)
+		node := resp.AuditLogs.Edges[0].Node
+		assert.Equal(t, "admin", node.Actor.Name)
+		require.NotNil(t, node.Target)
+		assert.Equal(t, strconv.Itoa(target.ID), node.Target.ID)
+		assert.NotEmpty(t, node.Changes)
+
+		// the audit logs are only visible to the admins
+		err = c.Post(query, &resp, asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"user:read"}}))
+		require.Error(t, err)
+	})
+
+	t.Run("edges", func(t *testing.T) {
+		other, err := entClient.Group.Create().SetName("other").Save(ctx)
+		require.NoError(t, err)
+
+		var resp map[string]any
+		err = c.Post(`mutation($id: ID!, $groupID: ID!) { updateUser(id: $id, input: { groupID: $groupID }) { id } }`, &resp,
+			client.Var("id", target.ID),
+			client.Var("groupID", other.ID),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"user:write"}}))
+		require.NoError(t, err)
+
+		log, err := entClient.AuditLog.Query().
+			Where(auditlog.OperationEQ("updateUser")).
+			Order(ent.Desc(auditlog.FieldID)).
+			First(ctx)
+		require.NoError(t, err)
+		changes := make(map[string][2]string)
+		for _, change := range log.Changes {
+			require.NotNil(t, change.Before)
+			require.NotNil(t, change.After)
+			changes[change.Field] = [2]string{*change.Before, *change.After}
+		}
+		assert.Equal(t, [2]string{strconv.Itoa(group.ID), strconv.Itoa(other.ID)}, changes["group_id"])
+	})
+
+	t.Run("scope checked in the resolver", func(t *testing.T) {
+		const createCheatRecord = `mutation($userID: ID) { createCheatRecord(userID: $userID, reason: "copying") { id } }`
+
+		var resp struct {
+			CreateCheatRecord struct{ ID string }
+		}
+		err := c.Post(createCheatRecord, &resp,
+			client.Var("userID", target.ID),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"cheat_record:write"}}))
+		require.NoError(t, err)
+
+		log, err := entClient.AuditLog.Query().
+			Where(auditlog.OperationEQ("createCheatRecord")).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "cheat_record:write", log.Scope)
+		assert.Equal(t, "CheatRecord", log.TargetType)
+		require.NotNil(t, log.TargetID)
+		assert.Equal(t, resp.CreateCheatRecord.ID, strconv.Itoa(*log.TargetID))
+		changes := make(map[string]string)
+		for _, change := range log.Changes {
+			assert.Nil(t, change.Before)
+			require.NotNil(t, change.After)
+			changes[change.Field] = *change.After
+		}
+		assert.Equal(t, strconv.Itoa(target.ID), changes["user_id"])
+		assert.Equal(t, strconv.Itoa(admin.ID), changes["reporter_id"])
+
+		// reporting oneself is not privileged
+		err = c.Post(createCheatRecord, &resp,
+			client.Var("userID", nil),
+			asUser(auth.TokenInfo{UserID: admin.ID, Scopes: []string{"me:write"}}))
+		require.NoError(t, err)
+
+		count, err := entClient.AuditLog.Query().
+			Where(auditlog.OperationEQ("createCheatRecord")).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
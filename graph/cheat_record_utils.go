@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/models"
+)
+
+// cheatRecordTransitionError maps the errors of cheating.Service to the GraphQL errors.
+func cheatRecordTransitionError(err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return defs.ErrNotFound
+	case errors.Is(err, cheating.ErrInvalidTransition):
+		return defs.NewErrInvalidInput(err.Error())
+	default:
+		return err
+	}
+}
+
+// buildCheatEvidence checks that the submissions and the events in the input
+// belong to the user, and returns the evidence of a cheat record.
+func buildCheatEvidence(ctx context.Context, entClient *ent.Client, userID int, input *model.CheatEvidenceInput) (*models.CheatEvidence, error) {
+	if input == nil || (len(input.SubmissionIDs) == 0 && len(input.EventIDs) == 0) {
+		return nil, nil
+	}
+
+	if len(input.SubmissionIDs) > 0 {
+		count, err := entClient.Submission.Query().
+			Where(submission.IDIn(input.SubmissionIDs...), submission.HasUserWith(user.ID(userID))).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count != len(input.SubmissionIDs) {
+			return nil, defs.NewErrInvalidInput("the submissions should be distinct and belong to the user")
+		}
+	}
+
+	if len(input.EventIDs) > 0 {
+		count, err := entClient.Event.Query().
+			Where(event.IDIn(input.EventIDs...), event.HasUserWith(user.ID(userID))).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count != len(input.EventIDs) {
+			return nil, defs.NewErrInvalidInput("the events should be distinct and belong to the user")
+		}
+	}
+
+	return &models.CheatEvidence{
+		SubmissionIDs: input.SubmissionIDs,
+		EventIDs:      input.EventIDs,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/database-playground/backend-v2/internal/auth"
+)
+
+// fieldCosts are the costs of the expensive fields, keyed by "Type.field".
+// The other fields cost 1. The complexity of a field is its cost plus the
+// complexity of its selections.
+var fieldCosts = map[string]int{
+	// runs the reference answer in the SQL runner
+	"Question.referenceAnswerResult": 50,
+	// runs the schema of the database in the SQL runner
+	"Database.structure": 50,
+	// aggregates the submissions of the question
+	"Question.statistics": 10,
+	// aggregates the submissions of the user
+	"User.submissionStatistics": 10,
+	// builds the rankings of the past days
+	"User.rankHistory":                  20,
+	"Query.myRanking":                   20,
+	"Query.ranking":                     20,
+	"Analytics.groupQuestionStatistics": 20,
+	"Analytics.inactiveStudents":        20,
+	"Analytics.activityHeatmap":         20,
+	// clusters the latest failed submissions of the question
+	"QuestionStatistics.commonMistakes": 20,
+}
+
+// defaultConnectionSize is the assumed number of the nodes in a
+// connection queried without "first" or "last".
+const defaultConnectionSize = 100
+
+// complexitySchema weights the fields in the complexity calculation with
+// fieldCosts, and multiplies the complexity of the connection nodes by the
+// number of the requested nodes, so that the nested connections are costly.
+type complexitySchema struct {
+	graphql.ExecutableSchema
+}
+
+func (s complexitySchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, args map[string]any) (int, bool) {
+	if complexity, ok := s.ExecutableSchema.Complexity(ctx, typeName, field, childComplexity, args); ok {
+		return complexity, true
+	}
+
+	cost := 1
+	if c, ok := fieldCosts[typeName+"."+field]; ok {
+		cost = c
+	}
+
+	if s.isConnection(typeName, field) {
+		size := defaultConnectionSize
+		for _, arg := range []string{"first", "last"} {
+			if n, ok := intArg(args[arg]); ok && n >= 0 {
+				size = n
+				break
+			}
+		}
+
+		return saturatingAdd(cost, saturatingMul(childComplexity, max(size, 1))), true
+	}
+
+	return saturatingAdd(cost, childComplexity), true
+}
+
+// isConnection reports whether the field returns a relay connection.
+func (s complexitySchema) isConnection(typeName, field string) bool {
+	def := s.Schema().Types[typeName]
+	if def == nil {
+		return false
+	}
+	fieldDef := def.Fields.ForName(field)
+	if fieldDef == nil {
+		return false
+	}
+
+	return strings.HasSuffix(fieldDef.Type.Name(), "Connection")
+}
+
+// intArg converts the argument to an int. The literals are int64, and
+// the variables are json.Number before they are unmarshalled.
+func intArg(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(min(n, math.MaxInt32)), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(min(i, math.MaxInt32)), err == nil
+	}
+
+	return 0, false
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
+
+// AdminScope is the scope of the administrators, who get the higher
+// complexity and depth budgets.
+const AdminScope = "*"
+
+// IsAdmin reports whether the user of the request holds AdminScope.
+func IsAdmin(ctx context.Context) bool {
+	user, ok := auth.GetUser(ctx)
+	return ok && slices.Contains(user.Scopes, AdminScope)
+}
+
+// LimitFunc returns the function returning adminLimit for the users holding
+// AdminScope, and limit for the others. It is used as the Func of the
+// complexity and depth limits. A non-positive limit disables the limit.
+func LimitFunc(limit, adminLimit int) func(ctx context.Context, opCtx *graphql.OperationContext) int {
+	return func(ctx context.Context, _ *graphql.OperationContext) int {
+		budget := limit
+		if IsAdmin(ctx) {
+			budget = adminLimit
+		}
+		if budget <= 0 {
+			return math.MaxInt
+		}
+
+		return budget
+	}
+}
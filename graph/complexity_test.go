@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/graphql/depth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func calculate(t *testing.T, query string) (int, int) {
+	t.Helper()
+
+	schema := complexitySchema{NewExecutableSchema(Config{Resolvers: &Resolver{}})}
+	doc, errs := gqlparser.LoadQuery(schema.Schema(), query)
+	require.Empty(t, errs)
+	op := doc.Operations[0]
+
+	return complexity.Calculate(context.Background(), schema, op, nil), depth.Calculate(op.SelectionSet)
+}
+
+func TestComplexity(t *testing.T) {
+	c, d := calculate(t, `{ me { id name } }`)
+	assert.Equal(t, 3, c)
+	assert.Equal(t, 2, d)
+
+	// the expensive fields
+	c, _ = calculate(t, `{ question(id: 1) { referenceAnswerResult { columns } } }`)
+	assert.Equal(t, 1+50+1, c)
+	c, _ = calculate(t, `{ question(id: 1) { statistics { commonMistakes { count } } } }`)
+	assert.Equal(t, 1+10+20+1, c)
+
+	// the connection nodes are multiplied by the requested nodes
+	c, d = calculate(t, `{ questions(first: 10) { edges { node { id title } } } }`)
+	assert.Equal(t, 1+10*(1+1+2), c)
+	assert.Equal(t, 4, d)
+
+	// nested connections fan out
+	nested, _ := calculate(t, `{ users(first: 10) { edges { node { submissions(first: 10) { edges { node { id } } } } } } }`)
+	assert.Equal(t, 1+10*(1+1+(1+10*(1+1+1))), nested)
+
+	// the variables are counted
+	schema := complexitySchema{NewExecutableSchema(Config{Resolvers: &Resolver{}})}
+	doc, errs := gqlparser.LoadQuery(schema.Schema(), `query($n: Int) { questions(first: $n) { totalCount } }`)
+	require.Empty(t, errs)
+	c = complexity.Calculate(context.Background(), schema, doc.Operations[0], map[string]any{"n": json.Number("5")})
+	assert.Equal(t, 1+5, c)
+
+	// without first or last, a connection is assumed to have 100 nodes
+	c, _ = calculate(t, `{ questions { totalCount } }`)
+	assert.Equal(t, 1+defaultConnectionSize, c)
+
+	// fragments are expanded
+	_, d = calculate(t, `{ me { ...F } } fragment F on User { group { name } }`)
+	assert.Equal(t, 3, d)
+}
+
+func TestLimitFunc(t *testing.T) {
+	limit := LimitFunc(100, 1000)
+
+	assert.Equal(t, 100, limit(context.Background(), nil))
+
+	student := auth.WithUser(context.Background(), auth.TokenInfo{UserID: 1, Scopes: []string{"me:*"}})
+	assert.Equal(t, 100, limit(student, nil))
+
+	admin := auth.WithUser(context.Background(), auth.TokenInfo{UserID: 2, Scopes: []string{AdminScope}})
+	assert.Equal(t, 1000, limit(admin, nil))
+	assert.Equal(t, 100, limit(student, nil))
+
+	assert.Greater(t, LimitFunc(0, 0)(context.Background(), nil), 1<<30)
+}
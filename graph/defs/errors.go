@@ -2,12 +2,16 @@ package defs
 
 import (
 	"fmt"
+	"math"
+	"time"
 )
 
 // GqlError is the extension of an error with a code.
 type GqlError struct {
 	Message string
 	Code    string
+	// Extensions are the additional extensions of the error besides the code.
+	Extensions map[string]any
 }
 
 func (e GqlError) Error() string {
@@ -22,6 +26,28 @@ func NewErrNoSufficientScope(requireScope string) GqlError {
 	}
 }
 
+// NewErrInvalidInput creates an "invalid input" error with the given message.
+func NewErrInvalidInput(message string) GqlError {
+	return GqlError{
+		Message: message,
+		Code:    CodeInvalidInput,
+	}
+}
+
+// NewErrRateLimited creates a "rate limited" error with the given message.
+//
+// The "retry_after" extension is the number of seconds (rounded up)
+// the client should wait before retrying.
+func NewErrRateLimited(message string, retryAfter time.Duration) GqlError {
+	return GqlError{
+		Message: message,
+		Code:    CodeRateLimited,
+		Extensions: map[string]any{
+			"retry_after": int(math.Ceil(retryAfter.Seconds())),
+		},
+	}
+}
+
 // ErrNotFound is the error for "not found".
 var ErrNotFound = GqlError{
 	Message: "not found",
@@ -46,6 +72,13 @@ var ErrNotImplemented = GqlError{
 	Code:    CodeNotImplemented,
 }
 
+// ErrIdempotencyKeyInUse is the error for a retry sent while the first
+// request with the same idempotency key is still running.
+var ErrIdempotencyKeyInUse = GqlError{
+	Message: "a request with the same idempotency key is in progress",
+	Code:    CodeIdempotencyKeyInUse,
+}
+
 var ErrDisallowUpdateGroup = GqlError{
 	Message: "update group of yourself is not allowed",
 	Code:    CodeForbidden,
@@ -67,4 +100,8 @@ const (
 	CodeForbidden = "FORBIDDEN"
 	// CodeInvalidInput is the error code for "invalid input".
 	CodeInvalidInput = "INVALID_INPUT"
+	// CodeRateLimited is the error code for "rate limited".
+	CodeRateLimited = "RATE_LIMITED"
+	// CodeIdempotencyKeyInUse is the error code for "idempotency key in use".
+	CodeIdempotencyKeyInUse = "IDEMPOTENCY_KEY_IN_USE"
 )
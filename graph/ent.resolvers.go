@@ -36,6 +36,24 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []int) ([]ent.Noder, erro
 	return nil, defs.ErrNotImplemented
 }
 
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.AuditLogOrder, where *ent.AuditLogWhereInput) (*ent.AuditLogConnection, error) {
+	ctx, span := tracer.Start(ctx, "AuditLogs")
+	defer span.End()
+
+	entClient := r.EntClient(ctx)
+
+	connection, err := entClient.AuditLog.Query().Paginate(ctx, after, first, before, last, ent.WithAuditLogOrder(orderBy), ent.WithAuditLogFilter(where.Filter))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query audit logs")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Audit logs retrieved successfully")
+	return connection, nil
+}
+
 // CheatRecords is the resolver for the cheatRecords field.
 func (r *queryResolver) CheatRecords(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.CheatRecordWhereInput) (*ent.CheatRecordConnection, error) {
 	ctx, span := tracer.Start(ctx, "CheatRecords")
@@ -108,6 +126,24 @@ func (r *queryResolver) Groups(ctx context.Context) ([]*ent.Group, error) {
 	return groups, nil
 }
 
+// JobRuns is the resolver for the jobRuns field.
+func (r *queryResolver) JobRuns(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.JobRunOrder, where *ent.JobRunWhereInput) (*ent.JobRunConnection, error) {
+	ctx, span := tracer.Start(ctx, "JobRuns")
+	defer span.End()
+
+	entClient := r.EntClient(ctx)
+
+	connection, err := entClient.JobRun.Query().Paginate(ctx, after, first, before, last, ent.WithJobRunOrder(orderBy), ent.WithJobRunFilter(where.Filter))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query job runs")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Job runs retrieved successfully")
+	return connection, nil
+}
+
 // Points is the resolver for the points field.
 func (r *queryResolver) Points(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.PointOrder, where *ent.PointWhereInput) (*ent.PointConnection, error) {
 	ctx, span := tracer.Start(ctx, "Points")
@@ -201,19 +237,49 @@ func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[int], fi
 	return connection, nil
 }
 
+// WebhookSubscriptions is the resolver for the webhookSubscriptions field.
+func (r *queryResolver) WebhookSubscriptions(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.WebhookSubscriptionWhereInput) (*ent.WebhookSubscriptionConnection, error) {
+	ctx, span := tracer.Start(ctx, "WebhookSubscriptions")
+	defer span.End()
+
+	entClient := r.EntClient(ctx)
+
+	connection, err := entClient.WebhookSubscription.Query().Paginate(ctx, after, first, before, last, ent.WithWebhookSubscriptionFilter(where.Filter))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query webhook subscriptions")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhook subscriptions retrieved successfully")
+	return connection, nil
+}
+
+// AuditLog returns AuditLogResolver implementation.
+func (r *Resolver) AuditLog() AuditLogResolver { return &auditLogResolver{r} }
+
 // Database returns DatabaseResolver implementation.
 func (r *Resolver) Database() DatabaseResolver { return &databaseResolver{r} }
 
+// Event returns EventResolver implementation.
+func (r *Resolver) Event() EventResolver { return &eventResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 // Question returns QuestionResolver implementation.
 func (r *Resolver) Question() QuestionResolver { return &questionResolver{r} }
 
+// Submission returns SubmissionResolver implementation.
+func (r *Resolver) Submission() SubmissionResolver { return &submissionResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
+type auditLogResolver struct{ *Resolver }
 type databaseResolver struct{ *Resolver }
+type eventResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type questionResolver struct{ *Resolver }
+type submissionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
@@ -7,16 +7,42 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/event"
 	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/graph/defs"
 	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/events"
 	"github.com/database-playground/backend-v2/internal/scope"
 	otelcodes "go.opentelemetry.io/otel/codes"
 )
 
+// Payload is the resolver for the payload field.
+func (r *eventResolver) Payload(ctx context.Context, obj *ent.Event) (events.Payload, error) {
+	_, span := tracer.Start(ctx, "Payload")
+	defer span.End()
+
+	// The legacy events can have an unknown type or a payload in an old
+	// shape. Their payload is null, so that they do not fail the whole list.
+	payload, err := events.DecodePayload(obj)
+	if errors.Is(err, events.ErrUnknownEventType) {
+		span.SetStatus(otelcodes.Ok, "Unknown event type")
+		return nil, nil
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to decode event payload")
+		span.RecordError(err)
+		slog.Warn("failed to decode event payload", "error", err, "event_id", obj.ID, "event_type", obj.Type)
+		return nil, nil
+	}
+
+	span.SetStatus(otelcodes.Ok, "Event payload decoded successfully")
+	return payload, nil
+}
+
 // Event is the resolver for the event field.
 func (r *queryResolver) Event(ctx context.Context, id int) (*ent.Event, error) {
 	ctx, span := tracer.Start(ctx, "Event")
@@ -74,6 +74,53 @@ func TestQueryResolver_Event(t *testing.T) {
 		require.Equal(t, "login", resp.Event.Type)
 	})
 
+	t.Run("success - typed payload", func(t *testing.T) {
+		var resp struct {
+			Event struct {
+				Payload struct {
+					Typename string `json:"__typename"`
+					Machine  string
+				}
+			}
+		}
+		err := gqlClient.Post(`query { event(id: `+strconv.Itoa(event.ID)+`) { payload { __typename ... on LoginEventPayload { machine } } } }`, &resp, func(bd *client.Request) {
+			bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+				UserID: ownerUser.ID,
+				Scopes: []string{},
+			}))
+		})
+		require.NoError(t, err)
+		require.Equal(t, "LoginEventPayload", resp.Event.Payload.Typename)
+		require.Equal(t, "test", resp.Event.Payload.Machine)
+	})
+
+	t.Run("success - legacy event has a null payload", func(t *testing.T) {
+		legacyEvent, err := entClient.Event.Create().
+			SetUserID(ownerUser.ID).
+			SetType("legacy_type").
+			SetPayload(map[string]any{"foo": "bar"}).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		var resp struct {
+			Event struct {
+				Type    string
+				Payload *struct {
+					Typename string `json:"__typename"`
+				}
+			}
+		}
+		err = gqlClient.Post(`query { event(id: `+strconv.Itoa(legacyEvent.ID)+`) { type payload { __typename } } }`, &resp, func(bd *client.Request) {
+			bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+				UserID: ownerUser.ID,
+				Scopes: []string{},
+			}))
+		})
+		require.NoError(t, err)
+		require.Equal(t, "legacy_type", resp.Event.Type)
+		require.Nil(t, resp.Event.Payload)
+	})
+
 	t.Run("success - user with event:read scope can access", func(t *testing.T) {
 		var resp struct {
 			Event struct {
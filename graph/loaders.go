@@ -0,0 +1,254 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/point"
+	"github.com/database-playground/backend-v2/ent/question"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/internal/dataloader"
+	"github.com/vektah/gqlparser/v2/ast"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// userQuestion is the key of the lookups of a user on a question.
+type userQuestion struct {
+	UserID     int
+	QuestionID int
+}
+
+// questionProgress is whether a user has attempted and solved a question.
+type questionProgress struct {
+	Attempted bool
+	Solved    bool
+}
+
+// Loaders are the dataloaders batching the per-object lookups of the
+// resolvers, such as Question.attempted of every question in a list.
+type Loaders struct {
+	client *ent.Client
+
+	questionProgress *dataloader.Loader[userQuestion, questionProgress]
+	lastSubmission   *dataloader.Loader[userQuestion, *ent.Submission]
+	totalPoints      *dataloader.Loader[int, int]
+	cheating         *dataloader.Loader[int, bool]
+}
+
+// NewLoaders creates the dataloaders querying with the client.
+// They cache the results, so they should be scoped to a request.
+func NewLoaders(client *ent.Client, opts ...dataloader.Option) *Loaders {
+	l := &Loaders{client: client}
+	l.questionProgress = dataloader.NewLoader(l.loadQuestionProgress, opts...)
+	l.lastSubmission = dataloader.NewLoader(l.loadLastSubmission, opts...)
+	l.totalPoints = dataloader.NewLoader(l.loadTotalPoints, opts...)
+	l.cheating = dataloader.NewLoader(l.loadCheating, opts...)
+
+	return l
+}
+
+type loadersKey struct{}
+
+// WithLoaders returns a context carrying the dataloaders.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, loaders)
+}
+
+// NewLoadersMiddleware creates the operation middleware providing new
+// dataloaders to every query.
+//
+// The mutations are not provided, since their fields are resolved in the
+// transaction after the changes, and the cached results would be stale.
+func NewLoadersMiddleware(client *ent.Client) graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		oc := graphql.GetOperationContext(ctx)
+		if oc.Operation == nil || oc.Operation.Operation != ast.Query {
+			return next(ctx)
+		}
+
+		return next(WithLoaders(ctx, NewLoaders(client)))
+	}
+}
+
+// Loaders returns the dataloaders of the request. Without them (e.g. in a
+// mutation), it returns new dataloaders querying with the client of the
+// context, which fetch every lookup on its own.
+func (r *Resolver) Loaders(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+
+	return NewLoaders(r.EntClient(ctx), dataloader.WithWait(0))
+}
+
+// groupByUser groups the question IDs of the keys by the users.
+func groupByUser(keys []userQuestion) map[int][]int {
+	questionIDs := make(map[int][]int)
+	for _, key := range keys {
+		questionIDs[key.UserID] = append(questionIDs[key.UserID], key.QuestionID)
+	}
+
+	return questionIDs
+}
+
+func (l *Loaders) loadQuestionProgress(ctx context.Context, keys []userQuestion) (map[userQuestion]questionProgress, error) {
+	ctx, span := tracer.Start(ctx, "loadQuestionProgress")
+	defer span.End()
+
+	results := make(map[userQuestion]questionProgress, len(keys))
+	for userID, questionIDs := range groupByUser(keys) {
+		var rows []struct {
+			QuestionID int `json:"question_id"`
+			Solved     int `json:"solved"`
+		}
+		err := l.client.Submission.Query().
+			Where(
+				entSubmission.HasUserWith(user.ID(userID)),
+				entSubmission.HasQuestionWith(question.IDIn(questionIDs...)),
+			).
+			Modify(func(sel *sql.Selector) {
+				sel.Select(
+					sql.As(sel.C(entSubmission.QuestionColumn), "question_id"),
+					sql.As(fmt.Sprintf("COUNT(CASE WHEN %s = '%s' THEN 1 END)",
+						sel.C(entSubmission.FieldStatus), entSubmission.StatusSuccess,
+					), "solved"),
+				).GroupBy(sel.C(entSubmission.QuestionColumn))
+			}).
+			Scan(ctx, &rows)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to query submissions")
+			span.RecordError(err)
+			return nil, fmt.Errorf("query question progress: %w", err)
+		}
+
+		for _, row := range rows {
+			results[userQuestion{UserID: userID, QuestionID: row.QuestionID}] = questionProgress{
+				Attempted: true,
+				Solved:    row.Solved > 0,
+			}
+		}
+	}
+
+	span.SetStatus(otelcodes.Ok, "Question progress loaded successfully")
+	return results, nil
+}
+
+func (l *Loaders) loadLastSubmission(ctx context.Context, keys []userQuestion) (map[userQuestion]*ent.Submission, error) {
+	ctx, span := tracer.Start(ctx, "loadLastSubmission")
+	defer span.End()
+
+	results := make(map[userQuestion]*ent.Submission, len(keys))
+	for userID, questionIDs := range groupByUser(keys) {
+		submissions, err := l.client.Submission.Query().
+			Where(
+				entSubmission.HasUserWith(user.ID(userID)),
+				entSubmission.HasQuestionWith(question.IDIn(questionIDs...)),
+				lastSubmitted,
+			).
+			WithQuestion(func(q *ent.QuestionQuery) {
+				q.Select(question.FieldID)
+			}).
+			Order(entSubmission.BySubmittedAt(sql.OrderDesc()), entSubmission.ByID(sql.OrderDesc())).
+			All(ctx)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to query submissions")
+			span.RecordError(err)
+			return nil, fmt.Errorf("query last submissions: %w", err)
+		}
+
+		for _, s := range submissions {
+			key := userQuestion{UserID: userID, QuestionID: s.Edges.Question.ID}
+			// the submissions submitted at the same time are ordered by ID.
+			if _, ok := results[key]; !ok {
+				results[key] = s
+			}
+		}
+	}
+
+	span.SetStatus(otelcodes.Ok, "Last submissions loaded successfully")
+	return results, nil
+}
+
+// lastSubmitted is the predicate of the last submissions of the user to the question.
+func lastSubmitted(sel *sql.Selector) {
+	last := sql.Table(entSubmission.Table).As("last_submission")
+	lastSubmittedAt := sql.Select(sql.Max(last.C(entSubmission.FieldSubmittedAt))).
+		From(last).
+		Where(sql.And(
+			sql.ColumnsEQ(last.C(entSubmission.UserColumn), sel.C(entSubmission.UserColumn)),
+			sql.ColumnsEQ(last.C(entSubmission.QuestionColumn), sel.C(entSubmission.QuestionColumn)),
+		))
+
+	sel.Where(sql.P(func(b *sql.Builder) {
+		b.Ident(sel.C(entSubmission.FieldSubmittedAt)).WriteOp(sql.OpEQ).Wrap(func(b *sql.Builder) {
+			b.Join(lastSubmittedAt)
+		})
+	}))
+}
+
+func (l *Loaders) loadTotalPoints(ctx context.Context, userIDs []int) (map[int]int, error) {
+	ctx, span := tracer.Start(ctx, "loadTotalPoints")
+	defer span.End()
+
+	var rows []struct {
+		UserID      int `json:"user_points"`
+		TotalPoints int `json:"total_points"`
+	}
+	err := l.client.Point.Query().
+		Where(point.HasUserWith(user.IDIn(userIDs...))).
+		GroupBy(point.UserColumn).
+		Aggregate(func(sel *sql.Selector) string {
+			return sql.As(sql.Sum(sel.C(point.FieldPoints)), "total_points")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query points")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query total points: %w", err)
+	}
+
+	results := make(map[int]int, len(rows))
+	for _, row := range rows {
+		results[row.UserID] = row.TotalPoints
+	}
+
+	span.SetStatus(otelcodes.Ok, "Total points loaded successfully")
+	return results, nil
+}
+
+func (l *Loaders) loadCheating(ctx context.Context, userIDs []int) (map[int]bool, error) {
+	ctx, span := tracer.Start(ctx, "loadCheating")
+	defer span.End()
+
+	var rows []struct {
+		UserID int `json:"user_cheat_records"`
+		Count  int `json:"count"`
+	}
+	err := l.client.CheatRecord.Query().
+		Where(
+			cheatrecord.HasUserWith(user.IDIn(userIDs...)),
+			cheating.Counted(),
+		).
+		GroupBy(cheatrecord.UserColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query cheat records")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query cheat records: %w", err)
+	}
+
+	results := make(map[int]bool, len(rows))
+	for _, row := range rows {
+		results[row.UserID] = row.Count > 0
+	}
+
+	span.SetStatus(otelcodes.Ok, "Cheating status loaded successfully")
+	return results, nil
+}
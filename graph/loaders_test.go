@@ -0,0 +1,241 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/graph/directive"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newLoadersTestClient creates a client of the server providing the
+// dataloaders if withLoaders is true.
+func newLoadersTestClient(t *testing.T, entClient *ent.Client, withLoaders bool) *client.Client {
+	t.Helper()
+
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}))
+	srv.AddTransport(transport.POST{})
+	if withLoaders {
+		srv.AroundOperations(NewLoadersMiddleware(entClient))
+	}
+
+	return client.New(srv)
+}
+
+func asAdmin(userID int) client.Option {
+	return func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+			UserID: userID,
+			Scopes: []string{"*"},
+		}))
+	}
+}
+
+func TestLoaders_Question(t *testing.T) {
+	entClient, counter := testhelper.NewEntSqliteClientWithQueryCounter(t)
+
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+	u, err := entClient.User.Create().
+		SetName("user").
+		SetEmail("user@example.com").
+		SetGroup(group).
+		Save(context.Background())
+	require.NoError(t, err)
+	other, err := entClient.User.Create().
+		SetName("other").
+		SetEmail("other@example.com").
+		SetGroup(group).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	database := createTestDatabase(t, entClient)
+	questions := make([]*ent.Question, 20)
+	for i := range questions {
+		questions[i] = createTestQuestion(t, entClient, database)
+	}
+
+	now := time.Now()
+	// solved
+	createTestSubmission(t, entClient, u, questions[0], "SELECT 1;", submission.StatusSuccess, now)
+	// solved and then failed
+	createTestSubmission(t, entClient, u, questions[1], "SELECT 1;", submission.StatusSuccess, now.Add(-time.Hour))
+	lastFailed := createTestSubmission(t, entClient, u, questions[1], "SELECT 2;", submission.StatusFailed, now)
+	// failed
+	createTestSubmission(t, entClient, u, questions[2], "SELECT 3;", submission.StatusFailed, now)
+	// only attempted by the other user
+	createTestSubmission(t, entClient, other, questions[3], "SELECT 1;", submission.StatusSuccess, now)
+
+	query := `query { questions(first: 50) { edges { node { id attempted solved lastSubmission { id } } } } }`
+	type response struct {
+		Questions struct {
+			Edges []struct {
+				Node struct {
+					ID             string
+					Attempted      bool
+					Solved         bool
+					LastSubmission *struct {
+						ID string
+					}
+				}
+			}
+		}
+	}
+
+	assertResponse := func(t *testing.T, resp response) {
+		t.Helper()
+
+		require.Len(t, resp.Questions.Edges, len(questions))
+		for _, edge := range resp.Questions.Edges {
+			node := edge.Node
+			switch node.ID {
+			case strconv.Itoa(questions[0].ID):
+				assert.True(t, node.Attempted)
+				assert.True(t, node.Solved)
+				assert.NotNil(t, node.LastSubmission)
+			case strconv.Itoa(questions[1].ID):
+				assert.True(t, node.Attempted)
+				assert.True(t, node.Solved)
+				require.NotNil(t, node.LastSubmission)
+				assert.Equal(t, strconv.Itoa(lastFailed.ID), node.LastSubmission.ID)
+			case strconv.Itoa(questions[2].ID):
+				assert.True(t, node.Attempted)
+				assert.False(t, node.Solved)
+				assert.NotNil(t, node.LastSubmission)
+			default:
+				assert.False(t, node.Attempted)
+				assert.False(t, node.Solved)
+				assert.Nil(t, node.LastSubmission)
+			}
+		}
+	}
+
+	t.Run("with loaders", func(t *testing.T) {
+		gqlClient := newLoadersTestClient(t, entClient, true)
+		counter.Reset()
+
+		var resp response
+		require.NoError(t, gqlClient.Post(query, &resp, asAdmin(u.ID)))
+		assertResponse(t, resp)
+
+		// the questions, the progress, and the last submissions with their questions
+		assert.Equal(t, 4, counter.Count())
+	})
+
+	t.Run("without loaders", func(t *testing.T) {
+		gqlClient := newLoadersTestClient(t, entClient, false)
+		counter.Reset()
+
+		var resp response
+		require.NoError(t, gqlClient.Post(query, &resp, asAdmin(u.ID)))
+		assertResponse(t, resp)
+
+		// every field of every question queries on its own
+		assert.GreaterOrEqual(t, counter.Count(), 1+len(questions)*3)
+	})
+}
+
+func TestLoaders_User(t *testing.T) {
+	entClient, counter := testhelper.NewEntSqliteClientWithQueryCounter(t)
+
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+
+	users := make([]*ent.User, 10)
+	for i := range users {
+		users[i], err = entClient.User.Create().
+			SetName("user" + strconv.Itoa(i)).
+			SetEmail("user" + strconv.Itoa(i) + "@example.com").
+			SetGroup(group).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	// users[0] has 30 points, users[1] has 5 points
+	for _, points := range []int{10, 20} {
+		_, err = entClient.Point.Create().SetUser(users[0]).SetPoints(points).Save(context.Background())
+		require.NoError(t, err)
+	}
+	_, err = entClient.Point.Create().SetUser(users[1]).SetPoints(5).Save(context.Background())
+	require.NoError(t, err)
+
+	// users[0] is cheating, and the record of users[1] is dismissed
+	_, err = entClient.CheatRecord.Create().SetUser(users[0]).SetReason("cheating").Save(context.Background())
+	require.NoError(t, err)
+	_, err = entClient.CheatRecord.Create().SetUser(users[1]).SetReason("dismissed").SetState(cheatrecord.StateDismissed).Save(context.Background())
+	require.NoError(t, err)
+
+	query := `query { users(first: 50) { edges { node { id totalPoints cheating } } } }`
+	type response struct {
+		Users struct {
+			Edges []struct {
+				Node struct {
+					ID          string
+					TotalPoints int
+					Cheating    bool
+				}
+			}
+		}
+	}
+
+	assertResponse := func(t *testing.T, resp response) {
+		t.Helper()
+
+		require.Len(t, resp.Users.Edges, len(users))
+		for _, edge := range resp.Users.Edges {
+			node := edge.Node
+			switch node.ID {
+			case strconv.Itoa(users[0].ID):
+				assert.Equal(t, 30, node.TotalPoints)
+				assert.True(t, node.Cheating)
+			case strconv.Itoa(users[1].ID):
+				assert.Equal(t, 5, node.TotalPoints)
+				assert.False(t, node.Cheating)
+			default:
+				assert.Zero(t, node.TotalPoints)
+				assert.False(t, node.Cheating)
+			}
+		}
+	}
+
+	t.Run("with loaders", func(t *testing.T) {
+		gqlClient := newLoadersTestClient(t, entClient, true)
+		counter.Reset()
+
+		var resp response
+		require.NoError(t, gqlClient.Post(query, &resp, asAdmin(users[0].ID)))
+		assertResponse(t, resp)
+
+		// the users, the points and the cheat records
+		assert.Equal(t, 3, counter.Count())
+	})
+
+	t.Run("without loaders", func(t *testing.T) {
+		gqlClient := newLoadersTestClient(t, entClient, false)
+		counter.Reset()
+
+		var resp response
+		require.NoError(t, gqlClient.Post(query, &resp, asAdmin(users[0].ID)))
+		assertResponse(t, resp)
+
+		// every user queries on its own
+		assert.Equal(t, 1+len(users)*2, counter.Count())
+	})
+}
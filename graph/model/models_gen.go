@@ -7,13 +7,19 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"entgo.io/contrib/entgql"
-	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/question"
 	"github.com/database-playground/backend-v2/models"
 )
 
+// The evidence of a cheat record reported manually.
+type CheatEvidenceInput struct {
+	SubmissionIDs []int `json:"submissionIDs,omitempty"`
+	EventIDs      []int `json:"eventIDs,omitempty"`
+}
+
 type DatabaseStructure struct {
 	Tables []*DatabaseTable `json:"tables"`
 }
@@ -23,6 +29,17 @@ type DatabaseTable struct {
 	Columns []string `json:"columns"`
 }
 
+type MyRanking struct {
+	// The rank of the current user, or null if the user is not ranked,
+	// e.g. no points in the period, or excluded from the ranking.
+	Rank *int `json:"rank,omitempty"`
+	// The score of the current user, or null if the user is not ranked.
+	Score *int `json:"score,omitempty"`
+	// The current user and the neighbours, in the order of the ranking.
+	Edges      []*RankingEdge `json:"edges"`
+	TotalCount int            `json:"totalCount"`
+}
+
 type RankingConnection struct {
 	Edges      []*RankingEdge        `json:"edges"`
 	PageInfo   *entgql.PageInfo[int] `json:"pageInfo"`
@@ -30,8 +47,12 @@ type RankingConnection struct {
 }
 
 type RankingEdge struct {
-	Node   *ent.User          `json:"node"`
-	Score  int                `json:"score"`
+	// The public profile of the user, rather than the User, to keep the users private.
+	Node  *models.RankingProfile `json:"node"`
+	Score int                    `json:"score"`
+	// The 1-based rank of the user, shared by the users with the same score.
+	// The tied users are listed by who reached the score first, then by the user ID.
+	Rank   int                `json:"rank"`
 	Cursor entgql.Cursor[int] `json:"cursor"`
 }
 
@@ -39,6 +60,28 @@ type RankingFilter struct {
 	By     RankingBy     `json:"by"`
 	Order  RankingOrder  `json:"order"`
 	Period RankingPeriod `json:"period"`
+	// The start (inclusive) of the CUSTOM period. Required and only allowed with the CUSTOM period.
+	From *time.Time `json:"from,omitempty"`
+	// The end (exclusive) of the CUSTOM period. Required and only allowed with the CUSTOM period.
+	To *time.Time `json:"to,omitempty"`
+	// The IANA time zone (e.g. "Asia/Taipei") to calculate the start of the day, week and month in.
+	// Defaults to the time zone of the rankings (RANKING_TIMEZONE).
+	Timezone *string `json:"timezone,omitempty"`
+	// Only rank the users in this group.
+	GroupID *int `json:"groupID,omitempty"`
+	// Only count the submissions to these questions.
+	// Only allowed with the COMPLETED_QUESTIONS ranking, since the points are not tied to questions.
+	QuestionIDs []int `json:"questionIDs,omitempty"`
+	// Only count the submissions to the questions in this category.
+	// Only allowed with the COMPLETED_QUESTIONS ranking, since the points are not tied to questions.
+	Category *string `json:"category,omitempty"`
+	// Include the administrators in the ranking. Defaults to false.
+	// The users hidden from the rankings (see User.rankingVisibility) are never included.
+	IncludeAdmins *bool `json:"includeAdmins,omitempty"`
+	// Include the users with cheat records counted as cheating (see User.cheating) in the ranking. Defaults to false.
+	IncludeCheaters *bool `json:"includeCheaters,omitempty"`
+	// How the tied users are ranked. Defaults to COMPETITION.
+	RankMode *RankingMode `json:"rankMode,omitempty"`
 }
 
 // Filter for scope sets.
@@ -123,6 +166,64 @@ func (e RankingBy) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// How the tied users are ranked. The tied users always share the same rank.
+type RankingMode string
+
+const (
+	// The rank after the ties skips the tied users, e.g. 1, 2, 2, 4.
+	RankingModeCompetition RankingMode = "COMPETITION"
+	// The rank after the ties is the next one, e.g. 1, 2, 2, 3.
+	RankingModeDense RankingMode = "DENSE"
+)
+
+var AllRankingMode = []RankingMode{
+	RankingModeCompetition,
+	RankingModeDense,
+}
+
+func (e RankingMode) IsValid() bool {
+	switch e {
+	case RankingModeCompetition, RankingModeDense:
+		return true
+	}
+	return false
+}
+
+func (e RankingMode) String() string {
+	return string(e)
+}
+
+func (e *RankingMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RankingMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RankingMode", str)
+	}
+	return nil
+}
+
+func (e RankingMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RankingMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RankingMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type RankingOrder string
 
 const (
@@ -181,18 +282,25 @@ func (e RankingOrder) MarshalJSON() ([]byte, error) {
 type RankingPeriod string
 
 const (
-	RankingPeriodDaily  RankingPeriod = "DAILY"
-	RankingPeriodWeekly RankingPeriod = "WEEKLY"
+	RankingPeriodDaily   RankingPeriod = "DAILY"
+	RankingPeriodWeekly  RankingPeriod = "WEEKLY"
+	RankingPeriodMonthly RankingPeriod = "MONTHLY"
+	RankingPeriodAllTime RankingPeriod = "ALL_TIME"
+	// The period between the from and to of the filter.
+	RankingPeriodCustom RankingPeriod = "CUSTOM"
 )
 
 var AllRankingPeriod = []RankingPeriod{
 	RankingPeriodDaily,
 	RankingPeriodWeekly,
+	RankingPeriodMonthly,
+	RankingPeriodAllTime,
+	RankingPeriodCustom,
 }
 
 func (e RankingPeriod) IsValid() bool {
 	switch e {
-	case RankingPeriodDaily, RankingPeriodWeekly:
+	case RankingPeriodDaily, RankingPeriodWeekly, RankingPeriodMonthly, RankingPeriodAllTime, RankingPeriodCustom:
 		return true
 	}
 	return false
@@ -0,0 +1,59 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"errors"
+
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/proctoring"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// ReportProctoringSignals is the resolver for the reportProctoringSignals field.
+func (r *mutationResolver) ReportProctoringSignals(ctx context.Context, signals []*events.ProctoringSignalPayload) (int, error) {
+	ctx, span := tracer.Start(ctx, "ReportProctoringSignals")
+	defer span.End()
+
+	user, ok := auth.GetUser(ctx)
+	if !ok {
+		span.SetStatus(otelcodes.Error, "Unauthorized")
+		return 0, defs.ErrUnauthorized
+	}
+
+	payloads := make([]events.ProctoringSignalPayload, len(signals))
+	for i, signal := range signals {
+		payloads[i] = *signal
+	}
+
+	reported, err := r.proctoringReporter.Report(ctx, user.UserID, payloads)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to report proctoring signals")
+		span.RecordError(err)
+
+		var exceeded *ratelimit.ExceededError
+		switch {
+		case errors.Is(err, proctoring.ErrInvalidSignal):
+			return 0, defs.NewErrInvalidInput(err.Error())
+		case errors.As(err, &exceeded):
+			return 0, defs.NewErrRateLimited(proctoring.ErrRateLimited.Error(), exceeded.RetryAfter)
+		default:
+			return 0, err
+		}
+	}
+
+	span.SetStatus(otelcodes.Ok, "Proctoring signals reported successfully")
+	return reported, nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/graph/directive"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMutationResolver_ReportProctoringSignals(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	cfg := Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}
+	srv := handler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.POST{})
+	gqlClient := client.New(srv)
+
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+	user, err := entClient.User.Create().
+		SetName("student").
+		SetEmail("student@example.com").
+		SetGroup(group).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	asUser := func(scopes ...string) client.Option {
+		return func(bd *client.Request) {
+			bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+				UserID: user.ID,
+				Scopes: scopes,
+			}))
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var resp struct {
+			ReportProctoringSignals int
+		}
+		occurredAt := time.Now().UTC().Format(time.RFC3339)
+		err := gqlClient.Post(`mutation($occurredAt: Time!) {
+			reportProctoringSignals(signals: [
+				{ signal: tab_switch, occurredAt: $occurredAt, durationMs: 3000 },
+				{ signal: paste, occurredAt: $occurredAt, length: 120 }
+			])
+		}`, &resp, client.Var("occurredAt", occurredAt), asUser("me:write"))
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.ReportProctoringSignals)
+
+		count, err := entClient.Event.Query().
+			Where(event.UserID(user.ID), event.Type(string(events.EventTypeProctoringSignal))).
+			Count(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	})
+
+	t.Run("invalid signal", func(t *testing.T) {
+		var resp struct {
+			ReportProctoringSignals int
+		}
+		err := gqlClient.Post(`mutation {
+			reportProctoringSignals(signals: [{ signal: focus_loss, occurredAt: "2999-01-01T00:00:00Z" }])
+		}`, &resp, asUser("me:write"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), defs.CodeInvalidInput)
+	})
+
+	t.Run("insufficient scope", func(t *testing.T) {
+		var resp struct {
+			ReportProctoringSignals int
+		}
+		err := gqlClient.Post(`mutation {
+			reportProctoringSignals(signals: [{ signal: paste, occurredAt: "2025-03-01T12:00:00Z" }])
+		}`, &resp, asUser("me:read"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), defs.NewErrNoSufficientScope("me:write").Error())
+	})
+}
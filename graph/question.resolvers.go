@@ -18,7 +18,10 @@ import (
 	"github.com/database-playground/backend-v2/graph/defs"
 	"github.com/database-playground/backend-v2/graph/model"
 	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/insights"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
 	"github.com/database-playground/backend-v2/internal/scope"
+	"github.com/database-playground/backend-v2/internal/sqlnorm"
 	"github.com/database-playground/backend-v2/internal/submission"
 	"github.com/database-playground/backend-v2/models"
 	"github.com/samber/lo"
@@ -214,6 +217,12 @@ func (r *mutationResolver) SubmitAnswer(ctx context.Context, id int, answer stri
 			return nil, defs.ErrNotFound
 		}
 
+		var exceeded *ratelimit.ExceededError
+		if errors.As(err, &exceeded) {
+			span.SetStatus(otelcodes.Error, "Rate limited")
+			return nil, defs.NewErrRateLimited(submission.ErrRateLimited.Error(), exceeded.RetryAfter)
+		}
+
 		span.SetStatus(otelcodes.Error, "Failed to submit answer")
 		span.RecordError(err)
 		return nil, err
@@ -404,19 +413,16 @@ func (r *questionResolver) LastSubmission(ctx context.Context, obj *ent.Question
 		return nil, defs.ErrUnauthorized
 	}
 
-	submission, err := obj.QuerySubmissions().Where(
-		entSubmission.HasUserWith(user.ID(tokenInfo.UserID)),
-	).Order(entSubmission.BySubmittedAt(sql.OrderDesc())).First(ctx)
+	submission, err := r.Loaders(ctx).lastSubmission.Load(ctx, userQuestion{UserID: tokenInfo.UserID, QuestionID: obj.ID})
 	if err != nil {
-		if ent.IsNotFound(err) {
-			span.SetStatus(otelcodes.Ok, "No submission found")
-			return nil, nil
-		}
-
 		span.SetStatus(otelcodes.Error, "Failed to query last submission")
 		span.RecordError(err)
 		return nil, err
 	}
+	if submission == nil {
+		span.SetStatus(otelcodes.Ok, "No submission found")
+		return nil, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "Last submission retrieved successfully")
 	return submission, nil
@@ -433,9 +439,7 @@ func (r *questionResolver) Attempted(ctx context.Context, obj *ent.Question) (bo
 		return false, defs.ErrUnauthorized
 	}
 
-	exists, err := obj.QuerySubmissions().Where(
-		entSubmission.HasUserWith(user.ID(tokenInfo.UserID)),
-	).Exist(ctx)
+	progress, err := r.Loaders(ctx).questionProgress.Load(ctx, userQuestion{UserID: tokenInfo.UserID, QuestionID: obj.ID})
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check if question attempted")
 		span.RecordError(err)
@@ -443,7 +447,7 @@ func (r *questionResolver) Attempted(ctx context.Context, obj *ent.Question) (bo
 	}
 
 	span.SetStatus(otelcodes.Ok, "Attempted status checked successfully")
-	return exists, nil
+	return progress.Attempted, nil
 }
 
 // Solved is the resolver for the solved field.
@@ -457,10 +461,7 @@ func (r *questionResolver) Solved(ctx context.Context, obj *ent.Question) (bool,
 		return false, defs.ErrUnauthorized
 	}
 
-	exists, err := obj.QuerySubmissions().Where(
-		entSubmission.HasUserWith(user.ID(tokenInfo.UserID)),
-		entSubmission.StatusEQ(entSubmission.StatusSuccess),
-	).Exist(ctx)
+	progress, err := r.Loaders(ctx).questionProgress.Load(ctx, userQuestion{UserID: tokenInfo.UserID, QuestionID: obj.ID})
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check if question solved")
 		span.RecordError(err)
@@ -468,7 +469,7 @@ func (r *questionResolver) Solved(ctx context.Context, obj *ent.Question) (bool,
 	}
 
 	span.SetStatus(otelcodes.Ok, "Solved status checked successfully")
-	return exists, nil
+	return progress.Solved, nil
 }
 
 // Statistics is the resolver for the statistics field.
@@ -518,6 +519,7 @@ func (r *questionResolver) Statistics(ctx context.Context, obj *ent.Question) (*
 
 	span.SetStatus(otelcodes.Ok, "Question statistics retrieved successfully")
 	return &models.QuestionStatistics{
+		QuestionID:             obj.ID,
 		CorrectSubmissionCount: correctSubmissionCount,
 		SubmissionCount:        submissionCount,
 		AttemptedUsers:         attemptedUsers,
@@ -525,6 +527,42 @@ func (r *questionResolver) Statistics(ctx context.Context, obj *ent.Question) (*
 	}, nil
 }
 
+// CommonMistakes is the resolver for the commonMistakes field.
+func (r *questionStatisticsResolver) CommonMistakes(ctx context.Context, obj *models.QuestionStatistics, kind *insights.MistakeKind, first int) ([]*insights.MistakeCluster, error) {
+	ctx, span := tracer.Start(ctx, "CommonMistakes")
+	defer span.End()
+
+	clusters, err := r.insightsService.CommonMistakes(ctx, obj.QuestionID, kind, first)
+	if errors.Is(err, insights.ErrInvalidArgument) {
+		span.SetStatus(otelcodes.Error, "Invalid argument")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get common mistakes")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Common mistakes retrieved successfully")
+	return clusters, nil
+}
+
+// FormattedCode is the resolver for the formattedCode field.
+func (r *submissionResolver) FormattedCode(ctx context.Context, obj *ent.Submission) (string, error) {
+	_, span := tracer.Start(ctx, "FormattedCode")
+	defer span.End()
+
+	return sqlnorm.Format(obj.SubmittedCode), nil
+}
+
+// NormalizedHash is the resolver for the normalizedHash field.
+func (r *submissionResolver) NormalizedHash(ctx context.Context, obj *ent.Submission) (string, error) {
+	_, span := tracer.Start(ctx, "NormalizedHash")
+	defer span.End()
+
+	return sqlnorm.Hash(obj.SubmittedCode), nil
+}
+
 // SubmissionStatistics is the resolver for the submissionStatistics field.
 func (r *userResolver) SubmissionStatistics(ctx context.Context, obj *ent.User) (*model.SubmissionStatistics, error) {
 	ctx, span := tracer.Start(ctx, "SubmissionStatistics")
@@ -605,7 +643,9 @@ func (r *userResolver) SubmissionStatistics(ctx context.Context, obj *ent.User)
 	}, nil
 }
 
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+// QuestionStatistics returns QuestionStatisticsResolver implementation.
+func (r *Resolver) QuestionStatistics() QuestionStatisticsResolver {
+	return &questionStatisticsResolver{r}
+}
 
-type mutationResolver struct{ *Resolver }
+type questionStatisticsResolver struct{ *Resolver }
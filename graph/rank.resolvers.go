@@ -7,9 +7,15 @@ package graph
 
 import (
 	"context"
+	"errors"
 
 	"entgo.io/contrib/entgql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/graph/defs"
 	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/ranking"
 	otelcodes "go.opentelemetry.io/otel/codes"
 )
 
@@ -19,6 +25,10 @@ func (r *queryResolver) Ranking(ctx context.Context, first *int, after *entgql.C
 	defer span.End()
 
 	connection, err := r.rankingService.GetRanking(ctx, first, after, filter)
+	if errors.Is(err, ranking.ErrInvalidFilter) {
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to get ranking")
 		span.RecordError(err)
@@ -28,3 +38,73 @@ func (r *queryResolver) Ranking(ctx context.Context, first *int, after *entgql.C
 	span.SetStatus(otelcodes.Ok, "Ranking retrieved successfully")
 	return connection, nil
 }
+
+// MyRanking is the resolver for the myRanking field.
+func (r *queryResolver) MyRanking(ctx context.Context, filter model.RankingFilter, neighbours *int) (*model.MyRanking, error) {
+	ctx, span := tracer.Start(ctx, "MyRanking")
+	defer span.End()
+
+	user, ok := auth.GetUser(ctx)
+	if !ok {
+		// this should never happen since we have set proper scope
+		span.SetStatus(otelcodes.Error, "Unauthorized")
+		return nil, defs.ErrUnauthorized
+	}
+
+	n := 2
+	if neighbours != nil {
+		n = *neighbours
+	}
+
+	myRanking, err := r.rankingService.GetMyRanking(ctx, user.UserID, n, filter)
+	if errors.Is(err, ranking.ErrInvalidFilter) {
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get my ranking")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "My ranking retrieved successfully")
+	return myRanking, nil
+}
+
+// RankHistory is the resolver for the rankHistory field.
+func (r *userResolver) RankHistory(ctx context.Context, obj *ent.User, by model.RankingBy, days *int) ([]*ent.RankSnapshot, error) {
+	ctx, span := tracer.Start(ctx, "RankHistory")
+	defer span.End()
+
+	tokenInfo, ok := auth.GetUser(ctx)
+	if !ok {
+		span.SetStatus(otelcodes.Error, "Unauthorized")
+		return nil, defs.ErrUnauthorized
+	}
+
+	// The history of the users hidden from the rankings is only visible to
+	// themselves and the administrators.
+	if obj.RankingVisibility == user.RankingVisibilityHidden && obj.ID != tokenInfo.UserID && !IsAdmin(ctx) {
+		span.SetStatus(otelcodes.Ok, "User is hidden from the rankings")
+		return []*ent.RankSnapshot{}, nil
+	}
+
+	n := 30
+	if days != nil {
+		n = *days
+	}
+
+	history, err := r.rankingService.GetRankHistory(ctx, obj.ID, by, n)
+	if errors.Is(err, ranking.ErrInvalidFilter) {
+		span.SetStatus(otelcodes.Error, "Invalid rank history filter")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get rank history")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Rank history retrieved successfully")
+	return history, nil
+}
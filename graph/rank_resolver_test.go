@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/graph/directive"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestUserResolver_RankHistory(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	cfg := Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}
+	srv := handler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.POST{})
+	gqlClient := client.New(srv)
+
+	ctx := context.Background()
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+	student, err := entClient.User.Create().
+		SetName("student").
+		SetEmail("student@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+	viewer, err := entClient.User.Create().
+		SetName("viewer").
+		SetEmail("viewer@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = entClient.RankSnapshot.Create().
+		SetUserID(student.ID).
+		SetBy(ranksnapshot.ByPOINTS).
+		SetDate(time.Now().AddDate(0, 0, -1)).
+		SetRank(1).
+		SetScore(100).
+		Save(ctx)
+	require.NoError(t, err)
+
+	rankHistory := func(t *testing.T, info auth.TokenInfo) int {
+		t.Helper()
+
+		var resp struct {
+			User struct {
+				RankHistory []struct {
+					Rank  int
+					Score int
+				}
+			}
+		}
+		err := gqlClient.Post(`query($id: ID!) { user(id: $id) { rankHistory { rank score } } }`, &resp,
+			client.Var("id", student.ID),
+			func(bd *client.Request) {
+				bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), info))
+			})
+		require.NoError(t, err)
+		return len(resp.User.RankHistory)
+	}
+
+	t.Run("visible user", func(t *testing.T) {
+		require.Equal(t, 1, rankHistory(t, auth.TokenInfo{UserID: viewer.ID, Scopes: []string{"user:read"}}))
+	})
+
+	require.NoError(t, entClient.User.UpdateOne(student).SetRankingVisibility(user.RankingVisibilityHidden).Exec(ctx))
+
+	t.Run("hidden user", func(t *testing.T) {
+		require.Zero(t, rankHistory(t, auth.TokenInfo{UserID: viewer.ID, Scopes: []string{"user:read"}}))
+	})
+
+	t.Run("hidden user viewing themselves", func(t *testing.T) {
+		require.Equal(t, 1, rankHistory(t, auth.TokenInfo{UserID: student.ID, Scopes: []string{"user:read"}}))
+	})
+
+	t.Run("hidden user viewed by an administrator", func(t *testing.T) {
+		require.Equal(t, 1, rankHistory(t, auth.TokenInfo{UserID: viewer.ID, Scopes: []string{AdminScope}}))
+	})
+}
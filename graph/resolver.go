@@ -10,6 +10,8 @@ import (
 	"github.com/database-playground/backend-v2/graph/directive"
 	"github.com/database-playground/backend-v2/internal/auth"
 	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/insights"
+	"github.com/database-playground/backend-v2/internal/proctoring"
 	"github.com/database-playground/backend-v2/internal/ranking"
 	"github.com/database-playground/backend-v2/internal/sqlrunner"
 	"github.com/database-playground/backend-v2/internal/submission"
@@ -35,14 +37,18 @@ type Resolver struct {
 	eventService      *events.EventService
 	submissionService *submission.SubmissionService
 	rankingService    *ranking.Service
+	insightsService   *insights.Service
+
+	proctoringReporter *proctoring.Reporter
 }
 
 // NewResolver creates a new resolver.
-func NewResolver(ent *ent.Client, auth auth.Storage, sqlrunner *sqlrunner.SqlRunner, useraccount *useraccount.Context, eventService *events.EventService, submissionService *submission.SubmissionService, rankingService *ranking.Service) *Resolver {
-	return &Resolver{ent, auth, sqlrunner, useraccount, eventService, submissionService, rankingService}
+func NewResolver(ent *ent.Client, auth auth.Storage, sqlrunner *sqlrunner.SqlRunner, useraccount *useraccount.Context, eventService *events.EventService, submissionService *submission.SubmissionService, rankingService *ranking.Service, insightsService *insights.Service, proctoringReporter *proctoring.Reporter) *Resolver {
+	return &Resolver{ent, auth, sqlrunner, useraccount, eventService, submissionService, rankingService, insightsService, proctoringReporter}
 }
 
-// NewSchema creates a graphql executable schema.
+// NewSchema creates a graphql executable schema, with the fields weighted
+// for the complexity limit (see fieldCosts).
 func NewSchema(
 	ent *ent.Client,
 	auth auth.Storage,
@@ -51,13 +57,15 @@ func NewSchema(
 	eventService *events.EventService,
 	submissionService *submission.SubmissionService,
 	rankingService *ranking.Service,
+	insightsService *insights.Service,
+	proctoringReporter *proctoring.Reporter,
 ) graphql.ExecutableSchema {
-	return NewExecutableSchema(Config{
-		Resolvers: NewResolver(ent, auth, sqlrunner, useraccount, eventService, submissionService, rankingService),
+	return complexitySchema{NewExecutableSchema(Config{
+		Resolvers: NewResolver(ent, auth, sqlrunner, useraccount, eventService, submissionService, rankingService, insightsService, proctoringReporter),
 		Directives: DirectiveRoot{
 			Scope: directive.ScopeDirective,
 		},
-	})
+	})}
 }
 
 func (r *Resolver) EntClient(ctx context.Context) *ent.Client {
@@ -74,13 +82,18 @@ func NewErrorPresenter() graphql.ErrorPresenterFunc {
 
 		var gqlErr defs.GqlError
 		if errors.As(err, &gqlErr) {
+			extensions := map[string]any{
+				"code":     gqlErr.Code,
+				"trace_id": traceID,
+			}
+			for key, value := range gqlErr.Extensions {
+				extensions[key] = value
+			}
+
 			return &gqlerror.Error{
-				Message: gqlErr.Message,
-				Path:    graphql.GetPath(ctx),
-				Extensions: map[string]any{
-					"code":     gqlErr.Code,
-					"trace_id": traceID,
-				},
+				Message:    gqlErr.Message,
+				Path:       graphql.GetPath(ctx),
+				Extensions: extensions,
 			}
 		}
 
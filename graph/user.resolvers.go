@@ -9,24 +9,75 @@ import (
 	"context"
 	"errors"
 	"strconv"
-	"time"
 
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/event"
 	"github.com/database-playground/backend-v2/ent/group"
-	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/ent/predicate"
 	"github.com/database-playground/backend-v2/ent/scopeset"
+	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
 	"github.com/database-playground/backend-v2/graph/defs"
 	"github.com/database-playground/backend-v2/graph/model"
 	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/internal/graphql/audit"
 	"github.com/database-playground/backend-v2/internal/httputils"
 	"github.com/database-playground/backend-v2/internal/scope"
 	"github.com/database-playground/backend-v2/internal/useraccount"
+	"github.com/database-playground/backend-v2/models"
 	otelcodes "go.opentelemetry.io/otel/codes"
 )
 
+// Submissions is the resolver for the submissions field.
+func (r *cheatEvidenceResolver) Submissions(ctx context.Context, obj *models.CheatEvidence) ([]*ent.Submission, error) {
+	ctx, span := tracer.Start(ctx, "CheatEvidence.Submissions")
+	defer span.End()
+
+	if len(obj.SubmissionIDs) == 0 {
+		span.SetStatus(otelcodes.Ok, "No related submissions")
+		return []*ent.Submission{}, nil
+	}
+
+	submissions, err := r.EntClient(ctx).Submission.Query().
+		Where(submission.IDIn(obj.SubmissionIDs...)).
+		Order(ent.Asc(submission.FieldID)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query related submissions")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Related submissions retrieved successfully")
+	return submissions, nil
+}
+
+// Events is the resolver for the events field.
+func (r *cheatEvidenceResolver) Events(ctx context.Context, obj *models.CheatEvidence) ([]*ent.Event, error) {
+	ctx, span := tracer.Start(ctx, "CheatEvidence.Events")
+	defer span.End()
+
+	if len(obj.EventIDs) == 0 {
+		span.SetStatus(otelcodes.Ok, "No related events")
+		return []*ent.Event{}, nil
+	}
+
+	events, err := r.EntClient(ctx).Event.Query().
+		Where(event.IDIn(obj.EventIDs...)).
+		Order(ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query related events")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Related events retrieved successfully")
+	return events, nil
+}
+
 // UpdateMe is the resolver for the updateMe field.
 func (r *mutationResolver) UpdateMe(ctx context.Context, input ent.UpdateUserInput) (*ent.User, error) {
 	ctx, span := tracer.Start(ctx, "UpdateMe")
@@ -323,7 +374,7 @@ func (r *mutationResolver) CreatePoint(ctx context.Context, input ent.CreatePoin
 }
 
 // CreateCheatRecord is the resolver for the createCheatRecord field.
-func (r *mutationResolver) CreateCheatRecord(ctx context.Context, userID *int, reason string) (*ent.CheatRecord, error) {
+func (r *mutationResolver) CreateCheatRecord(ctx context.Context, userID *int, reason string, kind *cheatrecord.Kind, evidence *model.CheatEvidenceInput) (*ent.CheatRecord, error) {
 	ctx, span := tracer.Start(ctx, "CreateCheatRecord")
 	defer span.End()
 
@@ -346,6 +397,15 @@ func (r *mutationResolver) CreateCheatRecord(ctx context.Context, userID *int, r
 			}
 		}
 
+		// the other kinds are reserved for the detectors and the teachers.
+		if kind != nil && *kind != cheatrecord.KindManual && !scope.ShouldAllow("cheat_record:write", user.Scopes) {
+			span.SetStatus(otelcodes.Error, "You must have 'cheat_record:write' scope to write non-manual cheat record")
+			return nil, defs.GqlError{
+				Message: "You must have 'cheat_record:write' scope to write non-manual cheat record",
+				Code:    defs.CodeForbidden,
+			}
+		}
+
 		targetUserID = user.UserID
 	} else {
 		if !scope.ShouldAllow("cheat_record:write", user.Scopes) {
@@ -356,12 +416,30 @@ func (r *mutationResolver) CreateCheatRecord(ctx context.Context, userID *int, r
 			}
 		}
 
+		// the scope is checked here instead of the @scope directive, so the
+		// mutation is audited explicitly.
+		if err := audit.Record(ctx, "cheat_record:write"); err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to audit the mutation")
+			span.RecordError(err)
+			return nil, err
+		}
+
 		targetUserID = *userID
 	}
 
+	cheatEvidence, err := buildCheatEvidence(ctx, entClient, targetUserID, evidence)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid evidence")
+		span.RecordError(err)
+		return nil, err
+	}
+
 	cheatRecord, err := entClient.CheatRecord.Create().
 		SetUserID(targetUserID).
+		SetReporterID(user.UserID).
 		SetReason(reason).
+		SetNillableKind(kind).
+		SetEvidence(cheatEvidence).
 		Save(ctx)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to record cheat record")
@@ -378,21 +456,65 @@ func (r *mutationResolver) ResolveCheatRecord(ctx context.Context, cheatRecordID
 	ctx, span := tracer.Start(ctx, "ResolveCheatRecord")
 	defer span.End()
 
-	entClient := r.EntClient(ctx)
-
-	err := entClient.CheatRecord.UpdateOneID(cheatRecordID).
-		SetResolvedAt(time.Now()).
-		SetResolvedReason(reason).
-		Exec(ctx)
+	_, err := cheating.NewService(r.EntClient(ctx)).Dismiss(ctx, cheatRecordID, reason)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to resolve cheat record")
 		span.RecordError(err)
-		return false, err
+		return false, cheatRecordTransitionError(err)
 	}
 
+	span.SetStatus(otelcodes.Ok, "Cheat record resolved successfully")
 	return true, nil
 }
 
+// ReviewCheatRecord is the resolver for the reviewCheatRecord field.
+func (r *mutationResolver) ReviewCheatRecord(ctx context.Context, cheatRecordID int) (*ent.CheatRecord, error) {
+	ctx, span := tracer.Start(ctx, "ReviewCheatRecord")
+	defer span.End()
+
+	cheatRecord, err := cheating.NewService(r.EntClient(ctx)).Review(ctx, cheatRecordID)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to review cheat record")
+		span.RecordError(err)
+		return nil, cheatRecordTransitionError(err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "Cheat record under review")
+	return cheatRecord, nil
+}
+
+// ConfirmCheatRecord is the resolver for the confirmCheatRecord field.
+func (r *mutationResolver) ConfirmCheatRecord(ctx context.Context, cheatRecordID int, reason *string) (*ent.CheatRecord, error) {
+	ctx, span := tracer.Start(ctx, "ConfirmCheatRecord")
+	defer span.End()
+
+	cheatRecord, err := cheating.NewService(r.EntClient(ctx)).Confirm(ctx, cheatRecordID, reason)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to confirm cheat record")
+		span.RecordError(err)
+		return nil, cheatRecordTransitionError(err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "Cheat record confirmed successfully")
+	return cheatRecord, nil
+}
+
+// DismissCheatRecord is the resolver for the dismissCheatRecord field.
+func (r *mutationResolver) DismissCheatRecord(ctx context.Context, cheatRecordID int, reason string) (*ent.CheatRecord, error) {
+	ctx, span := tracer.Start(ctx, "DismissCheatRecord")
+	defer span.End()
+
+	cheatRecord, err := cheating.NewService(r.EntClient(ctx)).Dismiss(ctx, cheatRecordID, reason)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to dismiss cheat record")
+		span.RecordError(err)
+		return nil, cheatRecordTransitionError(err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "Cheat record dismissed successfully")
+	return cheatRecord, nil
+}
+
 // Me is the resolver for the me field.
 func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
 	ctx, span := tracer.Start(ctx, "Me")
@@ -558,19 +680,7 @@ func (r *userResolver) TotalPoints(ctx context.Context, obj *ent.User) (int, err
 	ctx, span := tracer.Start(ctx, "TotalPoints")
 	defer span.End()
 
-	// Check if the user has points
-	hasPoints, err := obj.QueryPoints().Exist(ctx)
-	if err != nil {
-		span.SetStatus(otelcodes.Error, "Failed to check if user has points")
-		span.RecordError(err)
-		return 0, err
-	}
-	if !hasPoints {
-		span.SetStatus(otelcodes.Ok, "User has no points")
-		return 0, nil
-	}
-
-	totalPoints, err := obj.QueryPoints().Aggregate(ent.Sum(point.FieldPoints)).Int(ctx)
+	totalPoints, err := r.Loaders(ctx).totalPoints.Load(ctx, obj.ID)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to calculate total points")
 		span.RecordError(err)
@@ -586,16 +696,21 @@ func (r *userResolver) Cheating(ctx context.Context, obj *ent.User) (bool, error
 	ctx, span := tracer.Start(ctx, "Cheating")
 	defer span.End()
 
-	cheatRecordsCount, err := obj.QueryCheatRecords().Where(cheatrecord.ResolvedAtIsNil()).Count(ctx)
+	isCheating, err := r.Loaders(ctx).cheating.Load(ctx, obj.ID)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to query cheat records")
 		span.RecordError(err)
 		return false, err
 	}
-	if cheatRecordsCount > 0 {
+	if isCheating {
 		span.SetStatus(otelcodes.Ok, "User has existing unresolved cheat records")
 		return true, nil
 	}
 	span.SetStatus(otelcodes.Ok, "User has no existing unresolved cheat records")
 	return false, nil
 }
+
+// CheatEvidence returns CheatEvidenceResolver implementation.
+func (r *Resolver) CheatEvidence() CheatEvidenceResolver { return &cheatEvidenceResolver{r} }
+
+type cheatEvidenceResolver struct{ *Resolver }
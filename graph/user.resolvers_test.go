@@ -11,10 +11,13 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/graph/defs"
 	"github.com/database-playground/backend-v2/graph/directive"
 	"github.com/database-playground/backend-v2/internal/auth"
 	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/insights"
+	"github.com/database-playground/backend-v2/internal/proctoring"
 	"github.com/database-playground/backend-v2/internal/ranking"
 	"github.com/database-playground/backend-v2/internal/submission"
 	"github.com/database-playground/backend-v2/internal/testhelper"
@@ -60,8 +63,10 @@ func NewTestResolver(t *testing.T, entClient *ent.Client, authStorage auth.Stora
 	submissionService := submission.NewSubmissionService(entClient, eventService, sqlrunner)
 	useraccountCtx := useraccount.NewContext(entClient, authStorage, eventService)
 	rankingService := ranking.NewService(entClient)
+	insightsService := insights.NewService(entClient)
+	proctoringReporter := proctoring.NewReporter(eventService)
 
-	return NewResolver(entClient, authStorage, sqlrunner, useraccountCtx, eventService, submissionService, rankingService)
+	return NewResolver(entClient, authStorage, sqlrunner, useraccountCtx, eventService, submissionService, rankingService, insightsService, proctoringReporter)
 }
 
 func TestMutationResolver_LogoutAll(t *testing.T) {
@@ -2149,6 +2154,60 @@ func TestMutationResolver_CreateCheatRecord(t *testing.T) {
 		require.Contains(t, err.Error(), defs.CodeForbidden)
 		require.Contains(t, err.Error(), "cheat_record:write")
 	})
+
+	t.Run("insufficient scope - create a non-manual cheat record for yourself", func(t *testing.T) {
+		entClient := testhelper.NewEntSqliteClient(t)
+
+		group, err := createTestGroup(t, entClient)
+		require.NoError(t, err)
+		user, err := entClient.User.Create().
+			SetName("testuser").
+			SetEmail("test@example.com").
+			SetGroup(group).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+		srv := handler.New(NewExecutableSchema(Config{
+			Resolvers:  resolver,
+			Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+		}))
+		srv.AddTransport(transport.POST{})
+		c := client.New(srv)
+
+		var resp struct {
+			CreateCheatRecord struct {
+				ID   string
+				Kind string
+			}
+		}
+		asUser := func(scopes ...string) client.Option {
+			return func(bd *client.Request) {
+				bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+					UserID: user.ID,
+					Scopes: scopes,
+				}))
+			}
+		}
+
+		// a student can not forge a detected record
+		err = c.Post(`mutation { createCheatRecord(reason: "Test reason", kind: plagiarism) { id kind } }`, &resp, asUser("me:write"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), defs.CodeForbidden)
+		require.Contains(t, err.Error(), "cheat_record:write")
+
+		exists, err := entClient.CheatRecord.Query().Exist(context.Background())
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		err = c.Post(`mutation { createCheatRecord(reason: "Test reason", kind: manual) { id kind } }`, &resp, asUser("me:write"))
+		require.NoError(t, err)
+		require.Equal(t, "manual", resp.CreateCheatRecord.Kind)
+
+		err = c.Post(`mutation { createCheatRecord(reason: "Test reason", kind: tab_switch) { id kind } }`, &resp, asUser("me:write", "cheat_record:write"))
+		require.NoError(t, err)
+		require.Equal(t, "tab_switch", resp.CreateCheatRecord.Kind)
+	})
 }
 
 func TestMutationResolver_ResolveCheatRecord(t *testing.T) {
@@ -2205,6 +2264,7 @@ func TestMutationResolver_ResolveCheatRecord(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, resolvedRecord.ResolvedAt)
 		require.Equal(t, "Resolved - false alarm", resolvedRecord.ResolvedReason)
+		require.Equal(t, cheatrecord.StateDismissed, resolvedRecord.State)
 	})
 
 	t.Run("unauthenticated", func(t *testing.T) {
@@ -2330,3 +2390,112 @@ func TestMutationResolver_ResolveCheatRecord(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestMutationResolver_CheatRecordWorkflow(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+
+	group, err := createTestGroup(t, entClient)
+	require.NoError(t, err)
+
+	teacher, err := entClient.User.Create().
+		SetName("teacher").
+		SetEmail("teacher@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+	student, err := entClient.User.Create().
+		SetName("student").
+		SetEmail("student@example.com").
+		SetGroup(group).
+		Save(ctx)
+	require.NoError(t, err)
+
+	studentEvent, err := entClient.Event.Create().
+		SetUser(student).
+		SetType("tab_switch").
+		Save(ctx)
+	require.NoError(t, err)
+	teacherEvent, err := entClient.Event.Create().
+		SetUser(teacher).
+		SetType("tab_switch").
+		Save(ctx)
+	require.NoError(t, err)
+
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	cfg := Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}
+	srv := handler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	asTeacher := func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+			UserID: teacher.ID,
+			Scopes: []string{"cheat_record:write", "cheat_record:read", "user:read"},
+		}))
+	}
+
+	t.Run("evidence of another user", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`mutation { createCheatRecord(userID: `+strconv.Itoa(student.ID)+`, reason: "Tab switched", evidence: { eventIDs: [`+strconv.Itoa(teacherEvent.ID)+`] }) { id } }`, &resp, asTeacher)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), defs.CodeInvalidInput)
+	})
+
+	var created struct {
+		CreateCheatRecord struct {
+			ID       string
+			Kind     string
+			State    string
+			Reporter struct {
+				ID string
+			}
+			Evidence struct {
+				Events []struct {
+					ID string
+				}
+			}
+		}
+	}
+	err = c.Post(`mutation { createCheatRecord(userID: `+strconv.Itoa(student.ID)+`, reason: "Tab switched", kind: tab_switch, evidence: { eventIDs: [`+strconv.Itoa(studentEvent.ID)+`] }) { id kind state reporter { id } evidence { events { id } } } }`, &created, asTeacher)
+	require.NoError(t, err)
+	require.Equal(t, "tab_switch", created.CreateCheatRecord.Kind)
+	require.Equal(t, "open", created.CreateCheatRecord.State)
+	require.Equal(t, strconv.Itoa(teacher.ID), created.CreateCheatRecord.Reporter.ID)
+	require.Len(t, created.CreateCheatRecord.Evidence.Events, 1)
+	require.Equal(t, strconv.Itoa(studentEvent.ID), created.CreateCheatRecord.Evidence.Events[0].ID)
+
+	id := created.CreateCheatRecord.ID
+
+	var reviewed struct {
+		ReviewCheatRecord struct {
+			State      string
+			ReviewedAt *string
+		}
+	}
+	err = c.Post(`mutation { reviewCheatRecord(cheatRecordID: `+id+`) { state reviewedAt } }`, &reviewed, asTeacher)
+	require.NoError(t, err)
+	require.Equal(t, "under_review", reviewed.ReviewCheatRecord.State)
+	require.NotNil(t, reviewed.ReviewCheatRecord.ReviewedAt)
+
+	var confirmed struct {
+		ConfirmCheatRecord struct {
+			State          string
+			ResolvedReason *string
+		}
+	}
+	err = c.Post(`mutation { confirmCheatRecord(cheatRecordID: `+id+`, reason: "Confirmed with the student") { state resolvedReason } }`, &confirmed, asTeacher)
+	require.NoError(t, err)
+	require.Equal(t, "confirmed", confirmed.ConfirmCheatRecord.State)
+	require.NotNil(t, confirmed.ConfirmCheatRecord.ResolvedReason)
+	require.Equal(t, "Confirmed with the student", *confirmed.ConfirmCheatRecord.ResolvedReason)
+
+	// a confirmed record cannot be dismissed
+	var dismissed struct{}
+	err = c.Post(`mutation { dismissCheatRecord(cheatRecordID: `+id+`, reason: "Mistake") { state } }`, &dismissed, asTeacher)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), defs.CodeInvalidInput)
+}
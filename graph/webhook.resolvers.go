@@ -0,0 +1,111 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"slices"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/ent/webhooksubscription"
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/webhook"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
+func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input ent.CreateWebhookSubscriptionInput) (*ent.WebhookSubscription, error) {
+	ctx, span := tracer.Start(ctx, "CreateWebhookSubscription")
+	defer span.End()
+
+	if err := webhook.ValidateEventTypes(input.EventTypes); err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid webhook subscription")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+
+	entClient := r.EntClient(ctx)
+
+	subscription, err := entClient.WebhookSubscription.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			span.SetStatus(otelcodes.Error, "Invalid webhook subscription")
+			return nil, defs.NewErrInvalidInput(err.Error())
+		}
+		span.SetStatus(otelcodes.Error, "Failed to create webhook subscription")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhook subscription created successfully")
+	return subscription, nil
+}
+
+// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
+func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, id int, input ent.UpdateWebhookSubscriptionInput) (*ent.WebhookSubscription, error) {
+	ctx, span := tracer.Start(ctx, "UpdateWebhookSubscription")
+	defer span.End()
+
+	if err := webhook.ValidateEventTypes(slices.Concat(input.EventTypes, input.AppendEventTypes)); err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid webhook subscription")
+		return nil, defs.NewErrInvalidInput(err.Error())
+	}
+
+	entClient := r.EntClient(ctx)
+
+	subscription, err := entClient.WebhookSubscription.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			span.SetStatus(otelcodes.Error, "Webhook subscription not found")
+			return nil, defs.ErrNotFound
+		}
+		if ent.IsValidationError(err) {
+			span.SetStatus(otelcodes.Error, "Invalid webhook subscription")
+			return nil, defs.NewErrInvalidInput(err.Error())
+		}
+		span.SetStatus(otelcodes.Error, "Failed to update webhook subscription")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhook subscription updated successfully")
+	return subscription, nil
+}
+
+// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
+func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, id int) (bool, error) {
+	ctx, span := tracer.Start(ctx, "DeleteWebhookSubscription")
+	defer span.End()
+
+	entClient := r.EntClient(ctx)
+
+	// The deliveries reference the subscription, so they are deleted first
+	// in the transaction of the mutation.
+	span.AddEvent("database.webhook_delivery.delete")
+	_, err := entClient.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasSubscriptionWith(webhooksubscription.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to delete webhook deliveries")
+		span.RecordError(err)
+		return false, err
+	}
+
+	span.AddEvent("database.webhook_subscription.delete")
+	err = entClient.WebhookSubscription.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			span.SetStatus(otelcodes.Error, "Webhook subscription not found")
+			return false, defs.ErrNotFound
+		}
+		span.SetStatus(otelcodes.Error, "Failed to delete webhook subscription")
+		span.RecordError(err)
+		return false, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhook subscription deleted successfully")
+	return true, nil
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/graph/directive"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMutationResolver_WebhookSubscription(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	resolver := NewTestResolver(t, entClient, &mockAuthStorage{})
+	cfg := Config{
+		Resolvers:  resolver,
+		Directives: DirectiveRoot{Scope: directive.ScopeDirective},
+	}
+	srv := handler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: entClient})
+	gqlClient := client.New(srv)
+
+	ctx := context.Background()
+	withWriteScope := func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+			UserID: 1,
+			Scopes: []string{"webhook:write"},
+		}))
+	}
+
+	// Create
+	var createResp struct {
+		CreateWebhookSubscription struct {
+			ID         string
+			Name       string
+			URL        string
+			EventTypes []string
+			Enabled    bool
+		}
+	}
+	err := gqlClient.Post(`mutation {
+		createWebhookSubscription(input: {name: "hook", url: "https://example.com/hook", secret: "secret", eventTypes: ["login"]}) {
+			id name url eventTypes enabled
+		}
+	}`, &createResp, withWriteScope)
+	require.NoError(t, err)
+	created := createResp.CreateWebhookSubscription
+	require.Equal(t, "hook", created.Name)
+	require.Equal(t, []string{"login"}, created.EventTypes)
+	require.True(t, created.Enabled)
+
+	id, err := strconv.Atoi(created.ID)
+	require.NoError(t, err)
+
+	// An invalid URL is rejected
+	err = gqlClient.Post(`mutation {
+		createWebhookSubscription(input: {name: "hook", url: "ftp://example.com", secret: "secret"}) { id }
+	}`, &createResp, withWriteScope)
+	require.Error(t, err)
+
+	// An unknown event type is rejected
+	err = gqlClient.Post(`mutation {
+		createWebhookSubscription(input: {name: "hook", url: "https://example.com/hook", secret: "secret", eventTypes: ["log_in"]}) { id }
+	}`, &createResp, withWriteScope)
+	require.ErrorContains(t, err, "unknown event type")
+
+	// Update
+	var updateResp struct {
+		UpdateWebhookSubscription struct {
+			Name    string
+			Enabled bool
+		}
+	}
+	err = gqlClient.Post(`mutation($id: ID!) {
+		updateWebhookSubscription(id: $id, input: {name: "renamed", enabled: false}) { name enabled }
+	}`, &updateResp, client.Var("id", id), withWriteScope)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", updateResp.UpdateWebhookSubscription.Name)
+	require.False(t, updateResp.UpdateWebhookSubscription.Enabled)
+
+	err = gqlClient.Post(`mutation($id: ID!) {
+		updateWebhookSubscription(id: $id, input: {appendEventTypes: ["log_out"]}) { name }
+	}`, &updateResp, client.Var("id", id), withWriteScope)
+	require.ErrorContains(t, err, "unknown event type")
+
+	// Delete a subscription with deliveries
+	_, err = entClient.WebhookDelivery.Create().
+		SetSubscriptionID(id).
+		SetEventID(1).
+		SetEventType("login").
+		SetAttempt(1).
+		SetStatus(webhookdelivery.StatusSucceeded).
+		SetDurationMs(10).
+		Save(ctx)
+	require.NoError(t, err)
+
+	var deleteResp struct {
+		DeleteWebhookSubscription bool
+	}
+	err = gqlClient.Post(`mutation($id: ID!) { deleteWebhookSubscription(id: $id) }`,
+		&deleteResp, client.Var("id", id), withWriteScope)
+	require.NoError(t, err)
+	require.True(t, deleteResp.DeleteWebhookSubscription)
+
+	exists, err := entClient.WebhookSubscription.Query().Exist(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+	deliveries, err := entClient.WebhookDelivery.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, deliveries)
+
+	// Delete a missing subscription
+	err = gqlClient.Post(`mutation($id: ID!) { deleteWebhookSubscription(id: $id) }`,
+		&deleteResp, client.Var("id", id), withWriteScope)
+	require.Error(t, err)
+
+	// The mutations require the webhook:write scope
+	err = gqlClient.Post(`mutation {
+		createWebhookSubscription(input: {name: "hook", url: "https://example.com/hook", secret: "secret"}) { id }
+	}`, &createResp, func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithUser(bd.HTTP.Context(), auth.TokenInfo{
+			UserID: 1,
+			Scopes: []string{"webhook:read"},
+		}))
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,159 @@
+// Package analytics provides the sinks to send the product analytics events to.
+package analytics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Event is a product analytics event.
+type Event struct {
+	// DistinctID identifies the user who triggered the event.
+	DistinctID string
+	// Name is the name of the event, e.g. the event type.
+	Name       string
+	Timestamp  time.Time
+	Properties map[string]any
+}
+
+// Exception is an error happened while serving a user.
+type Exception struct {
+	DistinctID  string
+	Title       string
+	Description string
+	Timestamp   time.Time
+}
+
+// Sink receives the analytics events.
+//
+// The implementations should not block for long, since the events
+// are captured inline; the remote sinks should batch the events.
+type Sink interface {
+	// Capture sends an event to the sink.
+	Capture(ctx context.Context, event Event) error
+	// CaptureException sends an exception to the sink.
+	CaptureException(ctx context.Context, exception Exception) error
+	// Close flushes the pending events and releases the resources.
+	Close() error
+}
+
+// NoopSink discards every event.
+type NoopSink struct{}
+
+func (NoopSink) Capture(context.Context, Event) error { return nil }
+
+func (NoopSink) CaptureException(context.Context, Exception) error { return nil }
+
+func (NoopSink) Close() error { return nil }
+
+// MultiSink sends the events to every sink.
+type MultiSink []Sink
+
+// NewMultiSink creates a sink which fans out the events to the sinks.
+//
+// It returns a NoopSink if there is no sink, and the sink itself if there is only one.
+func NewMultiSink(sinks ...Sink) Sink {
+	switch len(sinks) {
+	case 0:
+		return NoopSink{}
+	case 1:
+		return sinks[0]
+	default:
+		return MultiSink(sinks)
+	}
+}
+
+func (m MultiSink) Capture(ctx context.Context, event Event) error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.Capture(ctx, event))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MultiSink) CaptureException(ctx context.Context, exception Exception) error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.CaptureException(ctx, exception))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m MultiSink) Close() error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// RedactedValue replaces the values of the redacted properties.
+const RedactedValue = "[REDACTED]"
+
+// redactSink redacts the properties before sending the events to the underlying sink.
+type redactSink struct {
+	Sink
+
+	fields map[string]struct{}
+}
+
+// Redact wraps the sink to replace the values of the given property names
+// with RedactedValue, including the ones in the nested maps.
+// The names are matched case-insensitively.
+//
+// The descriptions of the exceptions are free text which can contain the
+// same values (e.g. an error message with the email), so they are replaced
+// with RedactedValue as a whole.
+//
+// It returns the sink itself if there is no field to redact.
+func Redact(sink Sink, fields ...string) Sink {
+	if len(fields) == 0 {
+		return sink
+	}
+
+	set := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		set[strings.ToLower(field)] = struct{}{}
+	}
+
+	return &redactSink{Sink: sink, fields: set}
+}
+
+func (r *redactSink) Capture(ctx context.Context, event Event) error {
+	event.Properties = r.redact(event.Properties)
+	return r.Sink.Capture(ctx, event)
+}
+
+func (r *redactSink) CaptureException(ctx context.Context, exception Exception) error {
+	if exception.Description != "" {
+		exception.Description = RedactedValue
+	}
+	return r.Sink.CaptureException(ctx, exception)
+}
+
+// redact returns a copy of the properties with the fields redacted.
+func (r *redactSink) redact(properties map[string]any) map[string]any {
+	if properties == nil {
+		return nil
+	}
+
+	redacted := make(map[string]any, len(properties))
+	for key, value := range properties {
+		if _, ok := r.fields[strings.ToLower(key)]; ok {
+			redacted[key] = RedactedValue
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			value = r.redact(nested)
+		}
+		redacted[key] = value
+	}
+
+	return redacted
+}
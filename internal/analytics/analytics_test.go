@@ -0,0 +1,226 @@
+package analytics_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/internal/analytics"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// recordingSink records the captured events.
+type recordingSink struct {
+	events     []analytics.Event
+	exceptions []analytics.Exception
+	closed     bool
+	err        error
+}
+
+func (s *recordingSink) Capture(_ context.Context, event analytics.Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *recordingSink) CaptureException(_ context.Context, exception analytics.Exception) error {
+	s.exceptions = append(s.exceptions, exception)
+	return s.err
+}
+
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return s.err
+}
+
+func TestRedact(t *testing.T) {
+	sink := &recordingSink{}
+	redacted := analytics.Redact(sink, "email", "Name")
+
+	properties := map[string]any{
+		"email":  "test@example.com",
+		"points": 20,
+		"user": map[string]any{
+			"name": "Test User",
+			"id":   1,
+		},
+	}
+
+	err := redacted.Capture(context.Background(), analytics.Event{
+		DistinctID: "1",
+		Name:       "login",
+		Properties: properties,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sink.events, 1)
+	require.Equal(t, map[string]any{
+		"email":  analytics.RedactedValue,
+		"points": 20,
+		"user": map[string]any{
+			"name": analytics.RedactedValue,
+			"id":   1,
+		},
+	}, sink.events[0].Properties)
+
+	// The original properties are not modified.
+	require.Equal(t, "test@example.com", properties["email"])
+	require.Equal(t, "Test User", properties["user"].(map[string]any)["name"])
+
+	// The descriptions of the exceptions are redacted, and Close is passed through.
+	require.NoError(t, redacted.CaptureException(context.Background(), analytics.Exception{
+		DistinctID:  "1",
+		Title:       "failed",
+		Description: "user test@example.com not found",
+	}))
+	require.Len(t, sink.exceptions, 1)
+	require.Equal(t, "failed", sink.exceptions[0].Title)
+	require.Equal(t, analytics.RedactedValue, sink.exceptions[0].Description)
+	require.NoError(t, redacted.Close())
+	require.True(t, sink.closed)
+}
+
+func TestRedact_NoFields(t *testing.T) {
+	sink := &recordingSink{}
+	require.Same(t, sink, analytics.Redact(sink))
+}
+
+func TestMultiSink(t *testing.T) {
+	require.Equal(t, analytics.NoopSink{}, analytics.NewMultiSink())
+
+	first := &recordingSink{}
+	require.Same(t, first, analytics.NewMultiSink(first))
+
+	second := &recordingSink{err: errors.New("sink failed")}
+	multi := analytics.NewMultiSink(first, second)
+
+	err := multi.Capture(context.Background(), analytics.Event{Name: "login"})
+	require.ErrorContains(t, err, "sink failed")
+	require.Len(t, first.events, 1)
+	require.Len(t, second.events, 1)
+
+	err = multi.CaptureException(context.Background(), analytics.Exception{Title: "failed"})
+	require.Error(t, err)
+	require.Len(t, first.exceptions, 1)
+	require.Len(t, second.exceptions, 1)
+
+	require.Error(t, multi.Close())
+	require.True(t, first.closed)
+	require.True(t, second.closed)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "analytics.jsonl")
+	ctx := context.Background()
+	timestamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sink, err := analytics.NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Capture(ctx, analytics.Event{
+		DistinctID: "1",
+		Name:       "login",
+		Timestamp:  timestamp,
+		Properties: map[string]any{"machine": "test"},
+	}))
+	require.NoError(t, sink.CaptureException(ctx, analytics.Exception{
+		DistinctID:  "1",
+		Title:       "failed to grant point",
+		Description: "database is down",
+		Timestamp:   timestamp,
+	}))
+	require.NoError(t, sink.Close())
+
+	// Reopening the file appends to it.
+	sink, err = analytics.NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Capture(ctx, analytics.Event{DistinctID: "2", Name: "logout", Timestamp: timestamp}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var records []analytics.FileRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record analytics.FileRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Equal(t, []analytics.FileRecord{
+		{Kind: "event", DistinctID: "1", Name: "login", Timestamp: timestamp, Properties: map[string]any{"machine": "test"}},
+		{Kind: "exception", DistinctID: "1", Name: "failed to grant point", Timestamp: timestamp, Description: "database is down"},
+		{Kind: "event", DistinctID: "2", Name: "logout", Timestamp: timestamp},
+	}, records)
+}
+
+// memoryExporter keeps the exported log records in memory.
+type memoryExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *memoryExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, record := range records {
+		e.records = append(e.records, record.Clone())
+	}
+	return nil
+}
+
+func (e *memoryExporter) Shutdown(context.Context) error { return nil }
+
+func (e *memoryExporter) ForceFlush(context.Context) error { return nil }
+
+func TestOTLPSink(t *testing.T) {
+	exporter := &memoryExporter{}
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exporter)))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	sink := analytics.NewOTLPSink(analytics.WithLoggerProvider(provider))
+	ctx := context.Background()
+
+	require.NoError(t, sink.Capture(ctx, analytics.Event{
+		DistinctID: "1",
+		Name:       "submit_answer",
+		Timestamp:  time.Now(),
+		Properties: map[string]any{"question_id": 1, "correct": true},
+	}))
+	require.NoError(t, sink.CaptureException(ctx, analytics.Exception{
+		DistinctID:  "1",
+		Title:       "failed to grant point",
+		Description: "database is down",
+		Timestamp:   time.Now(),
+	}))
+
+	require.Len(t, exporter.records, 2)
+
+	event := exporter.records[0]
+	require.Equal(t, "submit_answer", event.EventName())
+	require.Equal(t, log.SeverityInfo, event.Severity())
+
+	attributes := make(map[string]log.Value)
+	event.WalkAttributes(func(kv log.KeyValue) bool {
+		attributes[kv.Key] = kv.Value
+		return true
+	})
+	require.Equal(t, "1", attributes["distinct_id"].AsString())
+	require.EqualValues(t, 1, attributes["question_id"].AsInt64())
+	require.True(t, attributes["correct"].AsBool())
+
+	exception := exporter.records[1]
+	require.Equal(t, log.SeverityError, exception.Severity())
+	require.Equal(t, "failed to grant point", exception.Body().AsString())
+}
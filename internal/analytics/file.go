@@ -0,0 +1,78 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileSink appends the events to a file as JSON lines.
+//
+// Every line is a FileRecord.
+type FileSink struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// FileRecord is a line written by FileSink.
+type FileRecord struct {
+	// Kind is "event" or "exception".
+	Kind        string         `json:"kind"`
+	DistinctID  string         `json:"distinct_id"`
+	Name        string         `json:"name,omitempty"`
+	Timestamp   time.Time      `json:"timestamp"`
+	Properties  map[string]any `json:"properties,omitempty"`
+	Description string         `json:"description,omitempty"`
+}
+
+// NewFileSink opens (or creates) the file at path for appending.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open analytics file: %w", err)
+	}
+
+	return &FileSink{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+func (s *FileSink) Capture(_ context.Context, event Event) error {
+	return s.write(FileRecord{
+		Kind:       "event",
+		DistinctID: event.DistinctID,
+		Name:       event.Name,
+		Timestamp:  event.Timestamp,
+		Properties: event.Properties,
+	})
+}
+
+func (s *FileSink) CaptureException(_ context.Context, exception Exception) error {
+	return s.write(FileRecord{
+		Kind:        "exception",
+		DistinctID:  exception.DistinctID,
+		Name:        exception.Title,
+		Timestamp:   exception.Timestamp,
+		Description: exception.Description,
+	})
+}
+
+func (s *FileSink) write(record FileRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.encoder.Encode(record); err != nil {
+		return fmt.Errorf("write analytics record: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// OTLPSink emits the events as OpenTelemetry log records.
+//
+// The records are exported by the logger provider, which is
+// the global one set up by otelprovider by default.
+type OTLPSink struct {
+	logger log.Logger
+}
+
+type OTLPSinkOption func(*otlpSinkOptions)
+
+type otlpSinkOptions struct {
+	provider log.LoggerProvider
+}
+
+// WithLoggerProvider sets the logger provider to emit the records with.
+func WithLoggerProvider(provider log.LoggerProvider) OTLPSinkOption {
+	return func(o *otlpSinkOptions) {
+		o.provider = provider
+	}
+}
+
+// NewOTLPSink creates a new OTLPSink.
+func NewOTLPSink(opts ...OTLPSinkOption) *OTLPSink {
+	options := otlpSinkOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.provider == nil {
+		options.provider = global.GetLoggerProvider()
+	}
+
+	return &OTLPSink{logger: options.provider.Logger("dbplay.analytics")}
+}
+
+func (s *OTLPSink) Capture(ctx context.Context, event Event) error {
+	var record log.Record
+	record.SetEventName(event.Name)
+	record.SetTimestamp(event.Timestamp)
+	record.SetSeverity(log.SeverityInfo)
+	record.SetBody(log.StringValue(event.Name))
+	record.AddAttributes(log.String("distinct_id", event.DistinctID))
+	for key, value := range event.Properties {
+		record.AddAttributes(log.KeyValue{Key: key, Value: logValue(value)})
+	}
+
+	s.logger.Emit(ctx, record)
+	return nil
+}
+
+func (s *OTLPSink) CaptureException(ctx context.Context, exception Exception) error {
+	var record log.Record
+	record.SetEventName("exception")
+	record.SetTimestamp(exception.Timestamp)
+	record.SetSeverity(log.SeverityError)
+	record.SetBody(log.StringValue(exception.Title))
+	record.AddAttributes(
+		log.String("distinct_id", exception.DistinctID),
+		log.String("exception.message", exception.Description),
+	)
+
+	s.logger.Emit(ctx, record)
+	return nil
+}
+
+// Close does nothing; the logger provider is shut down by its owner.
+func (s *OTLPSink) Close() error {
+	return nil
+}
+
+// logValue converts a property value to a log value.
+func logValue(value any) log.Value {
+	switch v := value.(type) {
+	case nil:
+		return log.Value{}
+	case string:
+		return log.StringValue(v)
+	case bool:
+		return log.BoolValue(v)
+	case int:
+		return log.IntValue(v)
+	case int64:
+		return log.Int64Value(v)
+	case float64:
+		return log.Float64Value(v)
+	case []any:
+		values := make([]log.Value, len(v))
+		for i, item := range v {
+			values[i] = logValue(item)
+		}
+		return log.SliceValue(values...)
+	case map[string]any:
+		kvs := make([]log.KeyValue, 0, len(v))
+		for key, item := range v {
+			kvs = append(kvs, log.KeyValue{Key: key, Value: logValue(item)})
+		}
+		return log.MapValue(kvs...)
+	default:
+		return log.StringValue(fmt.Sprint(v))
+	}
+}
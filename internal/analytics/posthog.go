@@ -0,0 +1,39 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/posthog/posthog-go"
+)
+
+// PostHogSink sends the events to PostHog.
+type PostHogSink struct {
+	client posthog.Client
+}
+
+// NewPostHogSink creates a sink sending the events with the PostHog client.
+//
+// The sink owns the client and closes it on Close.
+func NewPostHogSink(client posthog.Client) *PostHogSink {
+	return &PostHogSink{client: client}
+}
+
+func (s *PostHogSink) Capture(_ context.Context, event Event) error {
+	return s.client.Enqueue(posthog.Capture{
+		DistinctId: event.DistinctID,
+		Event:      event.Name,
+		Timestamp:  event.Timestamp,
+		Properties: event.Properties,
+	})
+}
+
+func (s *PostHogSink) CaptureException(_ context.Context, exception Exception) error {
+	return s.client.Enqueue(posthog.NewDefaultException(
+		exception.Timestamp, exception.DistinctID,
+		exception.Title, exception.Description,
+	))
+}
+
+func (s *PostHogSink) Close() error {
+	return s.client.Close()
+}
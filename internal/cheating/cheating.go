@@ -0,0 +1,150 @@
+// Package cheating manages the review workflow of the cheat records.
+package cheating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.cheating")
+
+// ErrInvalidTransition is returned when a cheat record cannot be moved to the requested state.
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// transitions are the states a cheat record can be moved to from each state.
+// The confirmed and dismissed states are final.
+var transitions = map[cheatrecord.State][]cheatrecord.State{
+	cheatrecord.StatePending:     {cheatrecord.StateConfirmed, cheatrecord.StateDismissed},
+	cheatrecord.StateOpen:        {cheatrecord.StateUnderReview, cheatrecord.StateConfirmed, cheatrecord.StateDismissed},
+	cheatrecord.StateUnderReview: {cheatrecord.StateConfirmed, cheatrecord.StateDismissed},
+}
+
+// CanTransition returns whether a cheat record in the state from can be moved to the state to.
+func CanTransition(from, to cheatrecord.State) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// Counted returns the predicate of the cheat records counted as cheating:
+// the confirmed, open and under-review records. The pending records wait for
+// a teacher to confirm them, and the records with a resolution time but no
+// final state (which are resolved before the states are introduced) are not counted.
+func Counted() predicate.CheatRecord {
+	return cheatrecord.Or(
+		cheatrecord.StateEQ(cheatrecord.StateConfirmed),
+		cheatrecord.And(
+			cheatrecord.StateIn(cheatrecord.StateOpen, cheatrecord.StateUnderReview),
+			cheatrecord.ResolvedAtIsNil(),
+		),
+	)
+}
+
+// Service moves the cheat records through the review workflow:
+//
+//	open → under_review → confirmed / dismissed
+//	pending → confirmed / dismissed
+//
+// An open record can also be confirmed or dismissed directly. The records
+// created by a detector start pending.
+type Service struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// Option configures a Service.
+type Option func(*Service)
+
+// WithClock sets the function returning the current time, for the audit timestamps.
+func WithClock(now func() time.Time) Option {
+	return func(s *Service) {
+		s.now = now
+	}
+}
+
+// NewService creates a new cheat record service.
+func NewService(client *ent.Client, opts ...Option) *Service {
+	s := &Service{client: client, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Review moves the cheat record to under_review and records the review time.
+func (s *Service) Review(ctx context.Context, id int) (*ent.CheatRecord, error) {
+	return s.transition(ctx, id, cheatrecord.StateUnderReview, func(u *ent.CheatRecordUpdateOne) {
+		u.SetReviewedAt(s.now())
+	})
+}
+
+// Confirm confirms the cheat record, so it counts as cheating even if it is
+// created as pending by a detector. reason is recorded as the resolved reason if set.
+func (s *Service) Confirm(ctx context.Context, id int, reason *string) (*ent.CheatRecord, error) {
+	return s.transition(ctx, id, cheatrecord.StateConfirmed, func(u *ent.CheatRecordUpdateOne) {
+		u.SetResolvedAt(s.now()).
+			SetNillableResolvedReason(reason)
+	})
+}
+
+// Dismiss dismisses the cheat record with the reason, so it does not count as cheating.
+func (s *Service) Dismiss(ctx context.Context, id int, reason string) (*ent.CheatRecord, error) {
+	return s.transition(ctx, id, cheatrecord.StateDismissed, func(u *ent.CheatRecordUpdateOne) {
+		u.SetResolvedAt(s.now()).
+			SetResolvedReason(reason)
+	})
+}
+
+// transition moves the cheat record to the state if it is allowed from its
+// current state. The update fails with ErrInvalidTransition if the state of
+// the record is changed concurrently.
+func (s *Service) transition(ctx context.Context, id int, to cheatrecord.State, update func(*ent.CheatRecordUpdateOne)) (*ent.CheatRecord, error) {
+	ctx, span := tracer.Start(ctx, "Transition",
+		trace.WithAttributes(
+			attribute.Int("cheat_record.id", id),
+			attribute.String("cheat_record.to", to.String()),
+		))
+	defer span.End()
+
+	record, err := s.client.CheatRecord.Get(ctx, id)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get cheat record")
+		span.RecordError(err)
+		return nil, fmt.Errorf("get cheat record: %w", err)
+	}
+	span.SetAttributes(attribute.String("cheat_record.from", record.State.String()))
+
+	if !CanTransition(record.State, to) {
+		span.SetStatus(otelcodes.Error, "Invalid transition")
+		return nil, fmt.Errorf("%w: %s → %s", ErrInvalidTransition, record.State, to)
+	}
+
+	u := record.Update().
+		Where(cheatrecord.StateEQ(record.State)).
+		SetState(to)
+	update(u)
+
+	record, err = u.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			span.SetStatus(otelcodes.Error, "State changed concurrently")
+			return nil, fmt.Errorf("%w: the state has been changed", ErrInvalidTransition)
+		}
+		span.SetStatus(otelcodes.Error, "Failed to update cheat record")
+		span.RecordError(err)
+		return nil, fmt.Errorf("update cheat record: %w", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "Cheat record transitioned successfully")
+	return record, nil
+}
@@ -0,0 +1,156 @@
+package cheating_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setup(t *testing.T) (*ent.Client, *ent.User) {
+	t.Helper()
+	ctx := context.Background()
+	client := testhelper.NewEntSqliteClient(t)
+
+	g, err := client.Group.Create().SetName("Class A").Save(ctx)
+	require.NoError(t, err)
+	u, err := client.User.Create().
+		SetName("User").
+		SetEmail("user@example.com").
+		SetGroup(g).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return client, u
+}
+
+func TestCanTransition(t *testing.T) {
+	testCases := []struct {
+		from, to cheatrecord.State
+		expected bool
+	}{
+		{cheatrecord.StateOpen, cheatrecord.StateUnderReview, true},
+		{cheatrecord.StateOpen, cheatrecord.StateConfirmed, true},
+		{cheatrecord.StateOpen, cheatrecord.StateDismissed, true},
+		{cheatrecord.StateUnderReview, cheatrecord.StateConfirmed, true},
+		{cheatrecord.StateUnderReview, cheatrecord.StateDismissed, true},
+		{cheatrecord.StateUnderReview, cheatrecord.StateOpen, false},
+		{cheatrecord.StatePending, cheatrecord.StateConfirmed, true},
+		{cheatrecord.StatePending, cheatrecord.StateDismissed, true},
+		{cheatrecord.StatePending, cheatrecord.StateUnderReview, false},
+		{cheatrecord.StatePending, cheatrecord.StateOpen, false},
+		{cheatrecord.StateUnderReview, cheatrecord.StateUnderReview, false},
+		{cheatrecord.StateConfirmed, cheatrecord.StateDismissed, false},
+		{cheatrecord.StateDismissed, cheatrecord.StateConfirmed, false},
+		{cheatrecord.StateDismissed, cheatrecord.StateOpen, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.from.String()+"→"+tc.to.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, cheating.CanTransition(tc.from, tc.to))
+		})
+	}
+}
+
+func TestService_Workflow(t *testing.T) {
+	client, u := setup(t)
+	ctx := context.Background()
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := cheating.NewService(client, cheating.WithClock(func() time.Time { return now }))
+
+	record, err := client.CheatRecord.Create().
+		SetUser(u).
+		SetReason("Copied the answer").
+		Save(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, cheatrecord.StateOpen, record.State)
+
+	record, err = service.Review(ctx, record.ID)
+	require.NoError(t, err)
+	assert.Equal(t, cheatrecord.StateUnderReview, record.State)
+	assert.True(t, record.ReviewedAt.Equal(now))
+
+	now = now.Add(time.Hour)
+	reason := "Same solution submitted within a minute"
+	record, err = service.Confirm(ctx, record.ID, &reason)
+	require.NoError(t, err)
+	assert.Equal(t, cheatrecord.StateConfirmed, record.State)
+	assert.True(t, record.ResolvedAt.Equal(now))
+	assert.Equal(t, reason, record.ResolvedReason)
+
+	// the confirmed state is final
+	_, err = service.Dismiss(ctx, record.ID, "Mistake")
+	require.ErrorIs(t, err, cheating.ErrInvalidTransition)
+	_, err = service.Review(ctx, record.ID)
+	require.ErrorIs(t, err, cheating.ErrInvalidTransition)
+
+	// an open record can be dismissed directly
+	other, err := client.CheatRecord.Create().SetUser(u).SetReason("Tab switched").Save(ctx)
+	require.NoError(t, err)
+	other, err = service.Dismiss(ctx, other.ID, "False positive")
+	require.NoError(t, err)
+	assert.Equal(t, cheatrecord.StateDismissed, other.State)
+	assert.Equal(t, "False positive", other.ResolvedReason)
+	assert.True(t, other.ReviewedAt.IsZero())
+
+	_, err = service.Confirm(ctx, other.ID, nil)
+	require.ErrorIs(t, err, cheating.ErrInvalidTransition)
+
+	// a pending record created by a detector is confirmed or dismissed by a teacher
+	detected, err := client.CheatRecord.Create().
+		SetUser(u).
+		SetKind(cheatrecord.KindPlagiarism).
+		SetReason("Suspected plagiarism").
+		SetState(cheatrecord.StatePending).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = service.Review(ctx, detected.ID)
+	require.ErrorIs(t, err, cheating.ErrInvalidTransition)
+	detected, err = service.Confirm(ctx, detected.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cheatrecord.StateConfirmed, detected.State)
+
+	_, err = service.Review(ctx, 12345)
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestCounted(t *testing.T) {
+	client, u := setup(t)
+	ctx := context.Background()
+
+	create := func(reason string, state cheatrecord.State, resolved bool) {
+		c := client.CheatRecord.Create().
+			SetUser(u).
+			SetReason(reason).
+			SetState(state)
+		if resolved {
+			c.SetResolvedAt(time.Now())
+		}
+		require.NoError(t, c.Exec(ctx))
+	}
+
+	create("open", cheatrecord.StateOpen, false)
+	create("under review", cheatrecord.StateUnderReview, false)
+	create("confirmed", cheatrecord.StateConfirmed, true)
+	create("pending", cheatrecord.StatePending, false)
+	create("dismissed", cheatrecord.StateDismissed, true)
+	create("resolved before the states", cheatrecord.StateOpen, true)
+
+	reasons, err := client.CheatRecord.Query().
+		Where(cheating.Counted()).
+		Order(ent.Asc(cheatrecord.FieldID)).
+		Select(cheatrecord.FieldReason).
+		Strings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open", "under review", "confirmed"}, reasons)
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 )
 
 type BackendConfig struct {
@@ -18,6 +20,17 @@ type BackendConfig struct {
 	Server    ServerConfig    `envPrefix:"SERVER_"`
 	SqlRunner SqlRunnerConfig `envPrefix:"SQL_RUNNER_"`
 	PostHog   PostHogConfig   `envPrefix:"POSTHOG_"`
+	Analytics AnalyticsConfig `envPrefix:"ANALYTICS_"`
+	Events    EventsConfig    `envPrefix:"EVENTS_"`
+	Scheduler SchedulerConfig `envPrefix:"SCHEDULER_"`
+	Ranking   RankingConfig   `envPrefix:"RANKING_"`
+
+	Plagiarism PlagiarismConfig `envPrefix:"PLAGIARISM_"`
+	Proctoring ProctoringConfig `envPrefix:"PROCTORING_"`
+
+	GraphQL             GraphQLConfig             `envPrefix:"GRAPHQL_"`
+	RateLimit           RateLimitConfig           `envPrefix:"RATE_LIMIT_"`
+	SubmissionRateLimit SubmissionRateLimitConfig `envPrefix:"SUBMISSION_RATE_LIMIT_"`
 }
 
 func (c BackendConfig) Validate() error {
@@ -39,6 +52,33 @@ func (c BackendConfig) Validate() error {
 	if err := c.PostHog.Validate(); err != nil {
 		return fmt.Errorf("POSTHOG: %w", err)
 	}
+	if err := c.Analytics.Validate(); err != nil {
+		return fmt.Errorf("ANALYTICS: %w", err)
+	}
+	if slices.Contains(c.Analytics.Sinks, AnalyticsSinkPostHog) && (c.PostHog.APIKey == nil || c.PostHog.Host == nil) {
+		return errors.New("ANALYTICS_SINKS contains posthog, but POSTHOG_API_KEY or POSTHOG_HOST is not set")
+	}
+	if err := c.Events.Validate(); err != nil {
+		return fmt.Errorf("EVENTS: %w", err)
+	}
+	if err := c.Ranking.Validate(); err != nil {
+		return fmt.Errorf("RANKING: %w", err)
+	}
+	if err := c.Plagiarism.Validate(); err != nil {
+		return fmt.Errorf("PLAGIARISM: %w", err)
+	}
+	if err := c.Proctoring.Validate(); err != nil {
+		return fmt.Errorf("PROCTORING: %w", err)
+	}
+	if err := c.GraphQL.Validate(); err != nil {
+		return fmt.Errorf("GRAPHQL: %w", err)
+	}
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("RATE_LIMIT: %w", err)
+	}
+	if err := c.SubmissionRateLimit.Validate(); err != nil {
+		return fmt.Errorf("SUBMISSION_RATE_LIMIT: %w", err)
+	}
 
 	return nil
 }
@@ -197,3 +237,270 @@ func (c PostHogConfig) Validate() error {
 
 	return nil
 }
+
+const (
+	AnalyticsSinkPostHog = "posthog"
+	AnalyticsSinkFile    = "file"
+	AnalyticsSinkOTLP    = "otlp"
+	AnalyticsSinkNoop    = "noop"
+)
+
+type AnalyticsConfig struct {
+	// Sinks are the analytics sinks to send the events to.
+	// If unset, PostHog is used when it is configured.
+	Sinks []string `env:"SINKS"`
+
+	PostHog AnalyticsSinkConfig     `envPrefix:"POSTHOG_"`
+	File    AnalyticsFileSinkConfig `envPrefix:"FILE_"`
+	OTLP    AnalyticsSinkConfig     `envPrefix:"OTLP_"`
+}
+
+type AnalyticsSinkConfig struct {
+	// Redact is the property names whose values are redacted before sending to the sink.
+	Redact []string `env:"REDACT"`
+}
+
+type AnalyticsFileSinkConfig struct {
+	// Path is the JSON-lines file to append the events to.
+	Path   string   `env:"PATH"`
+	Redact []string `env:"REDACT"`
+}
+
+func (c AnalyticsConfig) Validate() error {
+	for _, sink := range c.Sinks {
+		switch sink {
+		case AnalyticsSinkPostHog, AnalyticsSinkOTLP, AnalyticsSinkNoop:
+		case AnalyticsSinkFile:
+			if c.File.Path == "" {
+				return errors.New("ANALYTICS_FILE_PATH is required for the file sink")
+			}
+		default:
+			return fmt.Errorf("ANALYTICS_SINKS contains unknown sink %q", sink)
+		}
+	}
+
+	return nil
+}
+
+type EventsConfig struct {
+	Workers      int           `env:"WORKERS" envDefault:"4"`
+	PollInterval time.Duration `env:"POLL_INTERVAL" envDefault:"5s"`
+	MaxAttempts  int           `env:"MAX_ATTEMPTS" envDefault:"8"`
+
+	// Retention is how long the events are kept. Zero keeps the events forever.
+	Retention time.Duration `env:"RETENTION"`
+	// RetentionSchedule is the cron expression of the retention job.
+	RetentionSchedule string `env:"RETENTION_SCHEDULE" envDefault:"0 3 * * *"`
+	// ArchiveDir is the directory to archive the events to as compressed JSON lines.
+	// If unset, the events are archived to the archived_events table.
+	ArchiveDir string `env:"ARCHIVE_DIR"`
+}
+
+func (c EventsConfig) Validate() error {
+	if c.Workers <= 0 {
+		return errors.New("EVENTS_WORKERS must be positive")
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("EVENTS_POLL_INTERVAL must be positive")
+	}
+	if c.MaxAttempts <= 0 {
+		return errors.New("EVENTS_MAX_ATTEMPTS must be positive")
+	}
+	if c.Retention < 0 {
+		return errors.New("EVENTS_RETENTION cannot be negative")
+	}
+	if c.Retention > 0 && c.Retention < 7*24*time.Hour {
+		return errors.New("EVENTS_RETENTION must be at least 168h (7 days)")
+	}
+	if c.Retention > 0 && c.RetentionSchedule == "" {
+		return errors.New("EVENTS_RETENTION_SCHEDULE is required")
+	}
+
+	return nil
+}
+
+type SchedulerConfig struct {
+	// Enabled controls whether this replica runs the scheduled jobs.
+	Enabled bool `env:"ENABLED" envDefault:"true"`
+}
+
+type RankingConfig struct {
+	// Leaderboard controls whether the rankings are kept in the Redis sorted sets.
+	Leaderboard bool `env:"LEADERBOARD" envDefault:"true"`
+	// Timezone is the IANA time zone of the periods kept in the leaderboards.
+	// Defaults to the time zone of the server.
+	Timezone string `env:"TIMEZONE"`
+	// RebuildSchedule is the cron expression of the job rebuilding the leaderboards.
+	RebuildSchedule string `env:"REBUILD_SCHEDULE" envDefault:"0 4 * * *"`
+	// SnapshotSchedule is the cron expression of the job recording the daily rank snapshots.
+	// It should run near the end of the day in Timezone, e.g. "CRON_TZ=Asia/Taipei 55 23 * * *".
+	SnapshotSchedule string `env:"SNAPSHOT_SCHEDULE" envDefault:"55 23 * * *"`
+}
+
+// Location returns the location of Timezone.
+func (c RankingConfig) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+
+	return time.LoadLocation(c.Timezone)
+}
+
+func (c RankingConfig) Validate() error {
+	if _, err := c.Location(); err != nil {
+		return fmt.Errorf("RANKING_TIMEZONE is invalid: %w", err)
+	}
+	if c.Leaderboard && c.RebuildSchedule == "" {
+		return errors.New("RANKING_REBUILD_SCHEDULE is required")
+	}
+	if c.SnapshotSchedule == "" {
+		return errors.New("RANKING_SNAPSHOT_SCHEDULE is required")
+	}
+
+	return nil
+}
+
+type PlagiarismConfig struct {
+	// Enabled controls whether the plagiarism detector runs.
+	Enabled bool `env:"ENABLED" envDefault:"true"`
+	// Schedule is the cron expression of the plagiarism detection job.
+	Schedule string `env:"SCHEDULE" envDefault:"0 * * * *"`
+	// Lookback is the period of the recent submissions to check in each run.
+	// It should be longer than the interval of Schedule.
+	Lookback time.Duration `env:"LOOKBACK" envDefault:"24h"`
+	// Window is the window of the same solutions considered submitted closely.
+	Window time.Duration `env:"WINDOW" envDefault:"10m"`
+	// MinTokens is the minimum number of the tokens of a solution to check.
+	MinTokens int `env:"MIN_TOKENS" envDefault:"12"`
+	// MaxSharedUsers is the maximum number of the users in a group sharing a solution to check.
+	MaxSharedUsers int `env:"MAX_SHARED_USERS" envDefault:"5"`
+}
+
+func (c PlagiarismConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Schedule == "" {
+		return errors.New("PLAGIARISM_SCHEDULE is required")
+	}
+	if c.Lookback <= 0 {
+		return errors.New("PLAGIARISM_LOOKBACK must be positive")
+	}
+	if c.Window < 0 {
+		return errors.New("PLAGIARISM_WINDOW cannot be negative")
+	}
+	if c.MaxSharedUsers < 2 {
+		return errors.New("PLAGIARISM_MAX_SHARED_USERS must be at least 2")
+	}
+
+	return nil
+}
+
+type ProctoringConfig struct {
+	// Window is the window of the escalation rules of the proctoring signals.
+	Window time.Duration `env:"WINDOW" envDefault:"30m"`
+	// TabSwitchThreshold escalates the tab switches into a cheat record
+	// when a user reports more than it in the window. 0 disables the rule.
+	TabSwitchThreshold int `env:"TAB_SWITCH_THRESHOLD" envDefault:"5"`
+	// PasteThreshold escalates the pastes into a cheat record
+	// when a user reports more than it in the window. 0 disables the rule.
+	PasteThreshold int `env:"PASTE_THRESHOLD" envDefault:"10"`
+	// FocusLossThreshold escalates the focus losses into a cheat record
+	// when a user reports more than it in the window. 0 disables the rule.
+	FocusLossThreshold int `env:"FOCUS_LOSS_THRESHOLD" envDefault:"10"`
+	// RateLimitBurst is the number of the signals a user can report at once.
+	// A user can report another signal every RateLimitInterval afterwards.
+	// 0 disables the limit.
+	RateLimitBurst    int           `env:"RATE_LIMIT_BURST" envDefault:"120"`
+	RateLimitInterval time.Duration `env:"RATE_LIMIT_INTERVAL" envDefault:"500ms"`
+}
+
+func (c ProctoringConfig) Validate() error {
+	if c.Window <= 0 {
+		return errors.New("PROCTORING_WINDOW must be positive")
+	}
+	if c.TabSwitchThreshold < 0 || c.PasteThreshold < 0 || c.FocusLossThreshold < 0 {
+		return errors.New("PROCTORING thresholds cannot be negative")
+	}
+	if c.RateLimitBurst < 0 {
+		return errors.New("PROCTORING_RATE_LIMIT_BURST cannot be negative")
+	}
+	if c.RateLimitBurst > 0 && c.RateLimitInterval <= 0 {
+		return errors.New("PROCTORING_RATE_LIMIT_INTERVAL must be positive")
+	}
+
+	return nil
+}
+
+type GraphQLConfig struct {
+	// ComplexityLimit is the maximum complexity of an operation.
+	// 0 disables the limit.
+	ComplexityLimit int `env:"COMPLEXITY_LIMIT" envDefault:"5000"`
+	// AdminComplexityLimit is the maximum complexity of an operation
+	// of the administrators. 0 disables the limit.
+	AdminComplexityLimit int `env:"ADMIN_COMPLEXITY_LIMIT" envDefault:"50000"`
+	// DepthLimit is the maximum depth of an operation. 0 disables the limit.
+	DepthLimit int `env:"DEPTH_LIMIT" envDefault:"12"`
+	// AdminDepthLimit is the maximum depth of an operation of the
+	// administrators. 0 disables the limit.
+	AdminDepthLimit int `env:"ADMIN_DEPTH_LIMIT" envDefault:"20"`
+	// PersistedQueriesStrict only runs the operations in the persisted
+	// query allowlist for the non-administrators.
+	PersistedQueriesStrict bool `env:"PERSISTED_QUERIES_STRICT" envDefault:"false"`
+}
+
+func (c GraphQLConfig) Validate() error {
+	if c.ComplexityLimit < 0 || c.AdminComplexityLimit < 0 || c.DepthLimit < 0 || c.AdminDepthLimit < 0 {
+		return errors.New("GRAPHQL limits cannot be negative")
+	}
+
+	return nil
+}
+
+type RateLimitConfig struct {
+	// GraphQLBurst is the number of the requests a user (or an IP for the
+	// anonymous requests) can send to /query at once. Another request is
+	// allowed every GraphQLInterval afterwards. 0 disables the limit.
+	GraphQLBurst    int           `env:"GRAPHQL_BURST" envDefault:"120"`
+	GraphQLInterval time.Duration `env:"GRAPHQL_INTERVAL" envDefault:"500ms"`
+	// APIBurst is the number of the requests an IP can send to /api/* at
+	// once. Another request is allowed every APIInterval afterwards.
+	// 0 disables the limit.
+	APIBurst    int           `env:"API_BURST" envDefault:"20"`
+	APIInterval time.Duration `env:"API_INTERVAL" envDefault:"3s"`
+}
+
+func (c RateLimitConfig) Validate() error {
+	if c.GraphQLBurst < 0 || c.APIBurst < 0 {
+		return errors.New("RATE_LIMIT bursts cannot be negative")
+	}
+	if (c.GraphQLBurst > 0 && c.GraphQLInterval <= 0) || (c.APIBurst > 0 && c.APIInterval <= 0) {
+		return errors.New("RATE_LIMIT intervals must be positive")
+	}
+
+	return nil
+}
+
+type SubmissionRateLimitConfig struct {
+	// UserBurst is the number of the answers a user can submit at once.
+	// A user can submit another answer every UserInterval afterwards.
+	// 0 disables the limit.
+	UserBurst    int           `env:"USER_BURST" envDefault:"10"`
+	UserInterval time.Duration `env:"USER_INTERVAL" envDefault:"6s"`
+	// QuestionBurst is the number of the answers a user can submit to a
+	// question at once. A user can submit another answer to the question
+	// every QuestionInterval afterwards. 0 disables the limit.
+	QuestionBurst    int           `env:"QUESTION_BURST" envDefault:"3"`
+	QuestionInterval time.Duration `env:"QUESTION_INTERVAL" envDefault:"10s"`
+}
+
+func (c SubmissionRateLimitConfig) Validate() error {
+	if c.UserBurst < 0 || c.QuestionBurst < 0 {
+		return errors.New("SUBMISSION_RATE_LIMIT bursts cannot be negative")
+	}
+	if (c.UserBurst > 0 && c.UserInterval <= 0) || (c.QuestionBurst > 0 && c.QuestionInterval <= 0) {
+		return errors.New("SUBMISSION_RATE_LIMIT intervals must be positive")
+	}
+
+	return nil
+}
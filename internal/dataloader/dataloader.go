@@ -0,0 +1,146 @@
+// Package dataloader batches and caches the lookups of the GraphQL resolvers
+// in a request, so that resolving a field of N objects does not run N queries.
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWait is how long a batch waits for more keys before it is fetched.
+	DefaultWait = 2 * time.Millisecond
+	// DefaultMaxBatch is the maximum number of keys fetched in a batch.
+	DefaultMaxBatch = 100
+)
+
+// BatchFunc fetches the values of the keys in a batch.
+//
+// The keys missing in the returned map get the zero value, so it only
+// needs to return the found values. An error fails every key of the batch.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Option configures a Loader.
+type Option func(*options)
+
+type options struct {
+	wait     time.Duration
+	maxBatch int
+}
+
+// WithWait sets how long a batch waits for more keys before it is fetched.
+func WithWait(wait time.Duration) Option {
+	return func(o *options) {
+		o.wait = wait
+	}
+}
+
+// WithMaxBatch sets the maximum number of keys fetched in a batch.
+// The batch is fetched without waiting once it is full.
+func WithMaxBatch(maxBatch int) Option {
+	return func(o *options) {
+		o.maxBatch = maxBatch
+	}
+}
+
+// Loader collects the keys loaded concurrently into batches, and fetches
+// every batch with one call of the BatchFunc. The results (including the
+// errors) are cached, so a key is fetched at most once.
+//
+// A Loader should be scoped to a request, since the cache is never evicted.
+type Loader[K comparable, V any] struct {
+	fetch BatchFunc[K, V]
+	opts  options
+
+	mu      sync.Mutex
+	batches map[K]*batch[K, V]
+	pending *batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// NewLoader creates a new Loader fetching the batches with fetch.
+func NewLoader[K comparable, V any](fetch BatchFunc[K, V], opts ...Option) *Loader[K, V] {
+	o := options{
+		wait:     DefaultWait,
+		maxBatch: DefaultMaxBatch,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Loader[K, V]{
+		fetch:   fetch,
+		opts:    o,
+		batches: make(map[K]*batch[K, V]),
+	}
+}
+
+// Load returns the value of the key, waiting for its batch to be fetched.
+//
+// The batch is fetched with the context of its first key, without its
+// cancellation, since the other keys of the batch are waiting for it.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		b = l.pending
+		if b == nil {
+			b = &batch[K, V]{done: make(chan struct{})}
+			l.pending = b
+			go l.fetchAfterWait(context.WithoutCancel(ctx), b)
+		}
+
+		b.keys = append(b.keys, key)
+		l.batches[key] = b
+
+		if len(b.keys) >= l.opts.maxBatch {
+			l.pending = nil
+			go l.run(context.WithoutCancel(ctx), b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+
+	return b.results[key], b.err
+}
+
+// fetchAfterWait fetches the batch after the wait, unless it is full
+// and has been fetched.
+func (l *Loader[K, V]) fetchAfterWait(ctx context.Context, b *batch[K, V]) {
+	time.Sleep(l.opts.wait)
+
+	l.mu.Lock()
+	if l.pending != b {
+		l.mu.Unlock()
+		return
+	}
+	l.pending = nil
+	l.mu.Unlock()
+
+	l.run(ctx, b)
+}
+
+func (l *Loader[K, V]) run(ctx context.Context, b *batch[K, V]) {
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = fmt.Errorf("fetch batch: panic: %v", r)
+		}
+	}()
+
+	b.results, b.err = l.fetch(ctx, b.keys)
+}
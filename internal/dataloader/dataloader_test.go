@@ -0,0 +1,122 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/internal/dataloader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doubleLoader returns a loader doubling the positive keys, and the
+// batches it fetched.
+func doubleLoader(opts ...dataloader.Option) (*dataloader.Loader[int, int], func() [][]int) {
+	var mu sync.Mutex
+	var batches [][]int
+
+	loader := dataloader.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+		mu.Lock()
+		batches = append(batches, slices.Sorted(slices.Values(keys)))
+		mu.Unlock()
+
+		results := make(map[int]int, len(keys))
+		for _, key := range keys {
+			if key > 0 {
+				results[key] = key * 2
+			}
+		}
+		return results, nil
+	}, opts...)
+
+	return loader, func() [][]int {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(batches)
+	}
+}
+
+// loadConcurrently loads the keys concurrently, and returns the values in order.
+func loadConcurrently(t *testing.T, loader *dataloader.Loader[int, int], keys ...int) []int {
+	t.Helper()
+
+	values := make([]int, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Go(func() {
+			value, err := loader.Load(context.Background(), key)
+			assert.NoError(t, err)
+			values[i] = value
+		})
+	}
+	wg.Wait()
+
+	return values
+}
+
+func TestLoader_Batch(t *testing.T) {
+	loader, batches := doubleLoader(dataloader.WithWait(50 * time.Millisecond))
+
+	values := loadConcurrently(t, loader, 1, 2, 3, 2, -1)
+	assert.Equal(t, []int{2, 4, 6, 4, 0}, values)
+	assert.Equal(t, [][]int{{-1, 1, 2, 3}}, batches())
+
+	// the loaded keys are cached
+	values = loadConcurrently(t, loader, 1, 3, 4)
+	assert.Equal(t, []int{2, 6, 8}, values)
+	assert.Equal(t, [][]int{{-1, 1, 2, 3}, {4}}, batches())
+}
+
+func TestLoader_MaxBatch(t *testing.T) {
+	loader, batches := doubleLoader(dataloader.WithWait(time.Hour), dataloader.WithMaxBatch(2))
+
+	// the full batches are fetched without waiting
+	values := loadConcurrently(t, loader, 1, 2, 3, 4)
+	assert.Equal(t, []int{2, 4, 6, 8}, values)
+	require.Len(t, batches(), 2)
+	for _, batch := range batches() {
+		assert.Len(t, batch, 2)
+	}
+}
+
+func TestLoader_Error(t *testing.T) {
+	var calls atomic.Int64
+	errFetch := errors.New("fetch failed")
+	loader := dataloader.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+		calls.Add(1)
+		return nil, errFetch
+	})
+
+	_, err := loader.Load(context.Background(), 1)
+	require.ErrorIs(t, err, errFetch)
+
+	// the error is cached
+	_, err = loader.Load(context.Background(), 1)
+	require.ErrorIs(t, err, errFetch)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestLoader_Panic(t *testing.T) {
+	loader := dataloader.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+		panic("boom")
+	})
+
+	_, err := loader.Load(context.Background(), 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestLoader_Canceled(t *testing.T) {
+	loader, _ := doubleLoader(dataloader.WithWait(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := loader.Load(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
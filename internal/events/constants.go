@@ -10,6 +10,8 @@ const (
 
 	EventTypeSubmitAnswer EventType = "submit_answer"
 
+	EventTypeProctoringSignal EventType = "proctoring_signal"
+
 	// Internal usage
 	EventTypeGrantPoint EventType = "grant_point"
 )
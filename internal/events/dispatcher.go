@@ -0,0 +1,329 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EventDispatchTotal tracks the outcomes of the outbox dispatches.
+var EventDispatchTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_event_dispatch_total",
+		Help: "Total number of event dispatches by handler and result",
+	},
+	[]string{"handler", "result"},
+)
+
+const (
+	// DefaultDispatcherWorkers is the default number of concurrent dispatch workers.
+	DefaultDispatcherWorkers = 4
+	// DefaultPollInterval is the default interval to poll the outbox.
+	DefaultPollInterval = 5 * time.Second
+	// DefaultMaxAttempts is the default number of attempts before an entry is dead-lettered.
+	DefaultMaxAttempts = 8
+
+	// dispatchBatchSize is the maximum number of entries claimed in a poll.
+	dispatchBatchSize = 100
+	// dispatchLease is how long a worker owns a claimed entry. Entries whose
+	// lease expired (e.g. the replica crashed) are claimed again.
+	dispatchLease = 5 * time.Minute
+
+	backoffBase = time.Second
+	backoffMax  = time.Hour
+)
+
+// Dispatcher dispatches the outbox entries to the registered handlers.
+//
+// Multiple dispatchers (e.g. on different replicas) can run at the same time,
+// since every entry is claimed with a conditional update before dispatching.
+type Dispatcher struct {
+	service *EventService
+
+	workers      int
+	pollInterval time.Duration
+	maxAttempts  int
+}
+
+type DispatcherOption func(*Dispatcher)
+
+// WithWorkers sets the number of concurrent dispatch workers.
+func WithWorkers(workers int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.workers = workers
+	}
+}
+
+// WithPollInterval sets the interval to poll the outbox for due entries.
+func WithPollInterval(interval time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.pollInterval = interval
+	}
+}
+
+// WithMaxAttempts sets the number of attempts before an entry is dead-lettered.
+func WithMaxAttempts(maxAttempts int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.maxAttempts = maxAttempts
+	}
+}
+
+// NewDispatcher creates a new Dispatcher for the handlers of the EventService.
+func NewDispatcher(service *EventService, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		service:      service,
+		workers:      DefaultDispatcherWorkers,
+		pollInterval: DefaultPollInterval,
+		maxAttempts:  DefaultMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Run dispatches the outbox entries until the context is canceled.
+//
+// It polls the outbox every poll interval, or immediately when
+// a new event is triggered by the EventService.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DispatchPending(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to dispatch pending events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.service.notify:
+		}
+	}
+}
+
+// DispatchPending dispatches the due outbox entries once.
+//
+// It returns the number of entries dispatched by this call.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "DispatchPending")
+	defer span.End()
+
+	span.AddEvent("database.outbox.query")
+	ids, err := d.service.entClient.EventOutbox.Query().
+		Where(claimable(time.Now())).
+		Order(eventoutbox.ByNextAttemptAt()).
+		Limit(dispatchBatchSize).
+		IDs(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query outbox")
+		span.RecordError(err)
+		return 0, fmt.Errorf("query outbox: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("outbox.due", len(ids)))
+	if len(ids) == 0 {
+		span.SetStatus(otelcodes.Ok, "No due outbox entries")
+		return 0, nil
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var dispatched atomic.Int64
+
+	for range min(d.workers, len(ids)) {
+		wg.Go(func() {
+			for id := range jobs {
+				ok, err := d.dispatch(ctx, id)
+				if err != nil {
+					slog.Error("failed to dispatch outbox entry", "outbox_id", id, "error", err)
+				}
+				if ok {
+					dispatched.Add(1)
+				}
+			}
+		})
+	}
+
+	for _, id := range ids {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	span.SetAttributes(attribute.Int64("outbox.dispatched", dispatched.Load()))
+	span.SetStatus(otelcodes.Ok, "Outbox entries dispatched")
+	return int(dispatched.Load()), nil
+}
+
+// dispatch claims an outbox entry and hands it to its handler.
+//
+// It returns false if the entry has been claimed by another worker.
+func (d *Dispatcher) dispatch(ctx context.Context, id int) (bool, error) {
+	ctx, span := tracer.Start(ctx, "dispatch",
+		trace.WithAttributes(
+			attribute.Int("outbox.id", id),
+		))
+	defer span.End()
+
+	client := d.service.entClient
+	now := time.Now()
+
+	span.AddEvent("database.outbox.claim")
+	claimed, err := client.EventOutbox.Update().
+		Where(eventoutbox.ID(id), claimable(now)).
+		SetStatus(eventoutbox.StatusProcessing).
+		SetLockedUntil(now.Add(dispatchLease)).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to claim outbox entry")
+		span.RecordError(err)
+		return false, fmt.Errorf("claim outbox entry: %w", err)
+	}
+	if claimed == 0 {
+		span.SetStatus(otelcodes.Ok, "Outbox entry claimed by another worker")
+		return false, nil
+	}
+
+	entry, err := client.EventOutbox.Query().
+		Where(eventoutbox.ID(id)).
+		WithEvent().
+		Only(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get outbox entry")
+		span.RecordError(err)
+		return false, fmt.Errorf("get outbox entry: %w", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("outbox.handler", entry.Handler),
+		attribute.Int("outbox.attempts", entry.Attempts),
+		attribute.Int("event.id", entry.EventID),
+	)
+
+	handleErr := d.handle(ctx, entry)
+
+	// The attempts identify the lease, so a worker whose lease has expired and
+	// been claimed again cannot overwrite the result of the new holder.
+	update := client.EventOutbox.Update().
+		Where(
+			eventoutbox.ID(id),
+			eventoutbox.StatusEQ(eventoutbox.StatusProcessing),
+			eventoutbox.Attempts(entry.Attempts),
+		).
+		ClearLockedUntil()
+	var result string
+	switch {
+	case handleErr == nil:
+		result = "succeeded"
+		update.SetStatus(eventoutbox.StatusSucceeded).
+			SetProcessedAt(time.Now()).
+			ClearLastError()
+	case entry.Attempts >= d.maxAttempts:
+		result = "dead"
+		update.SetStatus(eventoutbox.StatusDead).
+			SetProcessedAt(time.Now()).
+			SetLastError(handleErr.Error())
+		slog.Error("event dead-lettered", "event_id", entry.EventID, "handler", entry.Handler, "attempts", entry.Attempts, "error", handleErr)
+	default:
+		result = "retry"
+		update.SetStatus(eventoutbox.StatusPending).
+			SetNextAttemptAt(time.Now().Add(backoff(entry.Attempts))).
+			SetLastError(handleErr.Error())
+		slog.Warn("event dispatch failed, will retry", "event_id", entry.EventID, "handler", entry.Handler, "attempts", entry.Attempts, "error", handleErr)
+	}
+
+	EventDispatchTotal.WithLabelValues(entry.Handler, result).Inc()
+
+	span.AddEvent("database.outbox.update", trace.WithAttributes(
+		attribute.String("outbox.result", result),
+	))
+	updated, err := update.Save(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to update outbox entry")
+		span.RecordError(err)
+		return true, fmt.Errorf("update outbox entry: %w", err)
+	}
+	if updated == 0 {
+		span.AddEvent("outbox.lease_lost")
+		slog.Warn("outbox entry claimed by another worker after the lease expired", "event_id", entry.EventID, "handler", entry.Handler, "attempts", entry.Attempts)
+	}
+
+	if handleErr != nil {
+		span.SetStatus(otelcodes.Error, "Handler failed")
+		span.RecordError(handleErr)
+		return true, nil
+	}
+
+	span.SetStatus(otelcodes.Ok, "Outbox entry dispatched")
+	return true, nil
+}
+
+// handle runs the handler of the entry with the decoded payload, converting panics into errors.
+func (d *Dispatcher) handle(ctx context.Context, entry *ent.EventOutbox) (err error) {
+	handler, ok := d.service.handler(entry.Handler)
+	if !ok {
+		return fmt.Errorf("no handler registered with name %q", entry.Handler)
+	}
+
+	payload, err := DecodePayload(entry.Edges.Event)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+
+	return handler.HandleEvent(ctx, entry.Edges.Event, payload)
+}
+
+// claimable selects the entries which are due, or whose lease has expired.
+func claimable(now time.Time) predicate.EventOutbox {
+	return eventoutbox.Or(
+		eventoutbox.And(
+			eventoutbox.StatusEQ(eventoutbox.StatusPending),
+			eventoutbox.NextAttemptAtLTE(now),
+		),
+		eventoutbox.And(
+			eventoutbox.StatusEQ(eventoutbox.StatusProcessing),
+			eventoutbox.LockedUntilLT(now),
+		),
+	)
+}
+
+// backoff returns the delay before the next attempt, doubling from
+// one second for each attempt made and capped at one hour.
+func backoff(attempts int) time.Duration {
+	if attempts <= 0 {
+		return backoffBase
+	}
+
+	delay := backoffBase
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= backoffMax {
+			return backoffMax
+		}
+	}
+
+	return delay
+}
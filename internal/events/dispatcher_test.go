@@ -0,0 +1,424 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/database-playground/backend-v2/ent/point"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// recordingHandler records the handled events, and fails the first failures calls.
+type recordingHandler struct {
+	mu       sync.Mutex
+	handled  []int
+	failures int
+}
+
+func (h *recordingHandler) HandleEvent(ctx context.Context, event *ent.Event, payload events.Payload) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.failures > 0 {
+		h.failures--
+		return errors.New("handler failed")
+	}
+
+	h.handled = append(h.handled, event.ID)
+	return nil
+}
+
+// makeOutboxDue makes every pending outbox entry due immediately, skipping the backoff.
+func makeOutboxDue(t *testing.T, client *ent.Client) {
+	t.Helper()
+
+	err := client.EventOutbox.Update().
+		Where(eventoutbox.StatusEQ(eventoutbox.StatusPending)).
+		SetNextAttemptAt(time.Now().Add(-time.Second)).
+		Exec(context.Background())
+	require.NoError(t, err)
+}
+
+func TestTriggerEvent_WritesOutbox(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", &recordingHandler{})
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:    events.EventTypeLogin,
+		Payload: events.LoginPayload{Machine: "test"},
+		UserID:  userID,
+	})
+
+	entries, err := client.EventOutbox.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	handlers := []string{entries[0].Handler, entries[1].Handler}
+	require.ElementsMatch(t, []string{events.HandlerPoints, "recording"}, handlers)
+	for _, entry := range entries {
+		require.Equal(t, eventoutbox.StatusPending, entry.Status)
+		require.Zero(t, entry.Attempts)
+	}
+
+	// The handlers are not invoked synchronously.
+	hasPoints, err := client.Point.Query().Where(point.HasUserWith(user.ID(userID))).Exist(ctx)
+	require.NoError(t, err)
+	require.False(t, hasPoints)
+}
+
+// filteringHandler only wants the logout events.
+type filteringHandler struct {
+	recordingHandler
+}
+
+func (h *filteringHandler) WantsEvent(ctx context.Context, eventType events.EventType) (bool, error) {
+	return eventType == events.EventTypeLogout, nil
+}
+
+func TestTriggerEvent_SkipsUnwantedHandlers(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("filtering", &filteringHandler{})
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:    events.EventTypeLogin,
+		Payload: events.LoginPayload{Machine: "test"},
+		UserID:  userID,
+	})
+	service.TriggerEvent(ctx, events.Event{
+		Type:   events.EventTypeLogout,
+		UserID: userID,
+	})
+
+	entries, err := client.EventOutbox.Query().
+		Where(eventoutbox.Handler("filtering")).
+		WithEvent().
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, string(events.EventTypeLogout), entries[0].Edges.Event.Type)
+}
+
+func TestDispatcher_DispatchPending(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1))
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:    events.EventTypeLogin,
+		Payload: events.LoginPayload{Machine: "test"},
+		UserID:  userID,
+	})
+
+	dispatched, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, dispatched)
+	require.Len(t, handler.handled, 1)
+
+	// The points granter should have granted the daily login points.
+	hasPoints, err := client.Point.Query().
+		Where(point.HasUserWith(user.ID(userID))).
+		Where(point.DescriptionEQ(events.PointDescriptionDailyLogin)).
+		Exist(ctx)
+	require.NoError(t, err)
+	require.True(t, hasPoints)
+
+	succeeded, err := client.EventOutbox.Query().
+		Where(eventoutbox.StatusEQ(eventoutbox.StatusSucceeded)).
+		Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, succeeded)
+
+	// Nothing is left to dispatch.
+	dispatched, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, dispatched)
+}
+
+func TestDispatcher_RetryWithBackoff(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{failures: 1}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1))
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:   events.EventTypeLogout,
+		UserID: userID,
+	})
+
+	_, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	entry, err := client.EventOutbox.Query().
+		Where(eventoutbox.Handler("recording")).
+		Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusPending, entry.Status)
+	require.Equal(t, 1, entry.Attempts)
+	require.NotNil(t, entry.LastError)
+	require.Equal(t, "handler failed", *entry.LastError)
+	require.True(t, entry.NextAttemptAt.After(time.Now()), "the retry should be scheduled with backoff")
+
+	// The entry is not due yet.
+	dispatched, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, dispatched)
+
+	makeOutboxDue(t, client)
+
+	dispatched, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, dispatched)
+	require.Len(t, handler.handled, 1)
+
+	entry, err = client.EventOutbox.Get(ctx, entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusSucceeded, entry.Status)
+	require.Equal(t, 2, entry.Attempts)
+	require.Nil(t, entry.LastError)
+	require.NotNil(t, entry.ProcessedAt)
+}
+
+func TestDispatcher_DeadLetter(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{failures: 2}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1), events.WithMaxAttempts(2))
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:   events.EventTypeLogout,
+		UserID: userID,
+	})
+
+	for range 2 {
+		_, err := dispatcher.DispatchPending(ctx)
+		require.NoError(t, err)
+		makeOutboxDue(t, client)
+	}
+
+	entry, err := client.EventOutbox.Query().
+		Where(eventoutbox.Handler("recording")).
+		Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusDead, entry.Status)
+	require.Equal(t, 2, entry.Attempts)
+	require.Empty(t, handler.handled)
+
+	// Dead entries are not retried.
+	dispatched, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, dispatched)
+}
+
+func TestDispatcher_UnknownHandler(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	service := events.NewEventService(client, nil)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1), events.WithMaxAttempts(1))
+
+	event, err := client.Event.Create().
+		SetUserID(userID).
+		SetType(string(events.EventTypeLogout)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.EventOutbox.Create().
+		SetEventID(event.ID).
+		SetHandler("removed").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	entry, err := client.EventOutbox.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusDead, entry.Status)
+	require.Contains(t, *entry.LastError, `no handler registered with name "removed"`)
+}
+
+func TestDispatcher_ReclaimExpiredLease(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1))
+
+	event, err := client.Event.Create().
+		SetUserID(userID).
+		SetType(string(events.EventTypeLogout)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// An entry claimed by a worker which crashed.
+	_, err = client.EventOutbox.Create().
+		SetEventID(event.ID).
+		SetHandler("recording").
+		SetStatus(eventoutbox.StatusProcessing).
+		SetAttempts(1).
+		SetLockedUntil(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	dispatched, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, dispatched)
+	require.Equal(t, []int{event.ID}, handler.handled)
+}
+
+// reclaimingHandler simulates that the lease expires while handling, and
+// another worker claims the entry again.
+type reclaimingHandler struct {
+	client *ent.Client
+}
+
+func (h *reclaimingHandler) HandleEvent(ctx context.Context, event *ent.Event, payload events.Payload) error {
+	return h.client.EventOutbox.Update().
+		Where(eventoutbox.EventID(event.ID)).
+		SetLockedUntil(time.Now().Add(time.Minute)).
+		AddAttempts(1).
+		Exec(ctx)
+}
+
+func TestDispatcher_ExpiredLeaseKeepsNewHolder(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("reclaiming", &reclaimingHandler{client: client})
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1))
+
+	event, err := client.Event.Create().
+		SetUserID(userID).
+		SetType(string(events.EventTypeLogout)).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.EventOutbox.Create().
+		SetEventID(event.ID).
+		SetHandler("reclaiming").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	// The result of the expired lease does not overwrite the new holder.
+	entry, err := client.EventOutbox.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusProcessing, entry.Status)
+	require.Equal(t, 2, entry.Attempts)
+	require.NotNil(t, entry.LockedUntil)
+}
+
+func TestReplay_DeadOnly(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{failures: 1}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+	dispatcher := events.NewDispatcher(service, events.WithWorkers(1), events.WithMaxAttempts(1))
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:   events.EventTypeLogout,
+		UserID: userID,
+	})
+
+	_, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	queued, err := service.Replay(ctx, events.ReplayOptions{DeadOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, queued)
+
+	entry, err := client.EventOutbox.Query().
+		Where(eventoutbox.Handler("recording")).
+		Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eventoutbox.StatusPending, entry.Status)
+	require.Zero(t, entry.Attempts)
+
+	dispatched, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, dispatched)
+	require.Len(t, handler.handled, 1)
+}
+
+func TestReplay_HistoricalEvents(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	handler := &recordingHandler{}
+	service := events.NewEventService(client, nil)
+	service.RegisterHandler("recording", handler)
+
+	now := time.Now()
+
+	// Events written before the outbox existed.
+	createLoginEvent(t, client, userID, now.Add(-48*time.Hour))
+	createLoginEvent(t, client, userID, now.Add(-time.Hour))
+
+	since := now.Add(-24 * time.Hour)
+	queued, err := service.Replay(ctx, events.ReplayOptions{
+		Handlers: []string{"recording"},
+		Types:    []events.EventType{events.EventTypeLogin},
+		Since:    &since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, queued)
+
+	// Replaying again resets the existing entry instead of duplicating it.
+	queued, err = service.Replay(ctx, events.ReplayOptions{
+		Handlers: []string{"recording"},
+		Since:    &since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, queued)
+
+	count, err := client.EventOutbox.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	dispatched, err := events.NewDispatcher(service, events.WithWorkers(1)).DispatchPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, dispatched)
+	require.Len(t, handler.handled, 1)
+}
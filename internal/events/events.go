@@ -2,12 +2,13 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"strconv"
 	"time"
 
 	"github.com/database-playground/backend-v2/ent"
-	"github.com/posthog/posthog-go"
+	"github.com/database-playground/backend-v2/internal/analytics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
@@ -16,38 +17,155 @@ import (
 
 var tracer = otel.Tracer("dbplay.events")
 
+// HandlerPoints is the name of the built-in PointsGranter handler.
+const HandlerPoints = "points"
+
 // EventService is the service for triggering events.
 type EventService struct {
-	entClient     *ent.Client
-	posthogClient posthog.Client
+	entClient *ent.Client
+	analytics analytics.Sink
+
+	handlers []namedHandler
+
+	// notify wakes up the dispatcher when new outbox entries are written.
+	notify chan struct{}
+
+	location *time.Location
+}
 
-	handlers []EventHandler
+type EventServiceOption func(*EventService)
+
+// WithLocation sets the location in which the PointsGranter calculates the
+// days of the daily and weekly points. Defaults to time.Local.
+func WithLocation(location *time.Location) EventServiceOption {
+	return func(s *EventService) {
+		s.location = location
+	}
+}
+
+type namedHandler struct {
+	name    string
+	handler EventHandler
 }
 
 // NewEventService creates a new EventService.
-func NewEventService(entClient *ent.Client, posthogClient posthog.Client) *EventService {
-	return &EventService{
-		entClient:     entClient,
-		posthogClient: posthogClient,
-		handlers:      []EventHandler{NewPointsGranter(entClient, posthogClient)},
+//
+// The triggered events are sent to the analytics sink; a nil sink discards them.
+// The PointsGranter is registered as the "points" handler by default.
+func NewEventService(entClient *ent.Client, sink analytics.Sink, opts ...EventServiceOption) *EventService {
+	if sink == nil {
+		sink = analytics.NoopSink{}
+	}
+
+	s := &EventService{
+		entClient: entClient,
+		analytics: sink,
+		notify:    make(chan struct{}, 1),
+		location:  time.Local,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.RegisterHandler(HandlerPoints, NewPointsGranter(entClient, sink, WithGranterLocation(s.location)))
+
+	return s
+}
+
+// RegisterHandler registers a handler with the given name.
+//
+// The name is persisted in the outbox to route the retries and replays,
+// so it must be unique and stable across deployments.
+// Registering a handler with an existing name replaces the previous one.
+//
+// It is not safe to call RegisterHandler while events are being triggered.
+func (s *EventService) RegisterHandler(name string, handler EventHandler) {
+	for i, h := range s.handlers {
+		if h.name == name {
+			s.handlers[i].handler = handler
+			return
+		}
 	}
+
+	s.handlers = append(s.handlers, namedHandler{name: name, handler: handler})
+}
+
+// HandlerNames returns the names of the registered handlers.
+func (s *EventService) HandlerNames() []string {
+	names := make([]string, len(s.handlers))
+	for i, h := range s.handlers {
+		names[i] = h.name
+	}
+
+	return names
+}
+
+// handlersFor returns the names of the handlers to enqueue the events of the
+// type for, skipping the EventFilter handlers which do not want them.
+//
+// The handlers which fail to tell are still enqueued, and decide when the
+// event is dispatched.
+func (s *EventService) handlersFor(ctx context.Context, eventType EventType) []string {
+	names := make([]string, 0, len(s.handlers))
+	for _, h := range s.handlers {
+		if filter, ok := h.handler.(EventFilter); ok {
+			wants, err := filter.WantsEvent(ctx, eventType)
+			if err != nil {
+				slog.Warn("failed to check if the handler wants the event, enqueueing it anyway", "handler", h.name, "event_type", eventType, "error", err)
+			} else if !wants {
+				continue
+			}
+		}
+
+		names = append(names, h.name)
+	}
+
+	return names
+}
+
+// handler returns the handler registered with the given name.
+func (s *EventService) handler(name string) (EventHandler, bool) {
+	for _, h := range s.handlers {
+		if h.name == name {
+			return h.handler, true
+		}
+	}
+
+	return nil, false
 }
 
 // Event is the event to be triggered.
 type Event struct {
-	Type    EventType
-	Payload map[string]any
+	Type EventType
+	// Payload is the typed payload of the event type.
+	// It should be nil if the event type has no payload.
+	Payload Payload
 	UserID  int
 }
 
 // EventHandler is the handler for the event.
 //
 // You can think it as the callback of the event.
+// Handlers are invoked by the Dispatcher asynchronously and may be
+// retried, so they should be idempotent.
 type EventHandler interface {
-	HandleEvent(ctx context.Context, event *ent.Event) error
+	// HandleEvent handles the event with its typed payload,
+	// which is nil if the event type has no payload.
+	HandleEvent(ctx context.Context, event *ent.Event, payload Payload) error
+}
+
+// EventFilter is implemented by the handlers which only handle some of the
+// events. The events a handler does not want are not enqueued for it.
+type EventFilter interface {
+	// WantsEvent reports whether the handler handles the events of the type.
+	WantsEvent(ctx context.Context, eventType EventType) (bool, error)
 }
 
 // TriggerEvent triggers an event.
+//
+// The payload is validated against the schema of the event type;
+// the invalid events are logged and dropped.
+// The event is written to the database along with an outbox entry for
+// each registered handler; the handlers are invoked by the Dispatcher.
 func (s *EventService) TriggerEvent(ctx context.Context, event Event) {
 	ctx, span := tracer.Start(ctx, "TriggerEvent",
 		trace.WithAttributes(
@@ -56,7 +174,15 @@ func (s *EventService) TriggerEvent(ctx context.Context, event Event) {
 		))
 	defer span.End()
 
-	err := s.triggerEvent(ctx, event)
+	payload, err := ValidatePayload(event.Type, event.Payload)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid event payload")
+		span.RecordError(err)
+		slog.Error("failed to trigger event", "event_type", event.Type, "error", err)
+		return
+	}
+
+	err = s.triggerEvent(ctx, event, payload)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to trigger event")
 		span.RecordError(err)
@@ -65,27 +191,22 @@ func (s *EventService) TriggerEvent(ctx context.Context, event Event) {
 		span.SetStatus(otelcodes.Ok, "Event triggered successfully")
 	}
 
-	if s.posthogClient != nil {
-		span.AddEvent("posthog.send", trace.WithAttributes(
-			attribute.String("event.type", string(event.Type)),
-			attribute.Int("user.id", event.UserID),
-		))
-		slog.Debug("sending event to PostHog", "event_type", event.Type, "user_id", event.UserID)
-		err = s.posthogClient.Enqueue(posthog.Capture{
-			DistinctId: strconv.Itoa(event.UserID),
-			Event:      string(event.Type),
-			Timestamp:  time.Now(),
-			Properties: event.Payload,
-		})
-		if err != nil {
-			span.RecordError(err)
-			slog.Error("failed to send event to PostHog", "error", err)
-		}
+	span.AddEvent("analytics.capture")
+	slog.Debug("sending event to analytics sink", "event_type", event.Type, "user_id", event.UserID)
+	err = s.analytics.Capture(ctx, analytics.Event{
+		DistinctID: strconv.Itoa(event.UserID),
+		Name:       string(event.Type),
+		Timestamp:  time.Now(),
+		Properties: payload,
+	})
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to send event to analytics sink", "error", err)
 	}
 }
 
-// triggerEvent triggers an event synchronously.
-func (s *EventService) triggerEvent(ctx context.Context, event Event) error {
+// triggerEvent writes the event with the encoded payload and its outbox entries in a transaction.
+func (s *EventService) triggerEvent(ctx context.Context, event Event, payload map[string]any) error {
 	ctx, span := tracer.Start(ctx, "triggerEvent",
 		trace.WithAttributes(
 			attribute.String("event.type", string(event.Type)),
@@ -93,13 +214,32 @@ func (s *EventService) triggerEvent(ctx context.Context, event Event) error {
 		))
 	defer span.End()
 
-	span.AddEvent("database.event.create")
-	eventEntity, err := s.entClient.Event.Create().
-		SetType(string(event.Type)).
-		SetPayload(event.Payload).
-		SetUserID(event.UserID).
-		SetTriggeredAt(time.Now()).
-		Save(ctx)
+	handlers := s.handlersFor(ctx, event.Type)
+
+	var eventEntity *ent.Event
+	err := withTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		var err error
+
+		span.AddEvent("database.event.create")
+		eventEntity, err = tx.Event.Create().
+			SetType(string(event.Type)).
+			SetPayload(payload).
+			SetUserID(event.UserID).
+			SetTriggeredAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create event: %w", err)
+		}
+
+		span.AddEvent("database.outbox.create", trace.WithAttributes(
+			attribute.Int("handlers.count", len(handlers)),
+		))
+		if err := s.enqueue(ctx, tx.Client(), eventEntity.ID, handlers); err != nil {
+			return fmt.Errorf("create outbox entries: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to create event")
 		span.RecordError(err)
@@ -107,23 +247,53 @@ func (s *EventService) triggerEvent(ctx context.Context, event Event) error {
 	}
 
 	span.SetAttributes(attribute.Int("event.id", eventEntity.ID))
+	s.wakeDispatcher()
+
+	span.SetStatus(otelcodes.Ok, "Event triggered successfully")
+	return nil
+}
 
-	span.AddEvent("handlers.processing", trace.WithAttributes(
-		attribute.Int("handlers.count", len(s.handlers)),
-	))
+// enqueue creates the pending outbox entries of an event for the given handlers.
+func (s *EventService) enqueue(ctx context.Context, client *ent.Client, eventID int, handlers []string) error {
+	if len(handlers) == 0 {
+		return nil
+	}
 
-	for i, handler := range s.handlers {
-		span.AddEvent("handler.executing", trace.WithAttributes(
-			attribute.Int("handler.index", i),
-		))
-		err := handler.HandleEvent(ctx, eventEntity)
-		if err != nil {
-			span.SetStatus(otelcodes.Error, "Failed to handle event")
-			span.RecordError(err)
-			return err
+	builders := make([]*ent.EventOutboxCreate, len(handlers))
+	for i, handler := range handlers {
+		builders[i] = client.EventOutbox.Create().
+			SetEventID(eventID).
+			SetHandler(handler)
+	}
+
+	return client.EventOutbox.CreateBulk(builders...).Exec(ctx)
+}
+
+// wakeDispatcher notifies the dispatcher that there are new outbox entries.
+func (s *EventService) wakeDispatcher() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// withTx runs fn in a transaction, and rolls back if fn returns an error.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback transaction: %v", err, rerr)
 		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
 	}
 
-	span.SetStatus(otelcodes.Ok, "Event triggered successfully")
 	return nil
 }
@@ -0,0 +1,50 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/database-playground/backend-v2/internal/analytics"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink records the captured analytics events.
+type recordingSink struct {
+	analytics.NoopSink
+
+	events []analytics.Event
+}
+
+func (s *recordingSink) Capture(_ context.Context, event analytics.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestTriggerEvent_CapturesAnalytics(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	sink := &recordingSink{}
+	service := events.NewEventService(client, sink)
+
+	service.TriggerEvent(ctx, events.Event{
+		Type:    events.EventTypeLogin,
+		UserID:  userID,
+		Payload: events.LoginPayload{Machine: "test"},
+	})
+
+	require.Len(t, sink.events, 1)
+	require.Equal(t, string(events.EventTypeLogin), sink.events[0].Name)
+	require.Equal(t, map[string]any{"machine": "test"}, sink.events[0].Properties)
+
+	// The granted points are captured by the points handler.
+	_, err := events.NewDispatcher(service, events.WithWorkers(1)).DispatchPending(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, sink.events, 2)
+	require.Equal(t, string(events.EventTypeGrantPoint), sink.events[1].Name)
+	require.Equal(t, events.PointValueDailyLogin, sink.events[1].Properties["points"])
+}
@@ -0,0 +1,262 @@
+package events
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// Payload is the typed payload of an event.
+//
+// Every payload type is registered to its event type along with
+// a JSON schema, which TriggerEvent validates the payloads against.
+type Payload interface {
+	EventType() EventType
+}
+
+// LoginPayload is the payload of the "login" event.
+type LoginPayload struct {
+	// Machine is the machine name of the client the user logged in from.
+	Machine string `json:"machine"`
+}
+
+func (LoginPayload) EventType() EventType { return EventTypeLogin }
+
+// ImpersonatedPayload is the payload of the "impersonated" event.
+type ImpersonatedPayload struct {
+	ImpersonatorID int `json:"impersonator_id"`
+}
+
+func (ImpersonatedPayload) EventType() EventType { return EventTypeImpersonated }
+
+// SubmitAnswerPayload is the payload of the "submit_answer" event.
+type SubmitAnswerPayload struct {
+	SubmissionID int               `json:"submission_id"`
+	QuestionID   int               `json:"question_id"`
+	Status       submission.Status `json:"status"`
+}
+
+func (SubmitAnswerPayload) EventType() EventType { return EventTypeSubmitAnswer }
+
+// ProctoringSignal is the kind of a proctoring signal detected by the client.
+type ProctoringSignal string
+
+const (
+	// ProctoringSignalTabSwitch is sent when the user switches to another tab.
+	ProctoringSignalTabSwitch ProctoringSignal = "tab_switch"
+	// ProctoringSignalPaste is sent when the user pastes into the editor.
+	ProctoringSignalPaste ProctoringSignal = "paste"
+	// ProctoringSignalFocusLoss is sent when the window loses the focus.
+	ProctoringSignalFocusLoss ProctoringSignal = "focus_loss"
+)
+
+// ProctoringSignals are all the kinds of the proctoring signals.
+var ProctoringSignals = []ProctoringSignal{ProctoringSignalTabSwitch, ProctoringSignalPaste, ProctoringSignalFocusLoss}
+
+// IsValid returns whether the signal is one of ProctoringSignals.
+func (s ProctoringSignal) IsValid() bool {
+	return slices.Contains(ProctoringSignals, s)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (s ProctoringSignal) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(string(s)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (s *ProctoringSignal) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", v)
+	}
+
+	*s = ProctoringSignal(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid ProctoringSignal", str)
+	}
+	return nil
+}
+
+// ProctoringSignalPayload is the payload of the "proctoring_signal" event.
+type ProctoringSignalPayload struct {
+	Signal ProctoringSignal `json:"signal"`
+	// OccurredAt is the time the client detected the signal.
+	OccurredAt time.Time `json:"occurred_at"`
+	// QuestionID is the question the user was answering, if any.
+	QuestionID *int `json:"question_id,omitempty"`
+	// DurationMs is how long the user was away, for the tab switches and the focus losses.
+	DurationMs *int `json:"duration_ms,omitempty"`
+	// Length is the number of the pasted characters, for the pastes.
+	Length *int `json:"length,omitempty"`
+}
+
+func (ProctoringSignalPayload) EventType() EventType { return EventTypeProctoringSignal }
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// emptyPayloadSchema is the schema of the event types without payload.
+const emptyPayloadSchema = `{"type": "object", "maxProperties": 0}`
+
+// eventTypeDefinition is the registered definition of an event type.
+type eventTypeDefinition struct {
+	schema *jsonschema.Schema
+	// decode decodes the stored payload. It is nil if the event type has no payload.
+	decode func(data []byte) (Payload, error)
+}
+
+// eventTypes is the registry of the event types which can be triggered.
+var eventTypes = map[EventType]eventTypeDefinition{}
+
+func init() {
+	registerEventType[LoginPayload](EventTypeLogin, "schemas/login.json")
+	registerEventType[ImpersonatedPayload](EventTypeImpersonated, "schemas/impersonated.json")
+	registerEventTypeWithoutPayload(EventTypeLogout)
+	registerEventTypeWithoutPayload(EventTypeLogoutAll)
+	registerEventType[SubmitAnswerPayload](EventTypeSubmitAnswer, "schemas/submit_answer.json")
+	registerEventType[ProctoringSignalPayload](EventTypeProctoringSignal, "schemas/proctoring_signal.json")
+}
+
+// registerEventType registers an event type with the payload type P
+// and the JSON schema embedded at schemaPath.
+func registerEventType[P Payload](eventType EventType, schemaPath string) {
+	var zero P
+	if zero.EventType() != eventType {
+		panic(fmt.Sprintf("payload %T is not for event type %q", zero, eventType))
+	}
+
+	data, err := schemaFS.ReadFile(schemaPath)
+	if err != nil {
+		panic(fmt.Sprintf("read schema of %q: %v", eventType, err))
+	}
+
+	eventTypes[eventType] = eventTypeDefinition{
+		schema: mustCompileSchema(eventType, data),
+		decode: func(data []byte) (Payload, error) {
+			var payload P
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return nil, err
+			}
+
+			return payload, nil
+		},
+	}
+}
+
+// registerEventTypeWithoutPayload registers an event type which has no payload.
+func registerEventTypeWithoutPayload(eventType EventType) {
+	eventTypes[eventType] = eventTypeDefinition{
+		schema: mustCompileSchema(eventType, []byte(emptyPayloadSchema)),
+	}
+}
+
+func mustCompileSchema(eventType EventType, data []byte) *jsonschema.Schema {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		panic(fmt.Sprintf("parse schema of %q: %v", eventType, err))
+	}
+
+	url := "https://dbplay.app/schemas/events/" + string(eventType) + ".json"
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, doc); err != nil {
+		panic(fmt.Sprintf("add schema of %q: %v", eventType, err))
+	}
+
+	schema, err := compiler.Compile(url)
+	if err != nil {
+		panic(fmt.Sprintf("compile schema of %q: %v", eventType, err))
+	}
+
+	return schema
+}
+
+// EventTypes returns the registered event types, sorted by name.
+func EventTypes() []EventType {
+	types := make([]EventType, 0, len(eventTypes))
+	for t := range eventTypes {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
+// ValidatePayload validates the payload of the event type against its schema,
+// and returns the payload encoded as a map to be stored.
+//
+// A nil payload is treated as an empty object.
+func ValidatePayload(eventType EventType, payload Payload) (map[string]any, error) {
+	definition, ok := eventTypes[eventType]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+	if payload != nil && payload.EventType() != eventType {
+		return nil, fmt.Errorf("payload %T is not for event type %q", payload, eventType)
+	}
+
+	data := []byte("{}")
+	if payload != nil {
+		var err error
+		data, err = json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("marshal payload: %w", err)
+		}
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+	if err := definition.schema.Validate(doc); err != nil {
+		return nil, fmt.Errorf("invalid payload of %q: %w", eventType, err)
+	}
+
+	var encoded map[string]any
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return encoded, nil
+}
+
+// ErrUnknownEventType is returned when the event type is not registered,
+// e.g. the type of a legacy event.
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// DecodePayload decodes the stored payload of the event to its typed payload.
+//
+// It returns nil if the event type has no payload, and ErrUnknownEventType
+// if the event type is not registered.
+func DecodePayload(event *ent.Event) (Payload, error) {
+	definition, ok := eventTypes[EventType(event.Type)]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEventType, event.Type)
+	}
+	if definition.decode == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	payload, err := definition.decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode payload of %q: %w", event.Type, err)
+	}
+
+	return payload, nil
+}
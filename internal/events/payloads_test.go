@@ -0,0 +1,176 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePayload(t *testing.T) {
+	encoded, err := events.ValidatePayload(events.EventTypeSubmitAnswer, events.SubmitAnswerPayload{
+		SubmissionID: 1,
+		QuestionID:   2,
+		Status:       submission.StatusSuccess,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"submission_id": float64(1),
+		"question_id":   float64(2),
+		"status":        "success",
+	}, encoded)
+
+	encoded, err = events.ValidatePayload(events.EventTypeLogout, nil)
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+}
+
+func TestValidatePayload_Invalid(t *testing.T) {
+	testCases := []struct {
+		name      string
+		eventType events.EventType
+		payload   events.Payload
+		err       string
+	}{
+		{
+			name:      "unknown event type",
+			eventType: "unknown",
+			err:       `unknown event type "unknown"`,
+		},
+		{
+			name:      "mismatched payload type",
+			eventType: events.EventTypeLogin,
+			payload:   events.ImpersonatedPayload{ImpersonatorID: 1},
+			err:       "is not for event type",
+		},
+		{
+			name:      "payload for event type without payload",
+			eventType: events.EventTypeLogout,
+			payload:   events.LoginPayload{Machine: "test"},
+			err:       "is not for event type",
+		},
+		{
+			name:      "missing payload",
+			eventType: events.EventTypeImpersonated,
+			err:       "missing property 'impersonator_id'",
+		},
+		{
+			name:      "violated constraint",
+			eventType: events.EventTypeSubmitAnswer,
+			payload: events.SubmitAnswerPayload{
+				QuestionID: 1,
+				Status:     submission.StatusFailed,
+			},
+			err: "submission_id",
+		},
+		{
+			name:      "invalid enum",
+			eventType: events.EventTypeSubmitAnswer,
+			payload: events.SubmitAnswerPayload{
+				SubmissionID: 1,
+				QuestionID:   1,
+				Status:       "unknown",
+			},
+			err: "status",
+		},
+		{
+			name:      "invalid proctoring signal",
+			eventType: events.EventTypeProctoringSignal,
+			payload: events.ProctoringSignalPayload{
+				Signal:     "copy",
+				OccurredAt: time.Now(),
+			},
+			err: "signal",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := events.ValidatePayload(tc.eventType, tc.payload)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestDecodePayload(t *testing.T) {
+	// The payloads read from the database have float64 numbers.
+	payload, err := events.DecodePayload(&ent.Event{
+		Type: string(events.EventTypeSubmitAnswer),
+		Payload: map[string]any{
+			"submission_id": float64(1),
+			"question_id":   float64(2),
+			"status":        "failed",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, events.SubmitAnswerPayload{
+		SubmissionID: 1,
+		QuestionID:   2,
+		Status:       submission.StatusFailed,
+	}, payload)
+
+	payload, err = events.DecodePayload(&ent.Event{Type: string(events.EventTypeLogoutAll)})
+	require.NoError(t, err)
+	require.Nil(t, payload)
+
+	_, err = events.DecodePayload(&ent.Event{Type: "unknown"})
+	require.Error(t, err)
+}
+
+func TestDecodePayload_ProctoringSignal(t *testing.T) {
+	occurredAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	durationMs := 1500
+
+	expected := events.ProctoringSignalPayload{
+		Signal:     events.ProctoringSignalTabSwitch,
+		OccurredAt: occurredAt,
+		DurationMs: &durationMs,
+	}
+	encoded, err := events.ValidatePayload(events.EventTypeProctoringSignal, expected)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"signal":      "tab_switch",
+		"occurred_at": "2025-03-01T12:00:00Z",
+		"duration_ms": float64(1500),
+	}, encoded)
+
+	payload, err := events.DecodePayload(&ent.Event{
+		Type:    string(events.EventTypeProctoringSignal),
+		Payload: encoded,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, payload)
+}
+
+func TestTriggerEvent_DropsInvalidPayload(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	service := events.NewEventService(client, nil)
+	service.TriggerEvent(ctx, events.Event{
+		Type:    events.EventTypeImpersonated,
+		Payload: events.ImpersonatedPayload{},
+		UserID:  userID,
+	})
+
+	count, err := client.Event.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestEventTypes(t *testing.T) {
+	require.Equal(t, []events.EventType{
+		events.EventTypeImpersonated,
+		events.EventTypeLogin,
+		events.EventTypeLogout,
+		events.EventTypeLogoutAll,
+		events.EventTypeProctoringSignal,
+		events.EventTypeSubmitAnswer,
+	}, events.EventTypes())
+}
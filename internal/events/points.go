@@ -13,7 +13,7 @@ import (
 	"github.com/database-playground/backend-v2/ent/question"
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
-	"github.com/posthog/posthog-go"
+	"github.com/database-playground/backend-v2/internal/analytics"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -25,9 +25,10 @@ func startOfDay(t time.Time) time.Time {
 	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
 }
 
-// startOfToday returns the start of today (midnight).
-func startOfToday() time.Time {
-	return startOfDay(time.Now())
+// dayOf returns the start and the end of the day of the given time in the location.
+func dayOf(t time.Time, location *time.Location) (time.Time, time.Time) {
+	start := startOfDay(t.In(location))
+	return start, start.AddDate(0, 0, 1)
 }
 
 const (
@@ -50,20 +51,43 @@ const (
 
 // PointsGranter determines if the criteria is met to grant points to a user.
 type PointsGranter struct {
-	entClient     *ent.Client
-	posthogClient posthog.Client
+	entClient *ent.Client
+	analytics analytics.Sink
+	location  *time.Location
+}
+
+type PointsGranterOption func(*PointsGranter)
+
+// WithGranterLocation sets the location in which the days of the daily and
+// weekly points are calculated. Defaults to time.Local.
+func WithGranterLocation(location *time.Location) PointsGranterOption {
+	return func(d *PointsGranter) {
+		d.location = location
+	}
 }
 
 // NewPointsGranter creates a new PointsGranter.
-func NewPointsGranter(entClient *ent.Client, posthogClient posthog.Client) *PointsGranter {
-	return &PointsGranter{
-		entClient:     entClient,
-		posthogClient: posthogClient,
+//
+// The granted points are sent to the analytics sink. A nil sink discards them.
+func NewPointsGranter(entClient *ent.Client, sink analytics.Sink, opts ...PointsGranterOption) *PointsGranter {
+	if sink == nil {
+		sink = analytics.NoopSink{}
+	}
+
+	d := &PointsGranter{
+		entClient: entClient,
+		analytics: sink,
+		location:  time.Local,
+	}
+	for _, opt := range opts {
+		opt(d)
 	}
+
+	return d
 }
 
 // HandleEvent handles the event creation.
-func (d *PointsGranter) HandleEvent(ctx context.Context, event *ent.Event) error {
+func (d *PointsGranter) HandleEvent(ctx context.Context, event *ent.Event, payload Payload) error {
 	ctx, span := tracer.Start(ctx, "HandleEvent",
 		trace.WithAttributes(
 			attribute.String("event.type", event.Type),
@@ -75,7 +99,7 @@ func (d *PointsGranter) HandleEvent(ctx context.Context, event *ent.Event) error
 	switch event.Type {
 	case string(EventTypeLogin):
 		span.AddEvent("points.daily_login")
-		ok, err := d.GrantDailyLoginPoints(ctx, event.UserID)
+		ok, err := d.GrantDailyLoginPoints(ctx, event.UserID, event.TriggeredAt)
 		if err != nil {
 			span.SetStatus(otelcodes.Error, "Failed to grant daily login points")
 			span.RecordError(err)
@@ -91,7 +115,12 @@ func (d *PointsGranter) HandleEvent(ctx context.Context, event *ent.Event) error
 		return nil
 	case string(EventTypeSubmitAnswer):
 		span.AddEvent("points.submit_answer")
-		err := d.handleSubmitAnswerEvent(ctx, event)
+		submitAnswerPayload, ok := payload.(SubmitAnswerPayload)
+		if !ok {
+			span.SetStatus(otelcodes.Error, "Invalid submit answer payload")
+			return fmt.Errorf("unexpected payload type %T for %q", payload, event.Type)
+		}
+		err := d.handleSubmitAnswerEvent(ctx, event, submitAnswerPayload)
 		if err != nil {
 			span.SetStatus(otelcodes.Error, "Failed to handle submit answer event")
 			span.RecordError(err)
@@ -106,7 +135,7 @@ func (d *PointsGranter) HandleEvent(ctx context.Context, event *ent.Event) error
 }
 
 // handleSubmitAnswerEvent handles the submit answer event and grants appropriate points.
-func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.Event) error {
+func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.Event, payload SubmitAnswerPayload) error {
 	ctx, span := tracer.Start(ctx, "handleSubmitAnswerEvent",
 		trace.WithAttributes(
 			attribute.Int("user.id", event.UserID),
@@ -114,29 +143,8 @@ func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.
 		))
 	defer span.End()
 
-	// Extract submission_id and question_id from payload
-	span.AddEvent("payload.extraction")
-	var submissionID int
-	switch v := event.Payload["submission_id"].(type) {
-	case float64:
-		submissionID = int(v)
-	case int:
-		submissionID = v
-	default:
-		span.SetStatus(otelcodes.Error, "submission_id not found in payload or has invalid type")
-		return fmt.Errorf("submission_id not found in payload or has invalid type")
-	}
-
-	var questionID int
-	switch v := event.Payload["question_id"].(type) {
-	case float64:
-		questionID = int(v)
-	case int:
-		questionID = v
-	default:
-		span.SetStatus(otelcodes.Error, "question_id not found in payload or has invalid type")
-		return fmt.Errorf("question_id not found in payload or has invalid type")
-	}
+	submissionID := payload.SubmissionID
+	questionID := payload.QuestionID
 
 	span.SetAttributes(
 		attribute.Int("submission.id", submissionID),
@@ -156,7 +164,7 @@ func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.
 
 	// Grant first attempt points (regardless of correctness)
 	span.AddEvent("points.first_attempt.checking")
-	ok, err := d.GrantFirstAttemptPoints(ctx, event.UserID, questionID)
+	ok, err := d.GrantFirstAttemptPoints(ctx, event.UserID, questionID, submissionID)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant first attempt points")
 		span.RecordError(err)
@@ -171,7 +179,7 @@ func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.
 
 	// Grant daily attempt points
 	span.AddEvent("points.daily_attempt.checking")
-	ok, err = d.GrantDailyAttemptPoints(ctx, event.UserID)
+	ok, err = d.GrantDailyAttemptPoints(ctx, event.UserID, event.TriggeredAt)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant daily attempt points")
 		span.RecordError(err)
@@ -225,22 +233,27 @@ func (d *PointsGranter) handleSubmitAnswerEvent(ctx context.Context, event *ent.
 	return nil
 }
 
-// GrantDailyLoginPoints grants the "daily login" points to a user.
-func (d *PointsGranter) GrantDailyLoginPoints(ctx context.Context, userID int) (bool, error) {
+// GrantDailyLoginPoints grants the "daily login" points to a user
+// for the day of the login at the given time.
+//
+// The day is taken from the login instead of the current time, so that
+// a delayed or retried login event still grants the points of its day.
+func (d *PointsGranter) GrantDailyLoginPoints(ctx context.Context, userID int, at time.Time) (bool, error) {
 	ctx, span := tracer.Start(ctx, "GrantDailyLoginPoints",
 		trace.WithAttributes(
 			attribute.Int("user.id", userID),
+			attribute.String("login.at", at.Format(time.RFC3339)),
 		))
 	defer span.End()
 
-	today := startOfToday()
+	dayStart, dayEnd := dayOf(at, d.location)
 
-	// Check if we have granted the "daily login" points for this user today.
+	// Check if we have granted the "daily login" points for this user on the day.
 	span.AddEvent("database.point.check")
 	hasPointsRecord, err := d.entClient.Point.Query().
 		Where(point.HasUserWith(user.ID(userID))).
 		Where(point.DescriptionEQ(PointDescriptionDailyLogin)).
-		Where(point.GrantedAtGTE(today)).Exist(ctx)
+		Where(point.GrantedAtGTE(dayStart), point.GrantedAtLT(dayEnd)).Exist(ctx)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check existing points")
 		span.RecordError(err)
@@ -251,12 +264,12 @@ func (d *PointsGranter) GrantDailyLoginPoints(ctx context.Context, userID int) (
 		return false, nil
 	}
 
-	// Check if the user has logged in today.
+	// Check if the user has logged in on the day.
 	span.AddEvent("database.event.check")
 	hasTodayLoginRecord, err := d.entClient.Event.Query().
 		Where(event.Type(string(EventTypeLogin))).
 		Where(event.UserID(userID)).
-		Where(event.TriggeredAtGTE(today)).
+		Where(event.TriggeredAtGTE(dayStart), event.TriggeredAtLT(dayEnd)).
 		Exist(ctx)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check login event")
@@ -270,12 +283,16 @@ func (d *PointsGranter) GrantDailyLoginPoints(ctx context.Context, userID int) (
 
 	// Grant the "daily login" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, 0, PointDescriptionDailyLogin, PointValueDailyLogin)
+	granted, err := d.grantPoint(ctx, userID, 0, PointDescriptionDailyLogin, dailyPointKey(userID, PointDescriptionDailyLogin, dayStart), PointValueDailyLogin, at)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant daily login points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "Daily login points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "Daily login points granted successfully")
 	return true, nil
@@ -290,7 +307,8 @@ func (d *PointsGranter) GrantWeeklyLoginPoints(ctx context.Context, userID int)
 	defer span.End()
 
 	// Calculate the start of 6 days ago (start of the 7-day period)
-	sevenDaysAgo := startOfDay(time.Now().AddDate(0, 0, -6))
+	today := startOfDay(time.Now().In(d.location))
+	sevenDaysAgo := today.AddDate(0, 0, -6)
 
 	// Check if we have granted the "weekly login" points for this user this week.
 	span.AddEvent("database.point.check")
@@ -325,7 +343,7 @@ func (d *PointsGranter) GrantWeeklyLoginPoints(ctx context.Context, userID int)
 	span.AddEvent("login.days.aggregation")
 	distinctLoginDays := make(map[time.Time]int)
 	for _, record := range weekLoginRecords {
-		distinctLoginDays[startOfDay(record.TriggeredAt)]++
+		distinctLoginDays[startOfDay(record.TriggeredAt.In(d.location))]++
 	}
 
 	span.SetAttributes(
@@ -340,24 +358,30 @@ func (d *PointsGranter) GrantWeeklyLoginPoints(ctx context.Context, userID int)
 
 	// Grant the "weekly login" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, 0, PointDescriptionWeeklyLogin, PointValueWeeklyLogin)
+	granted, err := d.grantPoint(ctx, userID, 0, PointDescriptionWeeklyLogin, dailyPointKey(userID, PointDescriptionWeeklyLogin, today), PointValueWeeklyLogin, time.Now())
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant weekly login points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "Weekly login points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "Weekly login points granted successfully")
 	return true, nil
 }
 
 // GrantFirstAttemptPoints grants the "first attempt" points to a user.
-// This is awarded when a user attempts a question for the first time.
-func (d *PointsGranter) GrantFirstAttemptPoints(ctx context.Context, userID int, questionID int) (bool, error) {
+// This is awarded when the given submission is the first attempt of the
+// user on the question, even if the later submissions have been made.
+func (d *PointsGranter) GrantFirstAttemptPoints(ctx context.Context, userID int, questionID int, submissionID int) (bool, error) {
 	ctx, span := tracer.Start(ctx, "GrantFirstAttemptPoints",
 		trace.WithAttributes(
 			attribute.Int("user.id", userID),
 			attribute.Int("question.id", questionID),
+			attribute.Int("submission.id", submissionID),
 		))
 	defer span.End()
 
@@ -378,53 +402,60 @@ func (d *PointsGranter) GrantFirstAttemptPoints(ctx context.Context, userID int,
 		return false, nil
 	}
 
-	// Check if this is the user's first submission for this question.
-	span.AddEvent("database.submission.count")
-	submissionCount, err := d.entClient.Submission.Query().
+	// Check if the user has submitted for this question before the submission.
+	// The submission IDs increase in the order of the submissions.
+	span.AddEvent("database.submission.check")
+	hasEarlierSubmission, err := d.entClient.Submission.Query().
 		Where(submission.HasUserWith(user.ID(userID))).
 		Where(submission.HasQuestionWith(question.IDEQ(questionID))).
-		Count(ctx)
+		Where(submission.IDLT(submissionID)).
+		Exist(ctx)
 	if err != nil {
-		span.SetStatus(otelcodes.Error, "Failed to count submissions")
+		span.SetStatus(otelcodes.Error, "Failed to check earlier submissions")
 		span.RecordError(err)
 		return false, err
 	}
-	span.SetAttributes(attribute.Int("submission.count", submissionCount))
-	if submissionCount != 1 {
+	if hasEarlierSubmission {
 		span.SetStatus(otelcodes.Ok, "Not the first submission")
 		return false, nil
 	}
 
 	// Grant the "first attempt" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, questionID, description, PointValueFirstAttempt)
+	granted, err := d.grantPoint(ctx, userID, questionID, description, pointKey(userID, description), PointValueFirstAttempt, time.Now())
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant first attempt points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "First attempt points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "First attempt points granted successfully")
 	return true, nil
 }
 
 // GrantDailyAttemptPoints grants the "daily attempt" points to a user.
-// This is awarded when a user attempts any question today.
-func (d *PointsGranter) GrantDailyAttemptPoints(ctx context.Context, userID int) (bool, error) {
+// This is awarded when a user attempts any question on the day of the
+// attempt at the given time.
+func (d *PointsGranter) GrantDailyAttemptPoints(ctx context.Context, userID int, at time.Time) (bool, error) {
 	ctx, span := tracer.Start(ctx, "GrantDailyAttemptPoints",
 		trace.WithAttributes(
 			attribute.Int("user.id", userID),
+			attribute.String("attempt.at", at.Format(time.RFC3339)),
 		))
 	defer span.End()
 
-	today := startOfToday()
+	dayStart, dayEnd := dayOf(at, d.location)
 
-	// Check if we have granted the "daily attempt" points for this user today.
+	// Check if we have granted the "daily attempt" points for this user on the day.
 	span.AddEvent("database.point.check")
 	hasPointsRecord, err := d.entClient.Point.Query().
 		Where(point.HasUserWith(user.ID(userID))).
 		Where(point.DescriptionEQ(PointDescriptionDailyAttempt)).
-		Where(point.GrantedAtGTE(today)).
+		Where(point.GrantedAtGTE(dayStart), point.GrantedAtLT(dayEnd)).
 		Exist(ctx)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check existing points")
@@ -436,12 +467,12 @@ func (d *PointsGranter) GrantDailyAttemptPoints(ctx context.Context, userID int)
 		return false, nil
 	}
 
-	// Check if the user has submitted any answer today.
+	// Check if the user has submitted any answer on the day.
 	span.AddEvent("database.event.check")
 	hasSubmittedToday, err := d.entClient.Event.Query().
 		Where(event.Type(string(EventTypeSubmitAnswer))).
 		Where(event.UserID(userID)).
-		Where(event.TriggeredAtGTE(today)).
+		Where(event.TriggeredAtGTE(dayStart), event.TriggeredAtLT(dayEnd)).
 		Exist(ctx)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to check submit answer event")
@@ -455,12 +486,16 @@ func (d *PointsGranter) GrantDailyAttemptPoints(ctx context.Context, userID int)
 
 	// Grant the "daily attempt" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, 0, PointDescriptionDailyAttempt, PointValueDailyAttempt)
+	granted, err := d.grantPoint(ctx, userID, 0, PointDescriptionDailyAttempt, dailyPointKey(userID, PointDescriptionDailyAttempt, dayStart), PointValueDailyAttempt, at)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant daily attempt points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "Daily attempt points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "Daily attempt points granted successfully")
 	return true, nil
@@ -512,12 +547,16 @@ func (d *PointsGranter) GrantCorrectAnswerPoints(ctx context.Context, userID int
 
 	// Grant the "correct answer" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, questionID, description, PointValueCorrectAnswer)
+	granted, err := d.grantPoint(ctx, userID, questionID, description, pointKey(userID, description), PointValueCorrectAnswer, time.Now())
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant correct answer points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "Correct answer points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "Correct answer points granted successfully")
 	return true, nil
@@ -582,18 +621,40 @@ func (d *PointsGranter) GrantFirstPlacePoints(ctx context.Context, userID int, q
 
 	// Grant the "first place" points to the user.
 	span.AddEvent("points.granting")
-	err = d.grantPoint(ctx, userID, questionID, description, PointValueFirstPlace)
+	granted, err := d.grantPoint(ctx, userID, questionID, description, description, PointValueFirstPlace, time.Now())
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to grant first place points")
 		span.RecordError(err)
 		return false, err
 	}
+	if !granted {
+		span.SetStatus(otelcodes.Ok, "First place points already granted")
+		return false, nil
+	}
 
 	span.SetStatus(otelcodes.Ok, "First place points granted successfully")
 	return true, nil
 }
 
-func (d *PointsGranter) grantPoint(ctx context.Context, userID int, questionID int, description string, points int) error {
+// pointKey returns the idempotency key of the points granted once to the user.
+func pointKey(userID int, description string) string {
+	return strconv.Itoa(userID) + ":" + description
+}
+
+// dailyPointKey returns the idempotency key of the points granted once a day
+// to the user.
+func dailyPointKey(userID int, description string, day time.Time) string {
+	return pointKey(userID, description) + ":" + day.Format(time.DateOnly)
+}
+
+// grantPoint grants the points to a user at the given time. The daily
+// points are granted at the time of their event, so that they are
+// counted in the day of the event.
+//
+// The key is unique among the points, so the grants checked at the same
+// time (e.g. by concurrent or retried dispatches) are only made once.
+// It returns false if the points with the key have been granted.
+func (d *PointsGranter) grantPoint(ctx context.Context, userID int, questionID int, description string, key string, points int, grantedAt time.Time) (bool, error) {
 	ctx, span := tracer.Start(ctx, "grantPoint",
 		trace.WithAttributes(
 			attribute.Int("user.id", userID),
@@ -611,52 +672,59 @@ func (d *PointsGranter) grantPoint(ctx context.Context, userID int, questionID i
 		SetUserID(userID).
 		SetDescription(description).
 		SetPoints(points).
+		SetGrantedAt(grantedAt).
+		SetIdempotencyKey(key).
 		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Only the existing key means granted; the other constraints (e.g. a deleted user) are errors.
+		granted, existErr := d.entClient.Point.Query().Where(point.IdempotencyKey(key)).Exist(ctx)
+		if existErr == nil && granted {
+			span.AddEvent("database.point.already_granted")
+			span.SetStatus(otelcodes.Ok, "Points already granted")
+			return false, nil
+		}
+	}
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to create point")
 		span.RecordError(err)
 
-		if d.posthogClient != nil {
-			span.AddEvent("posthog.exception.sending")
-			err = d.posthogClient.Enqueue(posthog.NewDefaultException(
-				time.Now(), strconv.Itoa(userID),
-				"failed to grant point", err.Error(),
-			))
-			if err != nil {
-				span.RecordError(err)
-				slog.Error("failed to send event to PostHog", "error", err)
-			}
+		span.AddEvent("analytics.exception.capture")
+		if captureErr := d.analytics.CaptureException(ctx, analytics.Exception{
+			DistinctID:  strconv.Itoa(userID),
+			Title:       "failed to grant point",
+			Description: err.Error(),
+			Timestamp:   time.Now(),
+		}); captureErr != nil {
+			span.RecordError(captureErr)
+			slog.Error("failed to send exception to analytics sink", "error", captureErr)
 		}
 
-		return err
+		return false, err
 	}
 
 	span.AddEvent("database.point.created")
 
-	if d.posthogClient != nil {
-		span.AddEvent("posthog.capture")
-		properties := posthog.NewProperties().
-			Set("description", description).
-			Set("points", points)
-
-		if questionID != 0 {
-			properties.Set("questionID", strconv.Itoa(questionID))
-		}
-
-		slog.Debug("sending event to PostHog", "event_type", EventTypeGrantPoint, "user_id", userID)
+	span.AddEvent("analytics.capture")
+	properties := map[string]any{
+		"description": description,
+		"points":      points,
+	}
+	if questionID != 0 {
+		properties["questionID"] = strconv.Itoa(questionID)
+	}
 
-		err = d.posthogClient.Enqueue(posthog.Capture{
-			DistinctId: strconv.Itoa(userID),
-			Event:      string(EventTypeGrantPoint),
-			Timestamp:  time.Now(),
-			Properties: properties,
-		})
-		if err != nil {
-			span.RecordError(err)
-			slog.Error("failed to send event to PostHog", "error", err)
-		}
+	slog.Debug("sending event to analytics sink", "event_type", EventTypeGrantPoint, "user_id", userID)
+	err = d.analytics.Capture(ctx, analytics.Event{
+		DistinctID: strconv.Itoa(userID),
+		Name:       string(EventTypeGrantPoint),
+		Timestamp:  time.Now(),
+		Properties: properties,
+	})
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to send event to analytics sink", "error", err)
 	}
 
 	span.SetStatus(otelcodes.Ok, "Point granted successfully")
-	return nil
+	return true, nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/hook"
 	"github.com/database-playground/backend-v2/ent/point"
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
@@ -38,6 +39,16 @@ func setupTestData(t *testing.T, client *ent.Client) int {
 	return user.ID
 }
 
+// handleEvent handles the event with its decoded payload, like the Dispatcher does.
+func handleEvent(ctx context.Context, granter *events.PointsGranter, event *ent.Event) error {
+	payload, err := events.DecodePayload(event)
+	if err != nil {
+		return err
+	}
+
+	return granter.HandleEvent(ctx, event, payload)
+}
+
 // createLoginEvent creates a login event for the user at the specified time
 func createLoginEvent(t *testing.T, client *ent.Client, userID int, triggeredAt time.Time) {
 	t.Helper()
@@ -80,7 +91,7 @@ func TestGrantDailyLoginPoints_Success(t *testing.T) {
 	createLoginEvent(t, client, userID, now)
 
 	// Grant daily login points
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted)
 
@@ -109,7 +120,7 @@ func TestGrantDailyLoginPoints_AlreadyGrantedToday(t *testing.T) {
 	createPointsRecord(t, client, userID, events.PointDescriptionDailyLogin, events.PointValueDailyLogin, now)
 
 	// Attempt to grant daily login points again
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -134,7 +145,7 @@ func TestGrantDailyLoginPoints_NoLoginToday(t *testing.T) {
 	createLoginEvent(t, client, userID, yesterday)
 
 	// Attempt to grant daily login points
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -163,7 +174,7 @@ func TestGrantDailyLoginPoints_OldPointsRecordExists(t *testing.T) {
 	createPointsRecord(t, client, userID, events.PointDescriptionDailyLogin, events.PointValueDailyLogin, twoDaysAgo)
 
 	// Grant daily login points should succeed since old record is outside 24 hour window
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted)
 
@@ -397,7 +408,7 @@ func TestGrantDailyLoginPoints_NonExistentUser(t *testing.T) {
 
 	// Attempt to grant points for non-existent user should not fail during the query phase
 	// but should return false since there's no login event
-	granted, err := granter.GrantDailyLoginPoints(ctx, nonExistentUserID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, nonExistentUserID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted)
 }
@@ -501,10 +512,10 @@ func TestGrantFirstAttemptPoints_Success(t *testing.T) {
 	questionID := createQuestion(t, client, databaseID)
 
 	// Create the first submission for this user on this question
-	createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
+	submissionID := createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
 
 	// Grant first attempt points
-	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID)
+	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID, submissionID)
 	require.NoError(t, err)
 	require.True(t, granted)
 
@@ -529,15 +540,15 @@ func TestGrantFirstAttemptPoints_AlreadyGranted(t *testing.T) {
 	questionID := createQuestion(t, client, databaseID)
 
 	// Create a submission
-	createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
+	submissionID := createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
 
 	// Grant points once
-	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID)
+	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID, submissionID)
 	require.NoError(t, err)
 	require.True(t, granted)
 
 	// Attempt to grant again
-	granted, err = granter.GrantFirstAttemptPoints(ctx, userID, questionID)
+	granted, err = granter.GrantFirstAttemptPoints(ctx, userID, questionID, submissionID)
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -562,10 +573,10 @@ func TestGrantFirstAttemptPoints_SecondSubmission(t *testing.T) {
 
 	// Create two submissions
 	createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
-	createSubmission(t, client, userID, questionID, submission.StatusSuccess, now.Add(time.Minute))
+	secondSubmissionID := createSubmission(t, client, userID, questionID, submission.StatusSuccess, now.Add(time.Minute))
 
-	// Attempt to grant first attempt points (should fail because there are 2 submissions)
-	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID)
+	// Attempt to grant first attempt points for the second submission (should fail because it is not the first)
+	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID, secondSubmissionID)
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -577,6 +588,26 @@ func TestGrantFirstAttemptPoints_SecondSubmission(t *testing.T) {
 	require.Len(t, pointsRecords, 0)
 }
 
+func TestGrantFirstAttemptPoints_LaterSubmissionExists(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	granter := events.NewPointsGranter(client, nil)
+	userID := setupTestData(t, client)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	databaseID := createDatabase(t, client)
+	questionID := createQuestion(t, client, databaseID)
+
+	// The second submission lands before the event of the first one is dispatched
+	firstSubmissionID := createSubmission(t, client, userID, questionID, submission.StatusFailed, now)
+	createSubmission(t, client, userID, questionID, submission.StatusSuccess, now.Add(time.Minute))
+
+	granted, err := granter.GrantFirstAttemptPoints(ctx, userID, questionID, firstSubmissionID)
+	require.NoError(t, err)
+	require.True(t, granted)
+}
+
 func TestGrantDailyAttemptPoints_Success(t *testing.T) {
 	client := testhelper.NewEntSqliteClient(t)
 	granter := events.NewPointsGranter(client, nil)
@@ -593,7 +624,7 @@ func TestGrantDailyAttemptPoints_Success(t *testing.T) {
 	createSubmitAnswerEvent(t, client, userID, submissionID, questionID, now)
 
 	// Grant daily attempt points
-	granted, err := granter.GrantDailyAttemptPoints(ctx, userID)
+	granted, err := granter.GrantDailyAttemptPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted)
 
@@ -626,7 +657,7 @@ func TestGrantDailyAttemptPoints_AlreadyGrantedToday(t *testing.T) {
 	createPointsRecord(t, client, userID, events.PointDescriptionDailyAttempt, events.PointValueDailyAttempt, now)
 
 	// Attempt to grant daily attempt points again
-	granted, err := granter.GrantDailyAttemptPoints(ctx, userID)
+	granted, err := granter.GrantDailyAttemptPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -655,7 +686,7 @@ func TestGrantDailyAttemptPoints_NoSubmissionToday(t *testing.T) {
 	createSubmitAnswerEvent(t, client, userID, submissionID, questionID, twoDaysAgo)
 
 	// Attempt to grant daily attempt points
-	granted, err := granter.GrantDailyAttemptPoints(ctx, userID)
+	granted, err := granter.GrantDailyAttemptPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted)
 
@@ -883,7 +914,7 @@ func TestHandleSubmitAnswerEvent_SuccessfulSubmission(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
-	err = granter.HandleEvent(ctx, event)
+	err = handleEvent(ctx, granter, event)
 	require.NoError(t, err)
 
 	// Verify all appropriate points were granted
@@ -927,7 +958,7 @@ func TestHandleSubmitAnswerEvent_FailedSubmission(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
-	err = granter.HandleEvent(ctx, event)
+	err = handleEvent(ctx, granter, event)
 	require.NoError(t, err)
 
 	// Verify only first attempt and daily attempt points were granted
@@ -969,7 +1000,7 @@ func TestHandleSubmitAnswerEvent_SecondAttemptSuccess(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
-	err = granter.HandleEvent(ctx, event1)
+	err = handleEvent(ctx, granter, event1)
 	require.NoError(t, err)
 
 	// Second attempt - success
@@ -985,7 +1016,7 @@ func TestHandleSubmitAnswerEvent_SecondAttemptSuccess(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
-	err = granter.HandleEvent(ctx, event2)
+	err = handleEvent(ctx, granter, event2)
 	require.NoError(t, err)
 
 	// Verify points were granted correctly
@@ -1027,7 +1058,7 @@ func TestGrantDailyLoginPoints_MidnightBoundary(t *testing.T) {
 	createLoginEvent(t, client, userID, todayMorning)
 
 	// Grant daily login points should succeed because the old record is from yesterday
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted, "Should grant points because yesterday's 11:59 PM and today's 12:01 AM are different days")
 
@@ -1040,6 +1071,43 @@ func TestGrantDailyLoginPoints_MidnightBoundary(t *testing.T) {
 	require.Len(t, pointsRecords, 2, "Should have two records: one from yesterday and one from today")
 }
 
+// TestHandleLoginEvent_DelayedAcrossMidnight tests that a login event dispatched
+// after midnight grants the points of its own day, and not of the next day.
+func TestHandleLoginEvent_DelayedAcrossMidnight(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	granter := events.NewPointsGranter(client, nil)
+	userID := setupTestData(t, client)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	year, month, day := now.Date()
+	yesterdayNight := time.Date(year, month, day-1, 23, 59, 0, 0, now.Location())
+
+	yesterdayLogin, err := client.Event.Create().
+		SetUserID(userID).
+		SetType(string(events.EventTypeLogin)).
+		SetTriggeredAt(yesterdayNight).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// The event of yesterday is dispatched today
+	require.NoError(t, handleEvent(ctx, granter, yesterdayLogin))
+
+	pointsRecords, err := client.Point.Query().
+		Where(point.HasUserWith(user.IDEQ(userID))).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, pointsRecords, 1)
+	require.True(t, pointsRecords[0].GrantedAt.Equal(yesterdayNight))
+
+	// The login of today still grants the points of today
+	createLoginEvent(t, client, userID, now)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, now)
+	require.NoError(t, err)
+	require.True(t, granted)
+}
+
 // TestGrantDailyLoginPoints_SameDayDifferentTimes tests that multiple events on the same day only grant points once
 func TestGrantDailyLoginPoints_SameDayDifferentTimes(t *testing.T) {
 	client := testhelper.NewEntSqliteClient(t)
@@ -1057,7 +1125,7 @@ func TestGrantDailyLoginPoints_SameDayDifferentTimes(t *testing.T) {
 	createLoginEvent(t, client, userID, morning)
 
 	// Grant daily login points
-	granted, err := granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted)
 
@@ -1066,7 +1134,7 @@ func TestGrantDailyLoginPoints_SameDayDifferentTimes(t *testing.T) {
 	createLoginEvent(t, client, userID, evening)
 
 	// Attempt to grant daily login points again
-	granted, err = granter.GrantDailyLoginPoints(ctx, userID)
+	granted, err = granter.GrantDailyLoginPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.False(t, granted, "Should not grant points again on the same day")
 
@@ -1108,7 +1176,7 @@ func TestGrantDailyAttemptPoints_MidnightBoundary(t *testing.T) {
 	createSubmitAnswerEvent(t, client, userID, submissionID2, questionID, todayMorning)
 
 	// Grant daily attempt points should succeed because the old record is from yesterday
-	granted, err := granter.GrantDailyAttemptPoints(ctx, userID)
+	granted, err := granter.GrantDailyAttemptPoints(ctx, userID, time.Now())
 	require.NoError(t, err)
 	require.True(t, granted, "Should grant points because yesterday's 11:59 PM and today's 12:01 AM are different days")
 
@@ -1208,3 +1276,73 @@ func TestGrantWeeklyLoginPoints_NotEnoughDistinctDays(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, pointsRecords, 0)
 }
+
+func TestGrantDailyLoginPoints_ConcurrentGrants(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	granter := events.NewPointsGranter(client, nil)
+	userID := setupTestData(t, client)
+
+	ctx := context.Background()
+	now := time.Now()
+	createLoginEvent(t, client, userID, now)
+
+	// Another dispatch of the event passes the check at the same time, and
+	// grants the points right before this one.
+	var raced, grantedByOthers bool
+	client.Point.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.PointFunc(func(ctx context.Context, m *ent.PointMutation) (ent.Value, error) {
+			if !raced {
+				raced = true
+				var err error
+				grantedByOthers, err = granter.GrantDailyLoginPoints(ctx, userID, now)
+				require.NoError(t, err)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, now)
+	require.NoError(t, err)
+	require.True(t, grantedByOthers)
+	require.False(t, granted)
+
+	count, err := client.Point.Query().
+		Where(point.HasUserWith(user.IDEQ(userID))).
+		Where(point.DescriptionEQ(events.PointDescriptionDailyLogin)).
+		Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+// TestGrantDailyLoginPoints_Location tests that the days are calculated in the
+// configured location rather than the time zone of the server.
+func TestGrantDailyLoginPoints_Location(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	location := time.FixedZone("UTC+14", 14*60*60)
+	granter := events.NewPointsGranter(client, nil, events.WithGranterLocation(location))
+	userID := setupTestData(t, client)
+
+	ctx := context.Background()
+	now := time.Now().In(location)
+	year, month, day := now.Date()
+
+	// 23:30 and 00:30 in the location are in the same UTC day, but not in the location.
+	lateNight := time.Date(year, month, day-1, 23, 30, 0, 0, location)
+	earlyMorning := time.Date(year, month, day, 0, 30, 0, 0, location)
+
+	for _, at := range []time.Time{lateNight, earlyMorning} {
+		createLoginEvent(t, client, userID, at)
+
+		granted, err := granter.GrantDailyLoginPoints(ctx, userID, at)
+		require.NoError(t, err)
+		require.True(t, granted, "Should grant points on each day of the location")
+	}
+
+	// Another login later on the same day of the location.
+	evening := time.Date(year, month, day, 20, 0, 0, 0, location)
+	createLoginEvent(t, client, userID, evening)
+
+	granted, err := granter.GrantDailyLoginPoints(ctx, userID, evening)
+	require.NoError(t, err)
+	require.False(t, granted)
+}
@@ -0,0 +1,198 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// replayBatchSize is the number of events re-queued in a transaction.
+const replayBatchSize = 500
+
+// ReplayOptions selects the events to replay.
+//
+// The filters are combined with AND. Empty filters match everything.
+type ReplayOptions struct {
+	// Handlers are the names of the handlers to replay the events to,
+	// which should match the handlers registered in the backend.
+	// Empty means every handler: the dead-lettered entries of any handler,
+	// or the handlers registered in this EventService.
+	Handlers []string
+
+	// EventIDs limits the replay to these events.
+	EventIDs []int
+	// Types limits the replay to these event types.
+	Types []EventType
+	// Since limits the replay to the events triggered at or after this time.
+	Since *time.Time
+	// Until limits the replay to the events triggered before this time.
+	Until *time.Time
+
+	// DeadOnly re-queues only the dead-lettered outbox entries.
+	// Otherwise, every matched event is re-queued, including
+	// the historical events that have no outbox entry.
+	DeadOnly bool
+}
+
+func (o ReplayOptions) eventPredicates() []predicate.Event {
+	var ps []predicate.Event
+	if len(o.EventIDs) > 0 {
+		ps = append(ps, event.IDIn(o.EventIDs...))
+	}
+	if len(o.Types) > 0 {
+		types := make([]string, len(o.Types))
+		for i, t := range o.Types {
+			types[i] = string(t)
+		}
+		ps = append(ps, event.TypeIn(types...))
+	}
+	if o.Since != nil {
+		ps = append(ps, event.TriggeredAtGTE(*o.Since))
+	}
+	if o.Until != nil {
+		ps = append(ps, event.TriggeredAtLT(*o.Until))
+	}
+
+	return ps
+}
+
+// Replay re-queues the matched events to the handlers.
+//
+// The re-queued entries are dispatched by the Dispatcher with their attempts reset.
+// It returns the number of outbox entries queued.
+func (s *EventService) Replay(ctx context.Context, opts ReplayOptions) (int, error) {
+	ctx, span := tracer.Start(ctx, "Replay",
+		trace.WithAttributes(
+			attribute.Bool("replay.dead_only", opts.DeadOnly),
+			attribute.StringSlice("replay.handlers", opts.Handlers),
+		))
+	defer span.End()
+
+	var (
+		queued int
+		err    error
+	)
+	if opts.DeadOnly {
+		queued, err = s.replayDead(ctx, opts)
+	} else {
+		handlers := opts.Handlers
+		if len(handlers) == 0 {
+			handlers = s.HandlerNames()
+		}
+		queued, err = s.replayEvents(ctx, handlers, opts)
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to replay events")
+		span.RecordError(err)
+		return queued, err
+	}
+
+	span.SetAttributes(attribute.Int("replay.queued", queued))
+	s.wakeDispatcher()
+
+	span.SetStatus(otelcodes.Ok, "Events replayed successfully")
+	return queued, nil
+}
+
+// replayDead resets the dead-lettered entries to pending.
+func (s *EventService) replayDead(ctx context.Context, opts ReplayOptions) (int, error) {
+	ps := []predicate.EventOutbox{
+		eventoutbox.StatusEQ(eventoutbox.StatusDead),
+		eventoutbox.HasEventWith(opts.eventPredicates()...),
+	}
+	if len(opts.Handlers) > 0 {
+		ps = append(ps, eventoutbox.HandlerIn(opts.Handlers...))
+	}
+
+	return s.requeue(s.entClient.EventOutbox.Update().Where(ps...)).Save(ctx)
+}
+
+// replayEvents re-queues every matched event, creating the missing outbox entries.
+func (s *EventService) replayEvents(ctx context.Context, handlers []string, opts ReplayOptions) (int, error) {
+	eventIDs, err := s.entClient.Event.Query().
+		Where(opts.eventPredicates()...).
+		Order(event.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query events: %w", err)
+	}
+
+	queued := 0
+	for batch := range slices.Chunk(eventIDs, replayBatchSize) {
+		batchQueued := 0
+		err := withTx(ctx, s.entClient, func(tx *ent.Tx) error {
+			// Reset the existing entries, except the ones being processed.
+			reset, err := s.requeue(tx.EventOutbox.Update().
+				Where(
+					eventoutbox.EventIDIn(batch...),
+					eventoutbox.HandlerIn(handlers...),
+					eventoutbox.StatusNEQ(eventoutbox.StatusProcessing),
+				)).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("reset outbox entries: %w", err)
+			}
+
+			existing, err := tx.EventOutbox.Query().
+				Where(
+					eventoutbox.EventIDIn(batch...),
+					eventoutbox.HandlerIn(handlers...),
+				).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("query outbox entries: %w", err)
+			}
+
+			hasEntry := make(map[int]map[string]bool, len(batch))
+			for _, entry := range existing {
+				if hasEntry[entry.EventID] == nil {
+					hasEntry[entry.EventID] = make(map[string]bool)
+				}
+				hasEntry[entry.EventID][entry.Handler] = true
+			}
+
+			for _, eventID := range batch {
+				var missing []string
+				for _, h := range handlers {
+					if !hasEntry[eventID][h] {
+						missing = append(missing, h)
+					}
+				}
+				if err := s.enqueue(ctx, tx.Client(), eventID, missing); err != nil {
+					return fmt.Errorf("create outbox entries: %w", err)
+				}
+				batchQueued += len(missing)
+			}
+
+			batchQueued += reset
+			return nil
+		})
+		if err != nil {
+			return queued, err
+		}
+
+		queued += batchQueued
+	}
+
+	return queued, nil
+}
+
+// requeue resets the outbox entries of the update to pending.
+func (s *EventService) requeue(update *ent.EventOutboxUpdate) *ent.EventOutboxUpdate {
+	return update.
+		SetStatus(eventoutbox.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		ClearLastError().
+		ClearProcessedAt().
+		ClearLockedUntil()
+}
@@ -0,0 +1,317 @@
+package events
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EventArchivedTotal tracks the number of events archived by the retention policy.
+var EventArchivedTotal = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "dbplay_event_archived_total",
+		Help: "Total number of events archived by the retention policy",
+	},
+)
+
+const (
+	// MinRetention is the minimum age of the events to archive.
+	//
+	// The points rules look back at the events of the last 7 days
+	// (e.g. the weekly login), so they must be kept in the events table.
+	MinRetention = 7 * 24 * time.Hour
+
+	// DefaultRetentionBatchSize is the default number of events archived in a transaction.
+	DefaultRetentionBatchSize = 1000
+)
+
+// ErrRetentionTooShort is returned when the retention is shorter than MinRetention.
+var ErrRetentionTooShort = fmt.Errorf("retention must be at least %s", MinRetention)
+
+// errArchiveConflict is returned when the events of a batch are
+// archived by another run at the same time.
+var errArchiveConflict = errors.New("events have been archived by another run")
+
+// Archiver stores the events removed from the events table.
+type Archiver interface {
+	// Archive stores the events. It is called in the transaction which
+	// deletes the events, so the events are deleted only if it succeeds.
+	Archive(ctx context.Context, tx *ent.Tx, events []*ent.Event) error
+}
+
+// RetentionPolicy is the policy to archive the old events.
+type RetentionPolicy struct {
+	// Retention is how long the events are kept in the events table.
+	// It must be at least MinRetention.
+	Retention time.Duration
+	// Archiver stores the archived events.
+	Archiver Archiver
+	// BatchSize is the number of events archived in a transaction.
+	// Defaults to DefaultRetentionBatchSize.
+	BatchSize int
+}
+
+// ApplyRetention archives the events older than the retention.
+//
+// The events are moved to the archiver, and the number of them is added to the
+// daily counts (EventDailyCount) per user and type, which keeps the aggregates
+// for the statistics. The events with undispatched outbox entries are kept
+// until they are dispatched or dead-lettered.
+//
+// It returns the number of events archived.
+func (s *EventService) ApplyRetention(ctx context.Context, policy RetentionPolicy) (int, error) {
+	ctx, span := tracer.Start(ctx, "ApplyRetention",
+		trace.WithAttributes(
+			attribute.String("retention", policy.Retention.String()),
+		))
+	defer span.End()
+
+	if policy.Retention < MinRetention {
+		span.SetStatus(otelcodes.Error, "Retention too short")
+		return 0, ErrRetentionTooShort
+	}
+	if policy.Archiver == nil {
+		span.SetStatus(otelcodes.Error, "No archiver")
+		return 0, errors.New("archiver is required")
+	}
+
+	batchSize := policy.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultRetentionBatchSize
+	}
+
+	cutoff := time.Now().Add(-policy.Retention)
+	span.SetAttributes(attribute.String("retention.cutoff", cutoff.Format(time.RFC3339)))
+
+	archived := 0
+	for {
+		n, err := s.archiveBatch(ctx, policy.Archiver, cutoff, batchSize)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to archive events")
+			span.RecordError(err)
+			return archived, err
+		}
+
+		archived += n
+		EventArchivedTotal.Add(float64(n))
+
+		if n < batchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("retention.archived", archived))
+	span.SetStatus(otelcodes.Ok, "Events archived successfully")
+	return archived, nil
+}
+
+// archiveBatch archives a batch of the expired events in a transaction.
+func (s *EventService) archiveBatch(ctx context.Context, archiver Archiver, cutoff time.Time, batchSize int) (int, error) {
+	ctx, span := tracer.Start(ctx, "archiveBatch")
+	defer span.End()
+
+	var archived int
+	err := withTx(ctx, s.entClient, func(tx *ent.Tx) error {
+		span.AddEvent("database.event.query")
+		expired, err := tx.Event.Query().
+			Where(
+				event.TriggeredAtLT(cutoff),
+				// Keep the events whose handlers have not finished.
+				event.Not(event.HasOutboxWith(
+					eventoutbox.StatusIn(eventoutbox.StatusPending, eventoutbox.StatusProcessing),
+				)),
+			).
+			Order(event.ByID()).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query expired events: %w", err)
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		ids := make([]int, len(expired))
+		for i, e := range expired {
+			ids[i] = e.ID
+		}
+
+		span.AddEvent("database.event_daily_count.update")
+		if err := addDailyCounts(ctx, tx, expired); err != nil {
+			return fmt.Errorf("update daily counts: %w", err)
+		}
+
+		span.AddEvent("archiver.archive")
+		if err := archiver.Archive(ctx, tx, expired); err != nil {
+			return fmt.Errorf("archive events: %w", err)
+		}
+
+		span.AddEvent("database.event.delete")
+		if _, err := tx.EventOutbox.Delete().Where(eventoutbox.EventIDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete outbox entries: %w", err)
+		}
+		deleted, err := tx.Event.Delete().Where(event.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete events: %w", err)
+		}
+		if deleted != len(ids) {
+			return errArchiveConflict
+		}
+
+		archived = deleted
+		return nil
+	})
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to archive batch")
+		span.RecordError(err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int("batch.archived", archived))
+	span.SetStatus(otelcodes.Ok, "Batch archived successfully")
+	return archived, nil
+}
+
+// dailyCountKey identifies an EventDailyCount.
+type dailyCountKey struct {
+	userID    int
+	eventType string
+	date      time.Time
+}
+
+// addDailyCounts adds the events to the daily counts.
+func addDailyCounts(ctx context.Context, tx *ent.Tx, events []*ent.Event) error {
+	counts := make(map[dailyCountKey]int)
+	for _, e := range events {
+		t := e.TriggeredAt.UTC()
+		date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		counts[dailyCountKey{userID: e.UserID, eventType: e.Type, date: date}]++
+	}
+
+	for key, count := range counts {
+		updated, err := tx.EventDailyCount.Update().
+			Where(
+				eventdailycount.UserID(key.userID),
+				eventdailycount.Type(key.eventType),
+				eventdailycount.Date(key.date),
+			).
+			AddCount(count).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated > 0 {
+			continue
+		}
+
+		err = tx.EventDailyCount.Create().
+			SetUserID(key.userID).
+			SetType(key.eventType).
+			SetDate(key.date).
+			SetCount(count).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TableArchiver archives the events to the archived_events table.
+type TableArchiver struct{}
+
+func (TableArchiver) Archive(ctx context.Context, tx *ent.Tx, events []*ent.Event) error {
+	builders := make([]*ent.ArchivedEventCreate, len(events))
+	for i, e := range events {
+		builders[i] = tx.ArchivedEvent.Create().
+			SetID(e.ID).
+			SetUserID(e.UserID).
+			SetType(e.Type).
+			SetTriggeredAt(e.TriggeredAt).
+			SetPayload(e.Payload)
+	}
+
+	return tx.ArchivedEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// ArchivedEventRecord is a line in the files written by FileArchiver.
+type ArchivedEventRecord struct {
+	ID          int            `json:"id"`
+	UserID      int            `json:"user_id"`
+	Type        string         `json:"type"`
+	TriggeredAt time.Time      `json:"triggered_at"`
+	Payload     map[string]any `json:"payload,omitempty"`
+}
+
+// FileArchiver archives the events to gzip-compressed JSON-lines files
+// in the directory, one file per day (events-YYYYMMDD.jsonl.gz, in UTC).
+// Every line is an ArchivedEventRecord.
+//
+// Every batch is appended as a separate gzip member and synced before the
+// events are deleted. If the deletion fails, the events are archived again
+// in the next run, so the readers should deduplicate the records by ID.
+type FileArchiver struct {
+	dir string
+}
+
+// NewFileArchiver creates a FileArchiver writing to the directory.
+func NewFileArchiver(dir string) (*FileArchiver, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create archive directory: %w", err)
+	}
+
+	return &FileArchiver{dir: dir}, nil
+}
+
+func (a *FileArchiver) Archive(_ context.Context, _ *ent.Tx, events []*ent.Event) (err error) {
+	name := fmt.Sprintf("events-%s.jsonl.gz", time.Now().UTC().Format("20060102"))
+	file, err := os.OpenFile(filepath.Join(a.dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open archive file: %w", err)
+	}
+	defer func() {
+		if cerr := file.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close archive file: %w", cerr)
+		}
+	}()
+
+	gz := gzip.NewWriter(file)
+	encoder := json.NewEncoder(gz)
+	for _, e := range events {
+		err := encoder.Encode(ArchivedEventRecord{
+			ID:          e.ID,
+			UserID:      e.UserID,
+			Type:        e.Type,
+			TriggeredAt: e.TriggeredAt,
+			Payload:     e.Payload,
+		})
+		if err != nil {
+			return fmt.Errorf("write archive record: %w", err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("compress archive: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("sync archive file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package events_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/eventoutbox"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestApplyRetention_TableArchiver(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	old := time.Now().AddDate(0, 0, -30)
+	createLoginEvent(t, client, userID, old)
+	createLoginEvent(t, client, userID, old.Add(time.Minute))
+	createLoginEvent(t, client, userID, time.Now())
+
+	service := events.NewEventService(client, nil)
+	archived, err := service.ApplyRetention(ctx, events.RetentionPolicy{
+		Retention: 14 * 24 * time.Hour,
+		Archiver:  events.TableArchiver{},
+		BatchSize: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, archived)
+
+	remaining, err := client.Event.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, remaining)
+
+	archivedEvents, err := client.ArchivedEvent.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, archivedEvents, 2)
+	for _, e := range archivedEvents {
+		require.Equal(t, userID, e.UserID)
+		require.Equal(t, string(events.EventTypeLogin), e.Type)
+	}
+
+	dailyCount, err := client.EventDailyCount.Query().
+		Where(eventdailycount.UserID(userID), eventdailycount.Type(string(events.EventTypeLogin))).
+		Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, dailyCount.Count)
+	require.True(t, dailyCount.Date.Equal(old.UTC().Truncate(24*time.Hour)))
+}
+
+func TestApplyRetention_KeepsUndispatchedEvents(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	old := time.Now().AddDate(0, 0, -30)
+	createLoginEvent(t, client, userID, old)
+
+	event, err := client.Event.Query().Only(ctx)
+	require.NoError(t, err)
+
+	_, err = client.EventOutbox.Create().
+		SetEventID(event.ID).
+		SetHandler(events.HandlerPoints).
+		SetStatus(eventoutbox.StatusPending).
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := events.NewEventService(client, nil)
+	archived, err := service.ApplyRetention(ctx, events.RetentionPolicy{
+		Retention: 14 * 24 * time.Hour,
+		Archiver:  events.TableArchiver{},
+	})
+	require.NoError(t, err)
+	require.Zero(t, archived)
+
+	exists, err := client.Event.Query().Exist(ctx)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestApplyRetention_TooShort(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+
+	service := events.NewEventService(client, nil)
+	_, err := service.ApplyRetention(context.Background(), events.RetentionPolicy{
+		Retention: 24 * time.Hour,
+		Archiver:  events.TableArchiver{},
+	})
+	require.ErrorIs(t, err, events.ErrRetentionTooShort)
+}
+
+func TestApplyRetention_FileArchiver(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	userID := setupTestData(t, client)
+	ctx := context.Background()
+
+	old := time.Now().AddDate(0, 0, -30)
+	createLoginEvent(t, client, userID, old)
+	createLoginEvent(t, client, userID, old.Add(time.Minute))
+
+	dir := t.TempDir()
+	archiver, err := events.NewFileArchiver(dir)
+	require.NoError(t, err)
+
+	service := events.NewEventService(client, nil)
+	archived, err := service.ApplyRetention(ctx, events.RetentionPolicy{
+		Retention: 14 * 24 * time.Hour,
+		Archiver:  archiver,
+		BatchSize: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, archived)
+
+	files, err := filepath.Glob(filepath.Join(dir, "events-*.jsonl.gz"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	file, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	// Every batch is a separate gzip member, which the reader reads as a single stream.
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var records []events.ArchivedEventRecord
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var record events.ArchivedEventRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 2)
+	require.Equal(t, userID, records[0].UserID)
+
+	exists, err := client.ArchivedEvent.Query().Exist(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,463 @@
+// Package audit records the privileged mutations in the audit log.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/useraccount"
+	"github.com/database-playground/backend-v2/models"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.graphql.audit")
+
+// ImpersonateScope is the scope guarding the impersonation.
+const ImpersonateScope = "user:impersonate"
+
+// selfServiceScopes are the write scopes of the mutations on the caller's
+// own data (e.g. updateMe and submitAnswer), which are not privileged.
+var selfServiceScopes = []string{"me:write", "submission:write"}
+
+// Audited reports whether the mutations guarded by the scope are audited:
+// the "*:write" scopes except the self-service ones, and ImpersonateScope.
+func Audited(scope string) bool {
+	if scope == ImpersonateScope {
+		return true
+	}
+
+	return strings.HasSuffix(scope, ":write") && !slices.Contains(selfServiceScopes, scope)
+}
+
+// Extension is a gqlgen extension writing an AuditLog for every successful
+// mutation guarded by an audited scope (see Audited) in the @scope directive.
+//
+// The log records the actor (and the impersonator of the token), the
+// target, the changed fields of the target and the trace ID. The target is
+// the node returned by the mutation, or the node of its first ID argument.
+//
+// The log is written in the transaction of the mutation (see
+// entgql.Transactioner), so that it is only kept if the mutation is
+// committed, and the mutation fails if the log can not be written.
+type Extension struct {
+	client *ent.Client
+}
+
+// New creates a new Extension writing the logs with the client, or with
+// the transaction in the context.
+func New(client *ent.Client) *Extension {
+	return &Extension{client: client}
+}
+
+func (e *Extension) ExtensionName() string {
+	return "AuditLog"
+}
+
+func (e *Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e *Extension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Object != "Mutation" || fc.Field.Definition == nil {
+		return next(ctx)
+	}
+	user, ok := auth.GetUser(ctx)
+	if !ok {
+		// the @scope directive rejects the anonymous requests.
+		return next(ctx)
+	}
+
+	client := ent.FromContext(ctx)
+	if client == nil {
+		client = e.client
+	}
+
+	m := &mutation{client: client}
+	m.targetID, m.hasTarget = targetIDOf(fc)
+	ctx = context.WithValue(ctx, mutationKey{}, m)
+
+	if scope := scopeOf(fc.Field.Definition); Audited(scope) {
+		if err := m.start(ctx, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := next(ctx)
+	if err != nil || m.scope == "" {
+		// the failed mutation is rolled back, so there is nothing to record.
+		return res, err
+	}
+
+	if err := m.write(ctx, fc.Field.Name, user, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Record audits the mutation being resolved with the scope, for the
+// mutations whose scope depends on the arguments, and so is checked in the
+// resolver instead of the @scope directive (e.g. createCheatRecord for
+// another user).
+//
+// It should be called before the mutation changes anything, so that the
+// target is recorded as it was. It does nothing outside of the Extension
+// or if the mutation is already audited.
+func Record(ctx context.Context, scope string) error {
+	m, ok := ctx.Value(mutationKey{}).(*mutation)
+	if !ok || m.scope != "" || !Audited(scope) {
+		return nil
+	}
+
+	return m.start(ctx, scope)
+}
+
+type mutationKey struct{}
+
+// mutation is the audit of a mutation being resolved.
+type mutation struct {
+	client *ent.Client
+
+	// scope is the audited scope of the mutation, or empty if the
+	// mutation is not audited.
+	scope     string
+	targetID  int
+	hasTarget bool
+	// before is the target before the mutation, and beforeFields is its snapshot.
+	before       ent.Noder
+	beforeFields map[string]json.RawMessage
+}
+
+// start audits the mutation with the scope, and takes a snapshot of the target.
+func (m *mutation) start(ctx context.Context, scope string) error {
+	ctx, span := tracer.Start(ctx, "Start",
+		trace.WithAttributes(
+			attribute.String("audit.scope", scope),
+		))
+	defer span.End()
+
+	m.scope = scope
+	if !m.hasTarget {
+		span.SetStatus(otelcodes.Ok, "No target")
+		return nil
+	}
+
+	span.AddEvent("target.loading")
+	node, err := loadNode(ctx, m.client, m.targetID)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to load the target")
+		span.RecordError(err)
+		return fmt.Errorf("load audit target: %w", err)
+	}
+	fields, err := snapshot(ctx, node)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to snapshot the target")
+		span.RecordError(err)
+		return fmt.Errorf("snapshot audit target: %w", err)
+	}
+	m.before, m.beforeFields = node, fields
+
+	span.SetStatus(otelcodes.Ok, "Target loaded")
+	return nil
+}
+
+// write writes the audit log of the successful mutation returning res.
+func (m *mutation) write(ctx context.Context, operation string, user auth.TokenInfo, res any) error {
+	ctx, span := tracer.Start(ctx, "Write",
+		trace.WithAttributes(
+			attribute.String("audit.operation", operation),
+			attribute.Int("user.id", user.UserID),
+		))
+	defer span.End()
+
+	before, beforeFields := m.before, m.beforeFields
+	targetID, hasTarget := m.targetID, m.hasTarget
+
+	after, ok := nodeOf(res)
+	switch {
+	case ok && hasTarget && idOf(after) != targetID:
+		// the mutation creates a node for the target (e.g. createCheatRecord).
+		before, beforeFields = nil, nil
+		targetID = idOf(after)
+	case ok:
+		hasTarget = true
+		targetID = idOf(after)
+	case hasTarget:
+		span.AddEvent("target.reloading")
+		var err error
+		after, err = loadNode(ctx, m.client, targetID)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to reload the target")
+			span.RecordError(err)
+			return fmt.Errorf("load audit target: %w", err)
+		}
+	}
+
+	afterFields, err := snapshot(ctx, after)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to snapshot the target")
+		span.RecordError(err)
+		return fmt.Errorf("snapshot audit target: %w", err)
+	}
+
+	create := m.client.AuditLog.Create().
+		SetActorID(user.UserID).
+		SetOperation(operation).
+		SetScope(m.scope).
+		SetChanges(diff(beforeFields, afterFields))
+	if impersonatorID, err := strconv.Atoi(user.Meta[useraccount.MetaImpersonation]); err == nil {
+		create.SetImpersonatorID(impersonatorID)
+	}
+	if hasTarget {
+		create.SetTargetID(targetID)
+		if node := cmpOr(after, before); node != nil {
+			create.SetTargetType(typeOf(node))
+		}
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		create.SetTraceID(spanCtx.TraceID().String())
+	}
+
+	span.AddEvent("audit_log.writing")
+	if err := create.Exec(ctx); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to write the audit log")
+		span.RecordError(err)
+		return fmt.Errorf("write audit log: %w", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "Audit log written")
+	return nil
+}
+
+// scopeOf returns the scope in the @scope directive of the field.
+func scopeOf(def *ast.FieldDefinition) string {
+	directive := def.Directives.ForName("scope")
+	if directive == nil {
+		return ""
+	}
+	arg := directive.Arguments.ForName("scope")
+	if arg == nil || arg.Value == nil {
+		return ""
+	}
+
+	return arg.Value.Raw
+}
+
+// targetIDOf returns the value of the first ID argument of the field.
+func targetIDOf(fc *graphql.FieldContext) (int, bool) {
+	for _, arg := range fc.Field.Definition.Arguments {
+		if arg.Type.Elem != nil || arg.Type.Name() != "ID" {
+			continue
+		}
+
+		switch id := fc.Args[arg.Name].(type) {
+		case int:
+			return id, true
+		case *int:
+			if id != nil {
+				return *id, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// loadNode loads every field of the node, or returns nil if it is not found.
+func loadNode(ctx context.Context, client *ent.Client, id int) (ent.Noder, error) {
+	// Noder only loads the fields selected in the field context.
+	node, err := client.Noder(withoutFieldContext{ctx}, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// withoutFieldContext hides the field context of the resolver.
+type withoutFieldContext struct {
+	context.Context
+}
+
+func (c withoutFieldContext) Value(key any) any {
+	value := c.Context.Value(key)
+	if _, ok := value.(*graphql.FieldContext); ok {
+		return nil
+	}
+
+	return value
+}
+
+// nodeOf returns the node returned by the mutation.
+func nodeOf(res any) (ent.Noder, bool) {
+	node, ok := res.(ent.Noder)
+	if !ok || reflect.ValueOf(node).IsNil() {
+		return nil, false
+	}
+
+	return node, true
+}
+
+// idOf returns the ID of the node, which every ent entity has.
+func idOf(node ent.Noder) int {
+	return int(reflect.ValueOf(node).Elem().FieldByName("ID").Int())
+}
+
+// typeOf returns the type name of the node, e.g. "User".
+func typeOf(node ent.Noder) string {
+	return reflect.TypeOf(node).Elem().Name()
+}
+
+func cmpOr(nodes ...ent.Noder) ent.Noder {
+	for _, node := range nodes {
+		if node != nil {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// excludedFields are the fields not recorded. The pseudonym of a user is
+// not recorded with the name, so the logs can not link them.
+var excludedFields = []string{"pseudonym"}
+
+// snapshot returns the fields of the node to diff: the JSON encoding of the
+// node and the IDs of its unique edges, except the excluded fields.
+func snapshot(ctx context.Context, node ent.Noder) (map[string]json.RawMessage, error) {
+	fields, err := fieldsOf(node)
+	if err != nil || fields == nil {
+		return fields, err
+	}
+	for _, field := range excludedFields {
+		delete(fields, field)
+	}
+
+	for name, query := range edgesOf(node) {
+		ids, err := query(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query %s of %s: %w", name, typeOf(node), err)
+		}
+
+		fields[name] = json.RawMessage("null")
+		if len(ids) > 0 {
+			fields[name] = json.RawMessage(strconv.Itoa(ids[0]))
+		}
+	}
+
+	return fields, nil
+}
+
+// edgesOf returns the queries of the IDs of the unique edges of the node,
+// keyed by the recorded field. Their foreign keys are unexported, so they
+// are not in the JSON encoding.
+func edgesOf(node ent.Noder) map[string]func(context.Context) ([]int, error) {
+	switch node := node.(type) {
+	case *ent.User:
+		return map[string]func(context.Context) ([]int, error){
+			"group_id": node.QueryGroup().IDs,
+		}
+	case *ent.CheatRecord:
+		return map[string]func(context.Context) ([]int, error){
+			"user_id":     node.QueryUser().IDs,
+			"reporter_id": node.QueryReporter().IDs,
+		}
+	case *ent.Question:
+		return map[string]func(context.Context) ([]int, error){
+			"database_id": node.QueryDatabase().IDs,
+		}
+	case *ent.Submission:
+		return map[string]func(context.Context) ([]int, error){
+			"question_id": node.QueryQuestion().IDs,
+			"user_id":     node.QueryUser().IDs,
+		}
+	case *ent.Point:
+		return map[string]func(context.Context) ([]int, error){
+			"user_id": node.QueryUser().IDs,
+		}
+	case *ent.WebhookDelivery:
+		return map[string]func(context.Context) ([]int, error){
+			"subscription_id": node.QuerySubscription().IDs,
+		}
+	}
+
+	return nil
+}
+
+// diff returns the changed fields between the snapshots.
+func diff(beforeFields, afterFields map[string]json.RawMessage) []models.AuditLogChange {
+	keys := slices.Sorted(maps.Keys(beforeFields))
+	for key := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []models.AuditLogChange
+	for _, key := range keys {
+		beforeValue, afterValue := beforeFields[key], afterFields[key]
+		if string(beforeValue) == string(afterValue) {
+			continue
+		}
+
+		change := models.AuditLogChange{Field: key}
+		if beforeValue != nil {
+			change.Before = stringOf(beforeValue)
+		}
+		if afterValue != nil {
+			change.After = stringOf(afterValue)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func stringOf(value json.RawMessage) *string {
+	s := string(value)
+	return &s
+}
+
+// fieldsOf returns the JSON encoding of the node without the edges. The
+// sensitive fields are not encoded.
+func fieldsOf(node ent.Noder) (map[string]json.RawMessage, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	content, err := json.Marshal(node)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %w", typeOf(node), err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", typeOf(node), err)
+	}
+	delete(fields, "edges")
+
+	return fields, nil
+}
+
+var (
+	_ graphql.HandlerExtension = (*Extension)(nil)
+	_ graphql.FieldInterceptor = (*Extension)(nil)
+)
@@ -0,0 +1,23 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/database-playground/backend-v2/internal/graphql/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudited(t *testing.T) {
+	for scope, audited := range map[string]bool{
+		"user:write":       true,
+		"question:write":   true,
+		"user:impersonate": true,
+		"me:write":         false,
+		"submission:write": false,
+		"user:read":        false,
+		"me:delete":        false,
+		"":                 false,
+	} {
+		assert.Equal(t, audited, audit.Audited(scope), scope)
+	}
+}
@@ -0,0 +1,117 @@
+// Package depth limits the depth of the GraphQL operations.
+package depth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeDepthLimit is the error code of the operations exceeding the depth limit.
+const ErrCodeDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+const extensionName = "DepthLimit"
+
+// Limit is a gqlgen extension rejecting the operations nested deeper than
+// the limit returned by Func. A non-positive limit disables the check.
+//
+// The depth of an operation is the maximum number of the nested fields,
+// e.g. "{ me { submissions { edges { node { id } } } } }" has a depth of 5.
+// The fragments are expanded, and the introspection fields are not counted.
+type Limit struct {
+	Func func(ctx context.Context, opCtx *graphql.OperationContext) int
+}
+
+// Stats is the depth of the operation and its limit.
+type Stats struct {
+	Depth      int
+	DepthLimit int
+}
+
+// FixedLimit sets a depth limit that does not change.
+func FixedLimit(limit int) *Limit {
+	return &Limit{
+		Func: func(context.Context, *graphql.OperationContext) int {
+			return limit
+		},
+	}
+}
+
+func (l Limit) ExtensionName() string {
+	return extensionName
+}
+
+func (l *Limit) Validate(graphql.ExecutableSchema) error {
+	if l.Func == nil {
+		return errors.New("DepthLimit func can not be nil")
+	}
+	return nil
+}
+
+func (l Limit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth := Calculate(op.SelectionSet)
+	limit := l.Func(ctx, opCtx)
+
+	opCtx.Stats.SetExtension(extensionName, &Stats{
+		Depth:      depth,
+		DepthLimit: limit,
+	})
+
+	if limit > 0 && depth > limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, limit)
+		errcode.Set(err, ErrCodeDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// Calculate returns the depth of the selection set.
+func Calculate(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + Calculate(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = Calculate(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			d = Calculate(s.SelectionSet)
+		}
+		depth = max(depth, d)
+	}
+
+	return depth
+}
+
+// GetStats returns the depth of the operation in the context, or nil if
+// the operation is not checked.
+func GetStats(ctx context.Context) *Stats {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	s, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(extensionName).(*Stats)
+	return s
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &Limit{}
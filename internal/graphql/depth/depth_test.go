@@ -0,0 +1,80 @@
+package depth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/internal/graphql/depth"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func newTestServer(limit int) *handler.Server {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node: Node }
+		type Node { id: Int! child: Node }
+	`})
+
+	srv := handler.New(&graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"node":null}`)})
+		},
+		SchemaFunc: func() *ast.Schema { return schema },
+	})
+	srv.AddTransport(transport.POST{})
+	srv.Use(depth.FixedLimit(limit))
+
+	return srv
+}
+
+func post(srv http.Handler, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":"`+query+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLimit(t *testing.T) {
+	srv := newTestServer(3)
+
+	rec := post(srv, `{ node { child { id } } }`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), depth.ErrCodeDepthLimit)
+
+	rec = post(srv, `{ node { child { child { id } } } }`)
+	assert.Contains(t, rec.Body.String(), depth.ErrCodeDepthLimit)
+	assert.Contains(t, rec.Body.String(), "operation has depth 4, which exceeds the limit of 3")
+
+	// the fragments are expanded
+	rec = post(srv, `{ node { ...F } } fragment F on Node { child { child { id } } }`)
+	assert.Contains(t, rec.Body.String(), depth.ErrCodeDepthLimit)
+
+	// the introspection fields are not counted
+	rec = post(srv, `{ __schema { types { fields { type { name } } } } }`)
+	assert.NotContains(t, rec.Body.String(), depth.ErrCodeDepthLimit)
+}
+
+func TestLimit_Disabled(t *testing.T) {
+	srv := newTestServer(0)
+
+	rec := post(srv, `{ node { child { child { child { child { id } } } } } }`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCalculate(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node: Node }
+		type Node { id: Int! child: Node }
+	`})
+	doc := gqlparser.MustLoadQuery(schema, `{ a: node { id } b: node { child { ... on Node { child { id } } } } }`)
+
+	assert.Equal(t, 4, depth.Calculate(doc.Operations[0].SelectionSet))
+}
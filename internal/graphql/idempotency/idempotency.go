@@ -0,0 +1,281 @@
+// Package idempotency replays the response of a mutation to the retries
+// carrying the same idempotency key, so a retried mutation runs only once.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/rueidis"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.graphql.idempotency")
+
+// RequestsTotal tracks the mutations carrying an idempotency key.
+//
+// The result is "stored", "replayed", "released", "in_progress", "mismatched" or "error".
+var RequestsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_idempotency_requests_total",
+		Help: "Total number of mutations carrying an idempotency key",
+	},
+	[]string{"result"},
+)
+
+const (
+	// HeaderName is the HTTP header carrying the idempotency key.
+	HeaderName = "Idempotency-Key"
+	// RequestExtensionName is the GraphQL request extension carrying the
+	// idempotency key, used when the header is absent.
+	RequestExtensionName = "idempotencyKey"
+	// ReplayedExtensionName is the response extension set to true
+	// when the response is replayed.
+	ReplayedExtensionName = "idempotentReplayed"
+	// MaxKeyLength is the maximum length of an idempotency key.
+	MaxKeyLength = 255
+
+	// pendingTTL is how long the first request holds the key
+	// before its response is stored.
+	pendingTTL = time.Minute
+)
+
+const redisIdempotencyPrefix = "idempotency:"
+
+// record is the state of an idempotency key stored in Redis.
+type record struct {
+	// Fingerprint identifies the request, so that a key can not
+	// be reused for another request.
+	Fingerprint string            `json:"fingerprint"`
+	Pending     bool              `json:"pending,omitempty"`
+	Response    *graphql.Response `json:"response,omitempty"`
+}
+
+// Extension is a gqlgen extension storing the response of the mutations
+// carrying an idempotency key in Redis, and replays it to the retries from
+// the same user with the same key until the TTL expires.
+//
+// Only the successful responses are stored. The key is released if the
+// mutation fails, so that it can be retried. The requests without a key,
+// the queries and the anonymous requests are not affected.
+//
+// The extension should be used before entgql.Transactioner, so that the
+// response is stored after the transaction is committed.
+type Extension struct {
+	client rueidis.Client
+	ttl    time.Duration
+}
+
+// New creates a new Extension keeping the responses for ttl.
+func New(client rueidis.Client, ttl time.Duration) *Extension {
+	return &Extension{client: client, ttl: ttl}
+}
+
+func (e *Extension) ExtensionName() string {
+	return "Idempotency"
+}
+
+func (e *Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e *Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+		return next(ctx)
+	}
+	key := keyOf(oc)
+	if key == "" {
+		return next(ctx)
+	}
+	user, ok := auth.GetUser(ctx)
+	if !ok {
+		return next(ctx)
+	}
+
+	ctx, span := tracer.Start(ctx, "InterceptResponse",
+		trace.WithAttributes(
+			attribute.Int("user.id", user.UserID),
+		))
+	defer span.End()
+
+	if len(key) > MaxKeyLength {
+		span.SetStatus(otelcodes.Error, "Idempotency key too long")
+		return errorResponse(ctx, defs.NewErrInvalidInput(fmt.Sprintf("the idempotency key should be at most %d characters", MaxKeyLength)))
+	}
+
+	redisKey := redisKeyOf(user.UserID, key)
+	fingerprint, err := fingerprintOf(oc)
+	if err != nil {
+		RequestsTotal.WithLabelValues("error").Inc()
+		span.SetStatus(otelcodes.Error, "Failed to fingerprint the request")
+		span.RecordError(err)
+		return next(ctx)
+	}
+
+	span.AddEvent("key.claiming")
+	claimed, err := e.claim(ctx, redisKey, fingerprint)
+	if err != nil {
+		// Redis is unavailable, so the mutation runs as if there were no key.
+		RequestsTotal.WithLabelValues("error").Inc()
+		span.SetStatus(otelcodes.Error, "Failed to claim the idempotency key")
+		span.RecordError(err)
+		slog.Warn("error claiming idempotency key", "error", err, "user_id", user.UserID)
+		return next(ctx)
+	}
+
+	if !claimed {
+		return e.replay(ctx, span, redisKey, fingerprint)
+	}
+
+	span.AddEvent("mutation.running")
+	resp := next(ctx)
+	if resp == nil || len(resp.Errors) > 0 {
+		RequestsTotal.WithLabelValues("released").Inc()
+		span.AddEvent("key.releasing")
+		if err := e.client.Do(ctx, e.client.B().Del().Key(redisKey).Build()).Error(); err != nil {
+			slog.Warn("error releasing idempotency key", "error", err, "user_id", user.UserID)
+		}
+
+		span.SetStatus(otelcodes.Ok, "Mutation failed, key released")
+		return resp
+	}
+
+	span.AddEvent("response.storing")
+	value, err := json.Marshal(record{Fingerprint: fingerprint, Response: resp})
+	if err == nil {
+		err = e.client.Do(ctx, e.client.B().Set().Key(redisKey).Value(string(value)).Ex(e.ttl).Build()).Error()
+	}
+	if err != nil {
+		RequestsTotal.WithLabelValues("error").Inc()
+		span.SetStatus(otelcodes.Error, "Failed to store the response")
+		span.RecordError(err)
+		slog.Warn("error storing idempotent response", "error", err, "user_id", user.UserID)
+		return resp
+	}
+
+	RequestsTotal.WithLabelValues("stored").Inc()
+	span.SetStatus(otelcodes.Ok, "Response stored")
+	return resp
+}
+
+// claim marks the key as pending, and reports whether the key was not used.
+func (e *Extension) claim(ctx context.Context, redisKey, fingerprint string) (bool, error) {
+	value, err := json.Marshal(record{Fingerprint: fingerprint, Pending: true})
+	if err != nil {
+		return false, fmt.Errorf("marshal record: %w", err)
+	}
+
+	err = e.client.Do(ctx, e.client.B().Set().Key(redisKey).Value(string(value)).Nx().Ex(pendingTTL).Build()).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("set pending record: %w", err)
+	}
+
+	return true, nil
+}
+
+// replay returns the stored response of the key, or an error if the key
+// is used by another request or the first request is still running.
+func (e *Extension) replay(ctx context.Context, span trace.Span, redisKey, fingerprint string) *graphql.Response {
+	span.AddEvent("response.replaying")
+
+	var rec record
+	value, err := e.client.Do(ctx, e.client.B().Get().Key(redisKey).Build()).AsBytes()
+	if err == nil {
+		err = json.Unmarshal(value, &rec)
+	}
+	if err != nil && !rueidis.IsRedisNil(err) {
+		RequestsTotal.WithLabelValues("error").Inc()
+		span.SetStatus(otelcodes.Error, "Failed to get the stored response")
+		span.RecordError(err)
+		return errorResponse(ctx, fmt.Errorf("get idempotent response: %w", err))
+	}
+
+	switch {
+	case rec.Fingerprint != "" && rec.Fingerprint != fingerprint:
+		RequestsTotal.WithLabelValues("mismatched").Inc()
+		span.SetStatus(otelcodes.Error, "Idempotency key reused")
+		return errorResponse(ctx, defs.NewErrInvalidInput("the idempotency key has been used by another request"))
+	case rec.Pending || rec.Response == nil:
+		// the key expires between SET NX and GET only if the first request
+		// has just failed, so the retry should be sent again.
+		RequestsTotal.WithLabelValues("in_progress").Inc()
+		span.SetStatus(otelcodes.Error, "Idempotency key in use")
+		return errorResponse(ctx, defs.ErrIdempotencyKeyInUse)
+	}
+
+	resp := rec.Response
+	resp.Extensions = maps.Clone(resp.Extensions)
+	if resp.Extensions == nil {
+		resp.Extensions = make(map[string]any, 1)
+	}
+	resp.Extensions[ReplayedExtensionName] = true
+
+	RequestsTotal.WithLabelValues("replayed").Inc()
+	span.SetStatus(otelcodes.Ok, "Response replayed")
+	return resp
+}
+
+// keyOf returns the idempotency key of the request, from the
+// header or the request extension.
+func keyOf(oc *graphql.OperationContext) string {
+	if key := oc.Headers.Get(HeaderName); key != "" {
+		return key
+	}
+
+	key, _ := oc.Extensions[RequestExtensionName].(string)
+	return key
+}
+
+func redisKeyOf(userID int, key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return redisIdempotencyPrefix + strconv.Itoa(userID) + ":" + hex.EncodeToString(hash[:])
+}
+
+// fingerprintOf hashes the query, the operation name and the variables of the request.
+func fingerprintOf(oc *graphql.OperationContext) (string, error) {
+	variables, err := json.Marshal(oc.Variables)
+	if err != nil {
+		return "", fmt.Errorf("marshal variables: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(oc.RawQuery))
+	h.Write([]byte{0})
+	h.Write([]byte(oc.OperationName))
+	h.Write([]byte{0})
+	h.Write(variables)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func errorResponse(ctx context.Context, err error) *graphql.Response {
+	graphql.AddError(ctx, err)
+	return &graphql.Response{Errors: graphql.GetErrors(ctx)}
+}
+
+var (
+	_ graphql.HandlerExtension    = (*Extension)(nil)
+	_ graphql.ResponseInterceptor = (*Extension)(nil)
+)
@@ -0,0 +1,175 @@
+package idempotency_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/graphql/idempotency"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type response struct {
+	Data       map[string]int   `json:"data"`
+	Errors     []map[string]any `json:"errors"`
+	Extensions map[string]any   `json:"extensions"`
+}
+
+// newTestServer creates a server whose "increment" mutation increments the
+// returned counter, and fails if the "fail" variable is true.
+func newTestServer(t *testing.T) (http.Handler, *atomic.Int64) {
+	t.Helper()
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { counter: Int! }
+		type Mutation { increment(fail: Boolean): Int! }
+	`})
+
+	var counter atomic.Int64
+	srv := handler.New(&graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			oc := graphql.GetOperationContext(ctx)
+			if oc.Operation.Operation != ast.Mutation {
+				return graphql.OneShot(&graphql.Response{Data: []byte(fmt.Sprintf(`{"counter":%d}`, counter.Load()))})
+			}
+
+			// the mutation runs when the response is requested, like the generated code.
+			return func(ctx context.Context) *graphql.Response {
+				if fail, _ := oc.Variables["fail"].(bool); fail {
+					return graphql.ErrorResponse(ctx, "failed")
+				}
+
+				return &graphql.Response{Data: []byte(fmt.Sprintf(`{"increment":%d}`, counter.Add(1)))}
+			}
+		},
+		SchemaFunc: func() *ast.Schema { return schema },
+	})
+
+	container := testhelper.NewRedisContainer(t)
+	redisClient := testhelper.NewRedisClient(t, container)
+	srv.Use(idempotency.New(redisClient, time.Hour))
+	srv.AddTransport(transport.POST{})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if userID := r.Header.Get("X-User-ID"); userID != "" {
+			var id int
+			_, _ = fmt.Sscanf(userID, "%d", &id)
+			r = r.WithContext(auth.WithUser(r.Context(), auth.TokenInfo{UserID: id}))
+		}
+		srv.ServeHTTP(w, r)
+	}), &counter
+}
+
+func post(t *testing.T, h http.Handler, body string, headers map[string]string) response {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return resp
+}
+
+const incrementBody = `{"query":"mutation($fail: Boolean) { increment(fail: $fail) }"}`
+
+func TestExtension_Replay(t *testing.T) {
+	h, counter := newTestServer(t)
+	headers := map[string]string{"X-User-ID": "1", idempotency.HeaderName: "key-1"}
+
+	first := post(t, h, incrementBody, headers)
+	require.Empty(t, first.Errors)
+	assert.Equal(t, 1, first.Data["increment"])
+	assert.Nil(t, first.Extensions[idempotency.ReplayedExtensionName])
+
+	retry := post(t, h, incrementBody, headers)
+	require.Empty(t, retry.Errors)
+	assert.Equal(t, 1, retry.Data["increment"])
+	assert.Equal(t, true, retry.Extensions[idempotency.ReplayedExtensionName])
+	assert.EqualValues(t, 1, counter.Load())
+
+	// the key is scoped to the caller
+	other := post(t, h, incrementBody, map[string]string{"X-User-ID": "2", idempotency.HeaderName: "key-1"})
+	assert.Equal(t, 2, other.Data["increment"])
+
+	// another key runs the mutation again
+	another := post(t, h, incrementBody, map[string]string{"X-User-ID": "1", idempotency.HeaderName: "key-2"})
+	assert.Equal(t, 3, another.Data["increment"])
+}
+
+func TestExtension_RequestExtension(t *testing.T) {
+	h, counter := newTestServer(t)
+	body := `{"query":"mutation { increment }","extensions":{"idempotencyKey":"key-1"}}`
+	headers := map[string]string{"X-User-ID": "1"}
+
+	first := post(t, h, body, headers)
+	retry := post(t, h, body, headers)
+	assert.Equal(t, first.Data, retry.Data)
+	assert.Equal(t, true, retry.Extensions[idempotency.ReplayedExtensionName])
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestExtension_WithoutKey(t *testing.T) {
+	h, counter := newTestServer(t)
+
+	post(t, h, incrementBody, map[string]string{"X-User-ID": "1"})
+	post(t, h, incrementBody, map[string]string{"X-User-ID": "1"})
+	assert.EqualValues(t, 2, counter.Load())
+
+	// anonymous requests are not affected
+	post(t, h, incrementBody, map[string]string{idempotency.HeaderName: "key-1"})
+	post(t, h, incrementBody, map[string]string{idempotency.HeaderName: "key-1"})
+	assert.EqualValues(t, 4, counter.Load())
+}
+
+func TestExtension_ReleasesFailedMutation(t *testing.T) {
+	h, counter := newTestServer(t)
+	headers := map[string]string{"X-User-ID": "1", idempotency.HeaderName: "key-1"}
+	failBody := `{"query":"mutation($fail: Boolean) { increment(fail: $fail) }","variables":{"fail":true}}`
+	okBody := `{"query":"mutation($fail: Boolean) { increment(fail: $fail) }","variables":{"fail":false}}`
+
+	failed := post(t, h, failBody, headers)
+	require.NotEmpty(t, failed.Errors)
+
+	// the failed response is not stored, so the retry runs the mutation
+	failed = post(t, h, failBody, headers)
+	require.NotEmpty(t, failed.Errors)
+	assert.Nil(t, failed.Extensions[idempotency.ReplayedExtensionName])
+
+	resp := post(t, h, okBody, headers)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, 1, resp.Data["increment"])
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestExtension_KeyReused(t *testing.T) {
+	h, _ := newTestServer(t)
+	headers := map[string]string{"X-User-ID": "1", idempotency.HeaderName: "key-1"}
+
+	post(t, h, incrementBody, headers)
+	resp := post(t, h, `{"query":"mutation { increment }"}`, headers)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0]["message"], "has been used by another request")
+
+	resp = post(t, h, incrementBody, map[string]string{"X-User-ID": "1", idempotency.HeaderName: strings.Repeat("k", idempotency.MaxKeyLength+1)})
+	require.Len(t, resp.Errors, 1)
+}
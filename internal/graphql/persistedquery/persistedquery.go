@@ -0,0 +1,160 @@
+// Package persistedquery restricts the GraphQL operations to the allowlist
+// of the persisted queries published from the frontend build.
+package persistedquery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/persistedquery"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeNotAllowed is the error code of the operations not in the allowlist.
+const ErrCodeNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+// RequestsTotal tracks the operations checked by the strict mode.
+//
+// The result is "allowed", "bypassed", "rejected" or "error".
+var RequestsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_persisted_query_requests_total",
+		Help: "Total number of GraphQL operations checked against the persisted query allowlist",
+	},
+	[]string{"result"},
+)
+
+const (
+	extensionName = "PersistedQueryStrict"
+
+	// apqExtensionName is the request extension of the Automatic Persisted
+	// Queries, carrying the hash of the query.
+	apqExtensionName = "persistedQuery"
+
+	// cacheSize is the number of the persisted queries cached in memory.
+	cacheSize = 1000
+)
+
+// Allowlist looks up the persisted queries in the database by their
+// SHA-256 hashes.
+//
+// The persisted queries are immutable, so the found ones are cached in
+// memory. The missing ones are looked up every time, so that the uploaded
+// queries take effect without restarting the server.
+type Allowlist struct {
+	client *ent.Client
+	cache  graphql.Cache[string]
+}
+
+// NewAllowlist creates a new Allowlist.
+func NewAllowlist(client *ent.Client) *Allowlist {
+	return &Allowlist{
+		client: client,
+		cache:  lru.New[string](cacheSize),
+	}
+}
+
+// Lookup returns the query of the hash, and whether it is in the allowlist.
+func (a *Allowlist) Lookup(ctx context.Context, hash string) (string, bool, error) {
+	if query, ok := a.cache.Get(ctx, hash); ok {
+		return query, true, nil
+	}
+
+	pq, err := a.client.PersistedQuery.Query().Where(persistedquery.HashEQ(hash)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("query persisted query: %w", err)
+	}
+
+	a.cache.Add(ctx, hash, pq.Query)
+	return pq.Query, true, nil
+}
+
+// Strict is a gqlgen extension only running the operations in the
+// allowlist, unless Bypass reports that the request may run ad-hoc
+// queries (e.g. the administrators).
+//
+// The operation is looked up by the hash in the APQ extension, or by the
+// SHA-256 hash of the query text, and the query in the allowlist replaces
+// the query of the request. Therefore, it should be used before
+// extension.AutomaticPersistedQuery, so that the hash-only requests of the
+// persisted queries are resolved from the allowlist instead of the cache.
+type Strict struct {
+	Allowlist *Allowlist
+	Bypass    func(ctx context.Context) bool
+}
+
+func (s Strict) ExtensionName() string {
+	return extensionName
+}
+
+func (s Strict) Validate(graphql.ExecutableSchema) error {
+	if s.Allowlist == nil {
+		return errors.New("PersistedQueryStrict allowlist can not be nil")
+	}
+	return nil
+}
+
+func (s Strict) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if s.Bypass != nil && s.Bypass(ctx) {
+		RequestsTotal.WithLabelValues("bypassed").Inc()
+		return nil
+	}
+
+	hash := hashOf(rawParams)
+	if hash == "" {
+		// no operation is provided, which the executor rejects.
+		return nil
+	}
+
+	query, ok, err := s.Allowlist.Lookup(ctx, hash)
+	if err != nil {
+		RequestsTotal.WithLabelValues("error").Inc()
+		slog.Error("error looking up persisted query", "error", err, "hash", hash)
+		return gqlerror.Errorf("failed to look up the persisted query")
+	}
+	if !ok {
+		RequestsTotal.WithLabelValues("rejected").Inc()
+		gqlErr := gqlerror.Errorf("the operation is not in the persisted query allowlist")
+		errcode.Set(gqlErr, ErrCodeNotAllowed)
+		return gqlErr
+	}
+
+	RequestsTotal.WithLabelValues("allowed").Inc()
+	rawParams.Query = query
+	return nil
+}
+
+// hashOf returns the hash in the APQ extension of the request,
+// or the SHA-256 hash of the query text.
+func hashOf(rawParams *graphql.RawParams) string {
+	if ext, ok := rawParams.Extensions[apqExtensionName].(map[string]any); ok {
+		if hash, ok := ext["sha256Hash"].(string); ok && hash != "" {
+			return hash
+		}
+	}
+
+	if rawParams.Query == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(rawParams.Query))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	_ graphql.HandlerExtension          = Strict{}
+	_ graphql.OperationParameterMutator = Strict{}
+)
@@ -0,0 +1,146 @@
+package persistedquery_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/database-playground/backend-v2/internal/graphql/persistedquery"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	persistedQuery = "query Me { me }"
+	adhocQuery     = "query Other { me }"
+)
+
+type response struct {
+	Data   map[string]string `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func hashOf(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// newTestServer creates a server in the strict mode with persistedQuery in
+// the allowlist. The "me" field returns the executed query, and the
+// requests with the X-Admin header bypass the allowlist.
+func newTestServer(t *testing.T) http.Handler {
+	t.Helper()
+
+	entClient := testhelper.NewEntSqliteClient(t)
+	require.NoError(t, entClient.PersistedQuery.Create().SetHash(hashOf(persistedQuery)).SetQuery(persistedQuery).Exec(context.Background()))
+
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `type Query { me: String! }`})
+	srv := handler.New(&graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			data, _ := json.Marshal(map[string]string{"me": graphql.GetOperationContext(ctx).RawQuery})
+			return graphql.OneShot(&graphql.Response{Data: data})
+		},
+		SchemaFunc: func() *ast.Schema { return schema },
+	})
+	srv.AddTransport(transport.POST{})
+	srv.Use(persistedquery.Strict{
+		Allowlist: persistedquery.NewAllowlist(entClient),
+		Bypass: func(ctx context.Context) bool {
+			return ctx.Value(adminKey{}) != nil
+		},
+	})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Admin") != "" {
+			r = r.WithContext(context.WithValue(r.Context(), adminKey{}, true))
+		}
+		srv.ServeHTTP(w, r)
+	})
+}
+
+type adminKey struct{}
+
+func post(t *testing.T, h http.Handler, body map[string]any, admin bool) response {
+	t.Helper()
+
+	content, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(content)))
+	req.Header.Set("Content-Type", "application/json")
+	if admin {
+		req.Header.Set("X-Admin", "1")
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return resp
+}
+
+func apqExtension(hash string) map[string]any {
+	return map[string]any{
+		"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash},
+	}
+}
+
+func TestStrict_PersistedQuery(t *testing.T) {
+	h := newTestServer(t)
+
+	resp := post(t, h, map[string]any{"query": persistedQuery}, false)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, persistedQuery, resp.Data["me"])
+
+	// the hash-only request is resolved from the allowlist
+	resp = post(t, h, map[string]any{"extensions": apqExtension(hashOf(persistedQuery))}, false)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, persistedQuery, resp.Data["me"])
+
+	// the query in the allowlist replaces the query of the request
+	resp = post(t, h, map[string]any{"query": adhocQuery, "extensions": apqExtension(hashOf(persistedQuery))}, false)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, persistedQuery, resp.Data["me"])
+}
+
+func TestStrict_AdhocQuery(t *testing.T) {
+	h := newTestServer(t)
+
+	for _, body := range []map[string]any{
+		{"query": adhocQuery},
+		{"extensions": apqExtension(hashOf(adhocQuery))},
+		{"query": adhocQuery, "extensions": apqExtension(hashOf(adhocQuery))},
+	} {
+		resp := post(t, h, body, false)
+		require.Len(t, resp.Errors, 1, body)
+		assert.Equal(t, persistedquery.ErrCodeNotAllowed, resp.Errors[0].Extensions["code"])
+		assert.Nil(t, resp.Data)
+	}
+}
+
+func TestStrict_Bypass(t *testing.T) {
+	h := newTestServer(t)
+
+	resp := post(t, h, map[string]any{"query": adhocQuery}, true)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, adhocQuery, resp.Data["me"])
+}
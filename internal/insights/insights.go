@@ -0,0 +1,549 @@
+// Package insights aggregates the learning statistics of the groups for the teachers.
+package insights
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
+	"github.com/database-playground/backend-v2/ent/group"
+	"github.com/database-playground/backend-v2/ent/point"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.insights")
+
+// ErrInvalidRange is returned when the time range of a query is invalid.
+var ErrInvalidRange = errors.New("invalid time range")
+
+// ErrInvalidArgument is returned when an argument of a query is invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// MaxHeatmapDays is the maximum number of the days in ActivityHeatmap.
+const MaxHeatmapDays = 366
+
+// Service aggregates the statistics of the groups.
+type Service struct {
+	client *ent.Client
+
+	mistakeSampleSize int
+}
+
+// Option configures a Service.
+type Option func(*Service)
+
+// WithMistakeSampleSize sets the number of the latest failed submissions
+// clustered by CommonMistakes. Defaults to DefaultMistakeSampleSize.
+func WithMistakeSampleSize(n int) Option {
+	return func(s *Service) {
+		s.mistakeSampleSize = n
+	}
+}
+
+// NewService creates a new insights service.
+func NewService(client *ent.Client, opts ...Option) *Service {
+	s := &Service{client: client, mistakeSampleSize: DefaultMistakeSampleSize}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// GroupQuestionStatistics returns the statistics of every question (in the
+// category, if set) among the users in the group, ordered by the question ID.
+func (s *Service) GroupQuestionStatistics(ctx context.Context, groupID int, category *string) ([]*GroupQuestionStatistics, error) {
+	ctx, span := tracer.Start(ctx, "GroupQuestionStatistics",
+		trace.WithAttributes(
+			attribute.Int("group.id", groupID),
+		))
+	defer span.End()
+
+	groupSize, err := s.client.User.Query().Where(user.HasGroupWith(group.ID(groupID))).Count(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to count group users")
+		span.RecordError(err)
+		return nil, fmt.Errorf("count group users: %w", err)
+	}
+
+	questionQuery := s.client.Question.Query().Order(ent.Asc(question.FieldID))
+	submissionScope := []predicate.Submission{
+		submission.HasUserWith(user.HasGroupWith(group.ID(groupID))),
+	}
+	if category != nil {
+		questionQuery = questionQuery.Where(question.CategoryEQ(*category))
+		submissionScope = append(submissionScope, submission.HasQuestionWith(question.CategoryEQ(*category)))
+	}
+
+	questions, err := questionQuery.All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query questions")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query questions: %w", err)
+	}
+
+	// The first submission and the first success of every question attempted by every user.
+	span.AddEvent("database.first_submissions.querying")
+	var firsts []struct {
+		UserID           int            `json:"user_id"`
+		QuestionID       int            `json:"question_id"`
+		FirstAttemptedAt models.SQLTime `json:"first_attempted_at"`
+		FirstSolvedAt    models.SQLTime `json:"first_solved_at"`
+	}
+	err = s.client.Submission.Query().
+		Where(submissionScope...).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(
+				sql.As(sel.C(submission.UserColumn), "user_id"),
+				sql.As(sel.C(submission.QuestionColumn), "question_id"),
+				sql.As(sql.Min(sel.C(submission.FieldSubmittedAt)), "first_attempted_at"),
+				sql.As(fmt.Sprintf("MIN(CASE WHEN %s = '%s' THEN %s END)",
+					sel.C(submission.FieldStatus), submission.StatusSuccess, sel.C(submission.FieldSubmittedAt),
+				), "first_solved_at"),
+			).GroupBy(sel.C(submission.UserColumn), sel.C(submission.QuestionColumn))
+		}).
+		Scan(ctx, &firsts)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query first submissions")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query first submissions: %w", err)
+	}
+
+	// The number of the submissions until (and including) the first success.
+	span.AddEvent("database.attempts.querying")
+	var attempts []struct {
+		QuestionID int `json:"question_id"`
+		Attempts   int `json:"attempts"`
+	}
+	err = s.client.Submission.Query().
+		Where(submissionScope...).
+		Where(untilFirstSuccess).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(
+				sql.As(sel.C(submission.QuestionColumn), "question_id"),
+				sql.As(sql.Count("*"), "attempts"),
+			).GroupBy(sel.C(submission.UserColumn), sel.C(submission.QuestionColumn))
+		}).
+		Scan(ctx, &attempts)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query attempts")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query attempts: %w", err)
+	}
+
+	span.AddEvent("results.processing")
+	type questionAggregate struct {
+		attempted       int
+		attempts        []float64
+		secondsToSolves []float64
+	}
+	aggregates := make(map[int]*questionAggregate, len(questions))
+	aggregateOf := func(questionID int) *questionAggregate {
+		a, ok := aggregates[questionID]
+		if !ok {
+			a = &questionAggregate{}
+			aggregates[questionID] = a
+		}
+		return a
+	}
+
+	for _, f := range firsts {
+		a := aggregateOf(f.QuestionID)
+		a.attempted++
+
+		firstSolvedAt := time.Time(f.FirstSolvedAt)
+		if !firstSolvedAt.IsZero() {
+			a.secondsToSolves = append(a.secondsToSolves, firstSolvedAt.Sub(time.Time(f.FirstAttemptedAt)).Seconds())
+		}
+	}
+	for _, r := range attempts {
+		a := aggregateOf(r.QuestionID)
+		a.attempts = append(a.attempts, float64(r.Attempts))
+	}
+
+	statistics := make([]*GroupQuestionStatistics, len(questions))
+	for i, q := range questions {
+		a := aggregateOf(q.ID)
+		solved := len(a.secondsToSolves)
+
+		statistics[i] = &GroupQuestionStatistics{
+			Question:                  q,
+			AttemptedUsers:            a.attempted,
+			SolvedUsers:               solved,
+			MedianAttempts:            median(a.attempts),
+			MedianSecondsToFirstSolve: median(a.secondsToSolves),
+		}
+		if groupSize > 0 {
+			statistics[i].SolveRate = float64(solved) / float64(groupSize)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("insights.group_size", groupSize),
+		attribute.Int("insights.questions_count", len(questions)),
+	)
+	span.SetStatus(otelcodes.Ok, "Group question statistics retrieved successfully")
+	return statistics, nil
+}
+
+// untilFirstSuccess is the predicate of the submissions submitted until (and
+// including) the first successful submission of the user to the question.
+func untilFirstSuccess(sel *sql.Selector) {
+	first := sql.Table(submission.Table).As("first_success")
+	firstSuccess := sql.Select(sql.Min(first.C(submission.FieldSubmittedAt))).
+		From(first).
+		Where(sql.And(
+			sql.ColumnsEQ(first.C(submission.UserColumn), sel.C(submission.UserColumn)),
+			sql.ColumnsEQ(first.C(submission.QuestionColumn), sel.C(submission.QuestionColumn)),
+			sql.EQ(first.C(submission.FieldStatus), submission.StatusSuccess.String()),
+		))
+
+	sel.Where(sql.P(func(b *sql.Builder) {
+		b.Ident(sel.C(submission.FieldSubmittedAt)).WriteOp(sql.OpLTE).Wrap(func(b *sql.Builder) {
+			b.Join(firstSuccess)
+		})
+	}))
+}
+
+// median returns the median of the values, or nil if there are none.
+func median(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	slices.Sort(values)
+	m := values[len(values)/2]
+	if len(values)%2 == 0 {
+		m = (values[len(values)/2-1] + m) / 2
+	}
+
+	return &m
+}
+
+// InactiveStudents returns the users in the group without any submission or
+// event since the time, the least recently active first.
+func (s *Service) InactiveStudents(ctx context.Context, groupID int, since time.Time) ([]*InactiveStudent, error) {
+	ctx, span := tracer.Start(ctx, "InactiveStudents",
+		trace.WithAttributes(
+			attribute.Int("group.id", groupID),
+			attribute.String("insights.since", since.Format(time.RFC3339)),
+		))
+	defer span.End()
+
+	users, err := s.client.User.Query().
+		Where(user.HasGroupWith(group.ID(groupID))).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query group users")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query group users: %w", err)
+	}
+
+	type lastActivity struct {
+		UserID       int            `json:"user_id"`
+		LastActiveAt models.SQLTime `json:"last_active_at"`
+	}
+
+	span.AddEvent("database.last_submissions.querying")
+	var lastSubmissions []lastActivity
+	err = s.client.Submission.Query().
+		Where(submission.HasUserWith(user.HasGroupWith(group.ID(groupID)))).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(
+				sql.As(sel.C(submission.UserColumn), "user_id"),
+				sql.As(sql.Max(sel.C(submission.FieldSubmittedAt)), "last_active_at"),
+			).GroupBy(sel.C(submission.UserColumn))
+		}).
+		Scan(ctx, &lastSubmissions)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query last submissions")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query last submissions: %w", err)
+	}
+
+	span.AddEvent("database.last_events.querying")
+	var lastEvents []lastActivity
+	err = s.client.Event.Query().
+		Where(event.HasUserWith(user.HasGroupWith(group.ID(groupID)))).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(
+				sql.As(sel.C(event.FieldUserID), "user_id"),
+				sql.As(sql.Max(sel.C(event.FieldTriggeredAt)), "last_active_at"),
+			).GroupBy(sel.C(event.FieldUserID))
+		}).
+		Scan(ctx, &lastEvents)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query last events")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query last events: %w", err)
+	}
+
+	lastActiveAt := make(map[int]time.Time, len(users))
+	for _, a := range slices.Concat(lastSubmissions, lastEvents) {
+		if t := time.Time(a.LastActiveAt); t.After(lastActiveAt[a.UserID]) {
+			lastActiveAt[a.UserID] = t
+		}
+	}
+
+	var inactive []*InactiveStudent
+	for _, u := range users {
+		last, ok := lastActiveAt[u.ID]
+		if !ok {
+			inactive = append(inactive, &InactiveStudent{User: u})
+			continue
+		}
+		if last.Before(since) {
+			inactive = append(inactive, &InactiveStudent{User: u, LastActiveAt: &last})
+		}
+	}
+
+	// The users who have never been active come first.
+	slices.SortStableFunc(inactive, func(a, b *InactiveStudent) int {
+		switch {
+		case a.LastActiveAt == nil && b.LastActiveAt == nil:
+			return 0
+		case a.LastActiveAt == nil:
+			return -1
+		case b.LastActiveAt == nil:
+			return 1
+		default:
+			return a.LastActiveAt.Compare(*b.LastActiveAt)
+		}
+	})
+
+	span.SetAttributes(attribute.Int("insights.inactive_count", len(inactive)))
+	span.SetStatus(otelcodes.Ok, "Inactive students retrieved successfully")
+	return inactive, nil
+}
+
+// ActivityHeatmap returns the activity of the users in the group in every day
+// from the day of from to the day before to, in the location.
+//
+// The events archived by the retention policy are counted from their daily
+// counts, which are split in UTC, so they are counted on the same date in the
+// location. The location must be an IANA time zone, not time.Local.
+func (s *Service) ActivityHeatmap(ctx context.Context, groupID int, from, to time.Time, location *time.Location) ([]*ActivityDay, error) {
+	ctx, span := tracer.Start(ctx, "ActivityHeatmap",
+		trace.WithAttributes(
+			attribute.Int("group.id", groupID),
+			attribute.String("insights.from", from.Format(time.RFC3339)),
+			attribute.String("insights.to", to.Format(time.RFC3339)),
+			attribute.String("insights.location", location.String()),
+		))
+	defer span.End()
+
+	// The location is named in the SQL of Postgres, which has no "Local" time zone.
+	if location.String() == "Local" {
+		span.SetStatus(otelcodes.Error, "Invalid location")
+		return nil, fmt.Errorf("%w: the location must be an IANA time zone", ErrInvalidArgument)
+	}
+
+	start := startOfDay(from.In(location))
+	end := startOfDay(to.In(location))
+	if !start.Before(end) {
+		span.SetStatus(otelcodes.Error, "Invalid time range")
+		return nil, fmt.Errorf("%w: from must be at least a day before to", ErrInvalidRange)
+	}
+	if end.After(start.AddDate(0, 0, MaxHeatmapDays)) {
+		span.SetStatus(otelcodes.Error, "Invalid time range")
+		return nil, fmt.Errorf("%w: the range must be at most %d days", ErrInvalidRange, MaxHeatmapDays)
+	}
+
+	type dailyRow struct {
+		Day    string `json:"day"`
+		UserID int    `json:"user_id"`
+		Count  int    `json:"count"`
+		Solved int    `json:"solved"`
+	}
+
+	span.AddEvent("database.daily_submissions.querying")
+	var submissionRows []dailyRow
+	err := s.client.Submission.Query().
+		Where(
+			submission.HasUserWith(user.HasGroupWith(group.ID(groupID))),
+			submission.SubmittedAtGTE(start),
+			submission.SubmittedAtLT(end),
+		).
+		Modify(func(sel *sql.Selector) {
+			day := dayOf(sel.Dialect(), sel.C(submission.FieldSubmittedAt), location, start)
+			sel.Select(
+				sql.As(day, "day"),
+				sql.As(sel.C(submission.UserColumn), "user_id"),
+				sql.As(sql.Count("*"), "count"),
+				sql.As(fmt.Sprintf("SUM(CASE WHEN %s = '%s' THEN 1 ELSE 0 END)",
+					sel.C(submission.FieldStatus), submission.StatusSuccess,
+				), "solved"),
+			).GroupBy(day, sel.C(submission.UserColumn))
+		}).
+		Scan(ctx, &submissionRows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query daily submissions")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query daily submissions: %w", err)
+	}
+
+	span.AddEvent("database.daily_events.querying")
+	var eventRows []dailyRow
+	err = s.client.Event.Query().
+		Where(
+			event.HasUserWith(user.HasGroupWith(group.ID(groupID))),
+			event.TriggeredAtGTE(start),
+			event.TriggeredAtLT(end),
+		).
+		Modify(func(sel *sql.Selector) {
+			day := dayOf(sel.Dialect(), sel.C(event.FieldTriggeredAt), location, start)
+			sel.Select(
+				sql.As(day, "day"),
+				sql.As(sel.C(event.FieldUserID), "user_id"),
+				sql.As(sql.Count("*"), "count"),
+			).GroupBy(day, sel.C(event.FieldUserID))
+		}).
+		Scan(ctx, &eventRows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query daily events")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query daily events: %w", err)
+	}
+
+	span.AddEvent("database.archived_events.querying")
+	userIDs, err := s.client.User.Query().Where(user.HasGroupWith(group.ID(groupID))).IDs(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query group users")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query group users: %w", err)
+	}
+
+	var archivedRows []struct {
+		UserID int            `json:"user_id"`
+		Date   models.SQLTime `json:"date"`
+		Count  int            `json:"count"`
+	}
+	err = s.client.EventDailyCount.Query().
+		Where(
+			eventdailycount.UserIDIn(userIDs...),
+			eventdailycount.DateGTE(utcDateOf(start)),
+			eventdailycount.DateLT(utcDateOf(end)),
+		).
+		GroupBy(eventdailycount.FieldUserID, eventdailycount.FieldDate).
+		Aggregate(ent.As(ent.Sum(eventdailycount.FieldCount), "count")).
+		Scan(ctx, &archivedRows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query archived events")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query archived events: %w", err)
+	}
+
+	span.AddEvent("database.daily_points.querying")
+	var pointRows []struct {
+		Day    string `json:"day"`
+		Points int    `json:"points"`
+	}
+	err = s.client.Point.Query().
+		Where(
+			point.HasUserWith(user.HasGroupWith(group.ID(groupID))),
+			point.GrantedAtGTE(start),
+			point.GrantedAtLT(end),
+		).
+		Modify(func(sel *sql.Selector) {
+			day := dayOf(sel.Dialect(), sel.C(point.FieldGrantedAt), location, start)
+			sel.Select(
+				sql.As(day, "day"),
+				sql.As(sql.Sum(sel.C(point.FieldPoints)), "points"),
+			).GroupBy(day)
+		}).
+		Scan(ctx, &pointRows)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query daily points")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query daily points: %w", err)
+	}
+
+	span.AddEvent("results.processing")
+	var days []*ActivityDay
+	index := make(map[string]int)
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		index[day.Format(time.DateOnly)] = len(days)
+		days = append(days, &ActivityDay{Date: day})
+	}
+
+	activeUsers := make([]map[int]struct{}, len(days))
+	active := func(i int, userID int) {
+		if activeUsers[i] == nil {
+			activeUsers[i] = make(map[int]struct{})
+		}
+		activeUsers[i][userID] = struct{}{}
+	}
+
+	for _, r := range submissionRows {
+		if i, ok := index[r.Day]; ok {
+			days[i].Submissions += r.Count
+			days[i].SolvedSubmissions += r.Solved
+			active(i, r.UserID)
+		}
+	}
+	for _, r := range eventRows {
+		if i, ok := index[r.Day]; ok {
+			days[i].Events += r.Count
+			active(i, r.UserID)
+		}
+	}
+	for _, r := range archivedRows {
+		if i, ok := index[time.Time(r.Date).UTC().Format(time.DateOnly)]; ok {
+			days[i].Events += r.Count
+			active(i, r.UserID)
+		}
+	}
+	for _, r := range pointRows {
+		if i, ok := index[r.Day]; ok {
+			days[i].Points += r.Points
+		}
+	}
+	for i := range days {
+		days[i].ActiveUsers = len(activeUsers[i])
+	}
+
+	span.SetAttributes(attribute.Int("insights.days_count", len(days)))
+	span.SetStatus(otelcodes.Ok, "Activity heatmap retrieved successfully")
+	return days, nil
+}
+
+// startOfDay returns the start of the day of t, in the location of t.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// utcDateOf returns the start of the date of t in UTC, which is how the
+// daily counts of the archived events are dated.
+func utcDateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// dayOf returns the SQL expression of the date (YYYY-MM-DD) of the time column in the location.
+//
+// SQLite has no time zones, so the offset of the location at the time is used
+// for every row, which is off by the DST changes in the range.
+func dayOf(d string, column string, location *time.Location, at time.Time) string {
+	if d == dialect.Postgres {
+		// The location name is validated by time.LoadLocation, which has no quotes.
+		return fmt.Sprintf("to_char(%s AT TIME ZONE '%s', 'YYYY-MM-DD')", column, location.String())
+	}
+
+	_, offset := at.In(location).Zone()
+	return fmt.Sprintf("date(%s, '%+d seconds')", column, offset)
+}
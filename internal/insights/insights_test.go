@@ -0,0 +1,316 @@
+package insights_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	entQuestion "github.com/database-playground/backend-v2/ent/question"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/internal/insights"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/database-playground/backend-v2/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// setupTestGroup creates a group with 4 users, another group with 1 user,
+// and 2 questions.
+func setupTestGroup(t *testing.T, client *ent.Client) (*ent.Group, []*ent.User, *ent.User, []*ent.Question) {
+	t.Helper()
+	ctx := context.Background()
+
+	g, err := client.Group.Create().SetName("Class A").Save(ctx)
+	require.NoError(t, err)
+	other, err := client.Group.Create().SetName("Class B").Save(ctx)
+	require.NoError(t, err)
+
+	users := make([]*ent.User, 4)
+	for i := range users {
+		users[i], err = client.User.Create().
+			SetName("User " + strconv.Itoa(i+1)).
+			SetEmail("user" + strconv.Itoa(i+1) + "@example.com").
+			SetGroup(g).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	outsider, err := client.User.Create().
+		SetName("Outsider").
+		SetEmail("outsider@example.com").
+		SetGroup(other).
+		Save(ctx)
+	require.NoError(t, err)
+
+	database, err := client.Database.Create().
+		SetSlug("test_db").
+		SetSchema(`{"tables": []}`).
+		SetRelationFigure("test_figure").
+		Save(ctx)
+	require.NoError(t, err)
+
+	questions := make([]*ent.Question, 2)
+	for i := range questions {
+		questions[i], err = client.Question.Create().
+			SetCategory("category-" + strconv.Itoa(i+1)).
+			SetTitle("Question " + strconv.Itoa(i+1)).
+			SetDescription("Test question").
+			SetReferenceAnswer("SELECT 1").
+			SetDifficulty(entQuestion.DifficultyEasy).
+			SetDatabase(database).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	return g, users, outsider, questions
+}
+
+func submit(t *testing.T, client *ent.Client, u *ent.User, q *ent.Question, status entSubmission.Status, at time.Time) {
+	t.Helper()
+
+	_, err := client.Submission.Create().
+		SetUser(u).
+		SetQuestion(q).
+		SetSubmittedCode("SELECT 1").
+		SetStatus(status).
+		SetSubmittedAt(at).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func TestGroupQuestionStatistics(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	g, users, outsider, questions := setupTestGroup(t, client)
+	ctx := context.Background()
+
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// User 1 solves question 1 at the first attempt.
+	submit(t, client, users[0], questions[0], entSubmission.StatusSuccess, base)
+	// User 2 solves question 1 at the third attempt, 10 minutes after the first one,
+	// and fails again after that.
+	submit(t, client, users[1], questions[0], entSubmission.StatusFailed, base)
+	submit(t, client, users[1], questions[0], entSubmission.StatusFailed, base.Add(5*time.Minute))
+	submit(t, client, users[1], questions[0], entSubmission.StatusSuccess, base.Add(10*time.Minute))
+	submit(t, client, users[1], questions[0], entSubmission.StatusFailed, base.Add(20*time.Minute))
+	// User 3 attempts question 1 without solving it.
+	submit(t, client, users[2], questions[0], entSubmission.StatusFailed, base)
+	// The users of the other groups are not counted.
+	submit(t, client, outsider, questions[0], entSubmission.StatusSuccess, base)
+
+	service := insights.NewService(client)
+	statistics, err := service.GroupQuestionStatistics(ctx, g.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, statistics, 2)
+
+	q1 := statistics[0]
+	assert.Equal(t, questions[0].ID, q1.Question.ID)
+	assert.Equal(t, 3, q1.AttemptedUsers)
+	assert.Equal(t, 2, q1.SolvedUsers)
+	assert.InDelta(t, 0.5, q1.SolveRate, 1e-9)
+	require.NotNil(t, q1.MedianAttempts)
+	assert.InDelta(t, 2, *q1.MedianAttempts, 1e-9)
+	require.NotNil(t, q1.MedianSecondsToFirstSolve)
+	assert.InDelta(t, 300, *q1.MedianSecondsToFirstSolve, 1e-9)
+
+	q2 := statistics[1]
+	assert.Equal(t, questions[1].ID, q2.Question.ID)
+	assert.Zero(t, q2.AttemptedUsers)
+	assert.Zero(t, q2.SolveRate)
+	assert.Nil(t, q2.MedianAttempts)
+	assert.Nil(t, q2.MedianSecondsToFirstSolve)
+
+	category := "category-2"
+	statistics, err = service.GroupQuestionStatistics(ctx, g.ID, &category)
+	require.NoError(t, err)
+	require.Len(t, statistics, 1)
+	assert.Equal(t, questions[1].ID, statistics[0].Question.ID)
+}
+
+func TestInactiveStudents(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	g, users, _, questions := setupTestGroup(t, client)
+	ctx := context.Background()
+
+	now := time.Now()
+
+	// User 1 submitted recently, User 2 triggered an event recently but submitted
+	// long ago, User 3 has been inactive for a while, and User 4 has never been active.
+	submit(t, client, users[0], questions[0], entSubmission.StatusFailed, now.Add(-time.Hour))
+	submit(t, client, users[1], questions[0], entSubmission.StatusFailed, now.AddDate(0, 0, -30))
+	_, err := client.Event.Create().SetUserID(users[1].ID).SetType("login").SetTriggeredAt(now.Add(-time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Event.Create().SetUserID(users[2].ID).SetType("login").SetTriggeredAt(now.AddDate(0, 0, -10)).Save(ctx)
+	require.NoError(t, err)
+
+	service := insights.NewService(client)
+	inactive, err := service.InactiveStudents(ctx, g.ID, now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	require.Len(t, inactive, 2)
+
+	assert.Equal(t, users[3].ID, inactive[0].User.ID)
+	assert.Nil(t, inactive[0].LastActiveAt)
+	assert.Equal(t, users[2].ID, inactive[1].User.ID)
+	require.NotNil(t, inactive[1].LastActiveAt)
+	assert.WithinDuration(t, now.AddDate(0, 0, -10), *inactive[1].LastActiveAt, time.Second)
+}
+
+func TestActivityHeatmap(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	g, users, outsider, questions := setupTestGroup(t, client)
+	ctx := context.Background()
+
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+
+	day1 := time.Date(2025, 3, 1, 0, 0, 0, 0, taipei)
+	day2 := day1.AddDate(0, 0, 1)
+
+	// 2025-03-01 23:30 in Taipei is 2025-03-01 15:30 in UTC.
+	submit(t, client, users[0], questions[0], entSubmission.StatusFailed, day1.Add(23*time.Hour+30*time.Minute).UTC())
+	submit(t, client, users[0], questions[0], entSubmission.StatusSuccess, day1.Add(23*time.Hour+40*time.Minute).UTC())
+	// 2025-03-02 00:30 in Taipei is 2025-03-01 16:30 in UTC.
+	submit(t, client, users[1], questions[0], entSubmission.StatusFailed, day2.Add(30*time.Minute).UTC())
+	submit(t, client, outsider, questions[0], entSubmission.StatusFailed, day2.Add(30*time.Minute).UTC())
+
+	_, err = client.Event.Create().SetUserID(users[2].ID).SetType("login").SetTriggeredAt(day2.Add(time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Point.Create().SetUser(users[0]).SetPoints(30).SetGrantedAt(day1.Add(23 * time.Hour)).Save(ctx)
+	require.NoError(t, err)
+
+	// The archived events are counted on their date in UTC.
+	_, err = client.EventDailyCount.Create().
+		SetUserID(users[3].ID).
+		SetType("login").
+		SetDate(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)).
+		SetCount(2).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.EventDailyCount.Create().
+		SetUserID(outsider.ID).
+		SetType("login").
+		SetDate(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)).
+		SetCount(5).
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := insights.NewService(client)
+	days, err := service.ActivityHeatmap(ctx, g.ID, day1, day1.AddDate(0, 0, 3), taipei)
+	require.NoError(t, err)
+	require.Len(t, days, 3)
+
+	assert.True(t, days[0].Date.Equal(day1))
+	assert.Equal(t, 1, days[0].ActiveUsers)
+	assert.Equal(t, 2, days[0].Submissions)
+	assert.Equal(t, 1, days[0].SolvedSubmissions)
+	assert.Equal(t, 30, days[0].Points)
+
+	assert.True(t, days[1].Date.Equal(day2))
+	assert.Equal(t, 2, days[1].ActiveUsers)
+	assert.Equal(t, 1, days[1].Submissions)
+	assert.Equal(t, 1, days[1].Events)
+
+	assert.Equal(t, 1, days[2].ActiveUsers)
+	assert.Equal(t, 2, days[2].Events)
+	assert.Zero(t, days[2].Submissions)
+
+	_, err = service.ActivityHeatmap(ctx, g.ID, day1, day2, time.Local)
+	require.ErrorIs(t, err, insights.ErrInvalidArgument)
+	_, err = service.ActivityHeatmap(ctx, g.ID, day2, day1, taipei)
+	require.ErrorIs(t, err, insights.ErrInvalidRange)
+	_, err = service.ActivityHeatmap(ctx, g.ID, day1, day1.AddDate(0, 0, insights.MaxHeatmapDays+1), taipei)
+	require.ErrorIs(t, err, insights.ErrInvalidRange)
+}
+
+func TestCommonMistakes(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	_, users, outsider, questions := setupTestGroup(t, client)
+	ctx := context.Background()
+
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	fail := func(u *ent.User, code string, errMessage *string, rows [][]string, at time.Time) *ent.Submission {
+		t.Helper()
+
+		create := client.Submission.Create().
+			SetUser(u).
+			SetQuestion(questions[0]).
+			SetSubmittedCode(code).
+			SetStatus(entSubmission.StatusFailed).
+			SetNillableError(errMessage).
+			SetSubmittedAt(at)
+		if rows != nil {
+			create.SetQueryResult(&models.UserSQLExecutionResult{
+				SQLExecutionResult: models.SQLExecutionResult{Columns: []string{"name"}, Rows: rows},
+			})
+		}
+
+		s, err := create.Save(ctx)
+		require.NoError(t, err)
+		return s
+	}
+
+	noColumn := "QUERY_ERROR: no such column: nmae"
+	fail(users[0], "SELECT nmae FROM users;", &noColumn, nil, base)
+	fail(users[1], "select  nmae\nfrom users", &noColumn, nil, base.Add(time.Minute))
+	latestNoColumn := fail(users[1], "SELECT nmae FROM users WHERE id = 1", &noColumn, nil, base.Add(2*time.Minute))
+	fail(users[2], "SELECT name FROM users", nil, [][]string{{"Alice"}, {"Bob"}}, base)
+	fail(outsider, "SELECT name FROM users WHERE name = 'Bob'", nil, [][]string{{"Alice"}, {"Bob"}}, base)
+	fail(users[3], "SELECT name FROM users LIMIT 1", nil, [][]string{{"Alice"}}, base)
+
+	// The successful submissions are not clustered.
+	submit(t, client, users[0], questions[0], entSubmission.StatusSuccess, base.Add(time.Hour))
+
+	service := insights.NewService(client)
+
+	clusters, err := service.CommonMistakes(ctx, questions[0].ID, nil, 3)
+	require.NoError(t, err)
+	require.Len(t, clusters, 3)
+
+	assert.Equal(t, insights.MistakeKindError, clusters[0].Kind)
+	assert.Equal(t, noColumn, clusters[0].Key)
+	assert.Equal(t, 3, clusters[0].Count)
+	assert.Equal(t, 2, clusters[0].Users)
+	assert.Equal(t, latestNoColumn.ID, clusters[0].Example.ID)
+
+	assert.Equal(t, insights.MistakeKindSQL, clusters[1].Kind)
+	assert.Equal(t, "SELECT nmae FROM users", clusters[1].Key)
+	assert.Equal(t, 2, clusters[1].Count)
+
+	assert.Equal(t, insights.MistakeKindWrongResult, clusters[2].Kind)
+	assert.Equal(t, 2, clusters[2].Count)
+	assert.Equal(t, 2, clusters[2].Users)
+
+	// Only the latest failed submissions are clustered.
+	sampled := insights.NewService(client, insights.WithMistakeSampleSize(2))
+	errorKind := insights.MistakeKindError
+	clusters, err = sampled.CommonMistakes(ctx, questions[0].ID, &errorKind, 5)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, 2, clusters[0].Count)
+	assert.Equal(t, 1, clusters[0].Users)
+
+	kind := insights.MistakeKindWrongResult
+	clusters, err = service.CommonMistakes(ctx, questions[0].ID, &kind, 5)
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	assert.Equal(t, []int{2, 1}, []int{clusters[0].Count, clusters[1].Count})
+
+	kind = insights.MistakeKindSQL
+	clusters, err = service.CommonMistakes(ctx, questions[0].ID, &kind, 5)
+	require.NoError(t, err)
+	require.Len(t, clusters, 5)
+	// The literals are replaced with the placeholders.
+	assert.Equal(t, "SELECT nmae FROM users", clusters[0].Key)
+	keys := []string{clusters[1].Key, clusters[2].Key, clusters[3].Key, clusters[4].Key}
+	assert.Contains(t, keys, "SELECT name FROM users WHERE name = ?")
+
+	_, err = service.CommonMistakes(ctx, questions[0].ID, nil, 0)
+	require.ErrorIs(t, err, insights.ErrInvalidArgument)
+	kind = "UNKNOWN"
+	_, err = service.CommonMistakes(ctx, questions[0].ID, &kind, 5)
+	require.ErrorIs(t, err, insights.ErrInvalidArgument)
+}
@@ -0,0 +1,208 @@
+package insights
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/internal/sqlnorm"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MistakeKind is how the failed submissions are clustered.
+type MistakeKind string
+
+const (
+	// MistakeKindError clusters the submissions failed with the same runner error.
+	MistakeKindError MistakeKind = "ERROR"
+	// MistakeKindWrongResult clusters the submissions returning the same wrong result set.
+	MistakeKindWrongResult MistakeKind = "WRONG_RESULT"
+	// MistakeKindSQL clusters the failed submissions with the same normalized SQL (see sqlnorm.Normalize).
+	MistakeKindSQL MistakeKind = "SQL"
+)
+
+// MistakeKinds are all the kinds of the mistakes.
+var MistakeKinds = []MistakeKind{MistakeKindError, MistakeKindWrongResult, MistakeKindSQL}
+
+// IsValid returns whether the kind is one of MistakeKinds.
+func (k MistakeKind) IsValid() bool {
+	return slices.Contains(MistakeKinds, k)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (k MistakeKind) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(string(k)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (k *MistakeKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", v)
+	}
+
+	*k = MistakeKind(str)
+	if !k.IsValid() {
+		return fmt.Errorf("%s is not a valid MistakeKind", str)
+	}
+	return nil
+}
+
+// MaxMistakeClusters is the maximum number of the clusters returned by CommonMistakes.
+const MaxMistakeClusters = 50
+
+// DefaultMistakeSampleSize is the default number of the latest failed
+// submissions clustered by CommonMistakes.
+const DefaultMistakeSampleSize = 1000
+
+// MistakeCluster is a group of the failed submissions with the same mistake.
+type MistakeCluster struct {
+	Kind MistakeKind
+	// Key identifies the cluster: the runner error, the hash of the result
+	// set, or the normalized SQL, depending on the kind.
+	Key string
+	// Count is the number of the failed submissions in the cluster.
+	Count int
+	// Users is the number of the distinct users in the cluster.
+	Users int
+	// Example is the latest submission in the cluster.
+	Example *ent.Submission
+}
+
+// CommonMistakes clusters the failed submissions of the question and returns
+// the largest limit clusters, ordered by the count and then the number of
+// the users in descending order. kind filters the clusters if set.
+//
+// A submission failed with a runner error is clustered by the error, and
+// the one returning a wrong result set is clustered by the hash of the
+// result set. Every failed submission is also clustered by its normalized SQL.
+//
+// Only the latest failed submissions (see WithMistakeSampleSize) are clustered,
+// so the cost does not grow with the history of a popular question.
+func (s *Service) CommonMistakes(ctx context.Context, questionID int, kind *MistakeKind, limit int) ([]*MistakeCluster, error) {
+	ctx, span := tracer.Start(ctx, "CommonMistakes",
+		trace.WithAttributes(
+			attribute.Int("question.id", questionID),
+			attribute.Int("limit", limit),
+		))
+	defer span.End()
+
+	if limit <= 0 || limit > MaxMistakeClusters {
+		span.SetStatus(otelcodes.Error, "Invalid limit")
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidArgument, MaxMistakeClusters)
+	}
+	if kind != nil && !kind.IsValid() {
+		span.SetStatus(otelcodes.Error, "Invalid kind")
+		return nil, fmt.Errorf("%w: unknown mistake kind %q", ErrInvalidArgument, *kind)
+	}
+
+	span.AddEvent("submissions.querying")
+	submissions, err := s.client.Submission.Query().
+		Where(
+			submission.HasQuestionWith(question.ID(questionID)),
+			submission.StatusEQ(submission.StatusFailed),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Order(ent.Desc(submission.FieldSubmittedAt), ent.Desc(submission.FieldID)).
+		Limit(s.mistakeSampleSize).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query failed submissions")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query failed submissions: %w", err)
+	}
+
+	span.AddEvent("submissions.clustering")
+	type clusterKey struct {
+		kind MistakeKind
+		key  string
+	}
+	clusters := make(map[clusterKey]*MistakeCluster)
+	users := make(map[clusterKey]map[int]struct{})
+
+	add := func(k MistakeKind, key string, sub *ent.Submission) {
+		if kind != nil && *kind != k {
+			return
+		}
+
+		ck := clusterKey{k, key}
+		c, ok := clusters[ck]
+		if !ok {
+			// the submissions are ordered by the time in descending order,
+			// so the first one is the latest.
+			c = &MistakeCluster{Kind: k, Key: key, Example: sub}
+			clusters[ck] = c
+			users[ck] = make(map[int]struct{})
+		}
+		c.Count++
+		if sub.Edges.User != nil {
+			users[ck][sub.Edges.User.ID] = struct{}{}
+		}
+	}
+
+	for _, sub := range submissions {
+		switch {
+		case sub.Error != nil:
+			add(MistakeKindError, *sub.Error, sub)
+		case sub.QueryResult != nil:
+			key, err := hashResult(sub)
+			if err != nil {
+				span.SetStatus(otelcodes.Error, "Failed to hash the result")
+				span.RecordError(err)
+				return nil, err
+			}
+			add(MistakeKindWrongResult, key, sub)
+		}
+
+		add(MistakeKindSQL, sqlnorm.Normalize(sub.SubmittedCode), sub)
+	}
+
+	result := make([]*MistakeCluster, 0, len(clusters))
+	for ck, c := range clusters {
+		c.Users = len(users[ck])
+		result = append(result, c)
+	}
+	slices.SortFunc(result, func(a, b *MistakeCluster) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(b.Users, a.Users),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Key, b.Key),
+		)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	span.SetAttributes(
+		attribute.Int("insights.failed_submissions", len(submissions)),
+		attribute.Int("insights.clusters", len(clusters)),
+	)
+	span.SetStatus(otelcodes.Ok, "Common mistakes retrieved successfully")
+	return result, nil
+}
+
+// hashResult returns the hex-encoded SHA-256 hash of the result set of the submission.
+func hashResult(sub *ent.Submission) (string, error) {
+	encoded, err := json.Marshal(sub.QueryResult.SQLExecutionResult)
+	if err != nil {
+		return "", fmt.Errorf("encode result of submission %d: %w", sub.ID, err)
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,44 @@
+package insights
+
+import (
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+)
+
+// GroupQuestionStatistics is the statistics of a question among the users of a group.
+type GroupQuestionStatistics struct {
+	Question       *ent.Question
+	AttemptedUsers int
+	SolvedUsers    int
+	// SolveRate is SolvedUsers divided by the number of the users in the group.
+	SolveRate float64
+	// MedianAttempts is the median number of the submissions until the first
+	// success among the users who solved it, or nil if nobody solved it.
+	MedianAttempts *float64
+	// MedianSecondsToFirstSolve is the median seconds from the first submission
+	// to the first success among the users who solved it, or nil if nobody solved it.
+	MedianSecondsToFirstSolve *float64
+}
+
+// InactiveStudent is a user without any recent activity.
+type InactiveStudent struct {
+	User *ent.User
+	// LastActiveAt is the time of the last submission or event, or nil if the user has never been active.
+	LastActiveAt *time.Time
+}
+
+// ActivityDay is the activity of the users of a group in a day.
+type ActivityDay struct {
+	// Date is the start of the day.
+	Date time.Time
+	// ActiveUsers is the number of the users with any submission or event in the day.
+	ActiveUsers       int
+	Submissions       int
+	SolvedSubmissions int
+	Events            int
+	Points            int
+}
+
+// Analytics is the namespace of the analytics queries in GraphQL.
+type Analytics struct{}
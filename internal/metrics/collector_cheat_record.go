@@ -23,6 +23,13 @@ var dbplayResolvedCheatRecordsTotalDesc = prometheus.NewDesc(
 	nil,
 )
 
+var dbplayCheatRecordsByKindStateTotalDesc = prometheus.NewDesc(
+	"dbplay_cheat_records_by_kind_state_total",
+	"Total number of cheat records by kind and state",
+	[]string{"kind", "state"},
+	nil,
+)
+
 type CheatRecordCollector struct {
 	entClient *ent.Client
 }
@@ -34,6 +41,7 @@ func NewCheatRecordCollector(entClient *ent.Client) *CheatRecordCollector {
 func (c *CheatRecordCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- dbplayCheatRecordsTotalDesc
 	ch <- dbplayResolvedCheatRecordsTotalDesc
+	ch <- dbplayCheatRecordsByKindStateTotalDesc
 }
 
 func (c *CheatRecordCollector) Collect(ch chan<- prometheus.Metric) {
@@ -63,6 +71,28 @@ func (c *CheatRecordCollector) Collect(ch chan<- prometheus.Metric) {
 	}
 	ch <- prometheus.MustNewConstMetric(dbplayResolvedCheatRecordsTotalDesc, prometheus.GaugeValue, float64(resolvedTotal))
 
+	var results []struct {
+		Kind  string `json:"kind,omitempty"`
+		State string `json:"state,omitempty"`
+		Count int    `json:"count,omitempty"`
+	}
+
+	err = c.entClient.CheatRecord.
+		Query().
+		GroupBy(cheatrecord.FieldKind, cheatrecord.FieldState).
+		Aggregate(ent.Count()).
+		Scan(ctx, &results)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to collect cheat records by kind and state")
+		span.RecordError(err)
+
+		ch <- prometheus.NewInvalidMetric(dbplayCheatRecordsByKindStateTotalDesc, err)
+		return
+	}
+	for _, result := range results {
+		ch <- prometheus.MustNewConstMetric(dbplayCheatRecordsByKindStateTotalDesc, prometheus.GaugeValue, float64(result.Count), result.Kind, result.State)
+	}
+
 	span.SetStatus(otelcodes.Ok, "Cheat records collected successfully")
 }
 
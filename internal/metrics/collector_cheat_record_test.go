@@ -2,10 +2,12 @@ package metrics
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/internal/testhelper"
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
@@ -59,10 +61,11 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	// Create 3 resolved cheat records
+	// Create 3 dismissed cheat records
 	for range 3 {
 		_, err := client.CheatRecord.Create().
 			SetReason("Suspicious activity detected").
+			SetState(cheatrecord.StateDismissed).
 			SetResolvedReason("False positive").
 			SetResolvedAt(time.Now()).
 			SetUser(user).
@@ -70,6 +73,17 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// Create 2 pending plagiarism cheat records
+	for range 2 {
+		_, err := client.CheatRecord.Create().
+			SetReason("Suspected plagiarism").
+			SetKind(cheatrecord.KindPlagiarism).
+			SetState(cheatrecord.StatePending).
+			SetUser(user).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
 	// Create collector
 	collector := NewCheatRecordCollector(client)
 
@@ -79,10 +93,10 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 
 	metrics, err := registry.Gather()
 	require.NoError(t, err)
-	require.Len(t, metrics, 2, "should have 2 metrics")
+	require.Len(t, metrics, 3, "should have 3 metrics")
 
 	// Find the metrics by name
-	var totalMetric, resolvedMetric *dto.MetricFamily
+	var totalMetric, resolvedMetric, byKindStateMetric *dto.MetricFamily
 	for _, m := range metrics {
 		if m.Name != nil {
 			switch *m.Name {
@@ -90,6 +104,8 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 				totalMetric = m
 			case "dbplay_resolved_cheat_records_total":
 				resolvedMetric = m
+			case "dbplay_cheat_records_by_kind_state_total":
+				byKindStateMetric = m
 			}
 		}
 	}
@@ -99,7 +115,7 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 	assert.Equal(t, "dbplay_cheat_records_total", *totalMetric.Name)
 	assert.Equal(t, "Total number of cheat records", *totalMetric.Help)
 	require.Len(t, totalMetric.Metric, 1)
-	assert.Equal(t, 8.0, *totalMetric.Metric[0].Gauge.Value, "total cheat records count should be 8")
+	assert.Equal(t, 10.0, *totalMetric.Metric[0].Gauge.Value, "total cheat records count should be 10")
 
 	// Verify resolved cheat records metric
 	require.NotNil(t, resolvedMetric, "dbplay_resolved_cheat_records_total metric should exist")
@@ -107,6 +123,22 @@ func TestCheatRecordCollector_Collect(t *testing.T) {
 	assert.Equal(t, "Total number of resolved cheat records", *resolvedMetric.Help)
 	require.Len(t, resolvedMetric.Metric, 1)
 	assert.Equal(t, 3.0, *resolvedMetric.Metric[0].Gauge.Value, "resolved cheat records count should be 3")
+
+	// Verify cheat records by kind and state metric
+	require.NotNil(t, byKindStateMetric, "dbplay_cheat_records_by_kind_state_total metric should exist")
+	counts := make(map[string]float64)
+	for _, m := range byKindStateMetric.Metric {
+		labels := make(map[string]string)
+		for _, l := range m.Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+		counts[labels["kind"]+"/"+labels["state"]] = m.Gauge.GetValue()
+	}
+	assert.Equal(t, map[string]float64{
+		"manual/open":        5,
+		"manual/dismissed":   3,
+		"plagiarism/pending": 2,
+	}, counts)
 }
 
 func TestCheatRecordCollector_Collect_EmptyDatabase(t *testing.T) {
@@ -170,7 +202,7 @@ func TestCheatRecordCollector_Collect_OnlyUnresolved(t *testing.T) {
 
 	metrics, err := registry.Gather()
 	require.NoError(t, err)
-	require.Len(t, metrics, 2)
+	require.Len(t, metrics, 3)
 
 	// Find the metrics by name
 	var totalMetric, resolvedMetric *dto.MetricFamily
@@ -198,7 +230,7 @@ func TestCheatRecordCollector_Describe(t *testing.T) {
 	collector := NewCheatRecordCollector(client)
 
 	// Create a channel to receive descriptions
-	ch := make(chan *prometheus.Desc, 2)
+	ch := make(chan *prometheus.Desc, 3)
 	collector.Describe(ch)
 	close(ch)
 
@@ -208,7 +240,7 @@ func TestCheatRecordCollector_Describe(t *testing.T) {
 		descs = append(descs, desc)
 	}
 
-	require.Len(t, descs, 2, "should describe 2 metrics")
+	require.Len(t, descs, 3, "should describe 3 metrics")
 
 	// Check that the descriptions contain the metric names
 	descStrings := make([]string, len(descs))
@@ -216,8 +248,10 @@ func TestCheatRecordCollector_Describe(t *testing.T) {
 		descStrings[i] = desc.String()
 	}
 
-	assert.Contains(t, descStrings[0]+descStrings[1], "dbplay_cheat_records_total")
-	assert.Contains(t, descStrings[0]+descStrings[1], "dbplay_resolved_cheat_records_total")
+	joined := strings.Join(descStrings, "")
+	assert.Contains(t, joined, "dbplay_cheat_records_total")
+	assert.Contains(t, joined, "dbplay_resolved_cheat_records_total")
+	assert.Contains(t, joined, "dbplay_cheat_records_by_kind_state_total")
 }
 
 func TestNewCheatRecordCollector(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/eventdailycount"
 	"github.com/prometheus/client_golang/prometheus"
 	otelcodes "go.opentelemetry.io/otel/codes"
 )
@@ -53,10 +54,37 @@ func (c *EventCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	span.SetStatus(otelcodes.Ok, "Events collected successfully")
+	// The archived events are kept as the daily counts.
+	var archivedResults []struct {
+		Type string `json:"type,omitempty"`
+		Sum  int    `json:"sum,omitempty"`
+	}
+
+	err = c.entClient.EventDailyCount.
+		Query().
+		GroupBy(eventdailycount.FieldType).
+		Aggregate(ent.Sum(eventdailycount.FieldCount)).
+		Scan(ctx, &archivedResults)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to collect archived events")
+		span.RecordError(err)
 
+		ch <- prometheus.NewInvalidMetric(dbplayEventsTotalDesc, err)
+		return
+	}
+
+	counts := make(map[string]int, len(results))
 	for _, result := range results {
-		ch <- prometheus.MustNewConstMetric(dbplayEventsTotalDesc, prometheus.GaugeValue, float64(result.Count), result.Type)
+		counts[result.Type] += result.Count
+	}
+	for _, result := range archivedResults {
+		counts[result.Type] += result.Sum
+	}
+
+	span.SetStatus(otelcodes.Ok, "Events collected successfully")
+
+	for eventType, count := range counts {
+		ch <- prometheus.MustNewConstMetric(dbplayEventsTotalDesc, prometheus.GaugeValue, float64(count), eventType)
 	}
 }
 
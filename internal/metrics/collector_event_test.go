@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/internal/testhelper"
@@ -142,3 +143,47 @@ func TestEventCollector_Describe(t *testing.T) {
 	// Check that the description contains the metric name
 	assert.Contains(t, desc.String(), "dbplay_events_total")
 }
+
+func TestEventCollector_Collect_ArchivedEvents(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	user := setupEventTestData(t, client)
+	ctx := context.Background()
+
+	_, err := client.Event.Create().
+		SetType("login").
+		SetUser(user).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// The daily counts of the archived events.
+	_, err = client.EventDailyCount.Create().
+		SetUserID(user.ID).
+		SetType("login").
+		SetDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+		SetCount(4).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.EventDailyCount.Create().
+		SetUserID(user.ID).
+		SetType("submit_answer").
+		SetDate(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)).
+		SetCount(2).
+		Save(ctx)
+	require.NoError(t, err)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewEventCollector(client))
+
+	metrics, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+
+	metricMap := make(map[string]float64)
+	for _, m := range metrics[0].Metric {
+		metricMap[m.Label[0].GetValue()] = m.Gauge.GetValue()
+	}
+
+	assert.Equal(t, 5.0, metricMap["login"], "live and archived login events should be summed")
+	assert.Equal(t, 2.0, metricMap["submit_answer"], "archived submit_answer events should be counted")
+}
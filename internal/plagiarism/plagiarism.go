@@ -0,0 +1,375 @@
+// Package plagiarism detects the students copying the solutions from each other.
+package plagiarism
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/question"
+	"github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/internal/sqlnorm"
+	"github.com/database-playground/backend-v2/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.plagiarism")
+
+// SuspectedTotal tracks the pending cheat records created by the detector.
+var SuspectedTotal = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "dbplay_plagiarism_suspected_total",
+		Help: "Total number of pending cheat records created by the plagiarism detector",
+	},
+)
+
+const (
+	// DefaultWindow is the default window of the submissions considered submitted closely.
+	DefaultWindow = 10 * time.Minute
+	// DefaultLookback is the default period of the recent submissions to check.
+	DefaultLookback = 24 * time.Hour
+	// DefaultMinTokens is the default minimum number of the tokens of a solution to check.
+	DefaultMinTokens = 12
+	// DefaultMaxSharedUsers is the default maximum number of the users in a group
+	// sharing a solution to check.
+	DefaultMaxSharedUsers = 5
+)
+
+// Detector compares the successful submissions of the users in the same group
+// to the same question, and creates a pending cheat record for each user of a
+// suspicious pair, with the evidence for a teacher to confirm or dismiss.
+//
+// Two submissions are a suspicious pair if they have the same normalized SQL
+// (see sqlnorm.Normalize) and they are submitted within the window. The trivial
+// solutions (shorter than the minimum tokens) and the common ones (shared by
+// more than the maximum users) are never suspicious. A solution that nobody
+// else in the group has submitted is recorded as a rare one in the evidence,
+// but it is not suspicious by itself, since two students can arrive at the
+// same canonical answer independently.
+type Detector struct {
+	client *ent.Client
+
+	window         time.Duration
+	lookback       time.Duration
+	minTokens      int
+	maxSharedUsers int
+}
+
+// Option configures a Detector.
+type Option func(*Detector)
+
+// WithWindow sets the window of the submissions considered submitted closely.
+func WithWindow(window time.Duration) Option {
+	return func(d *Detector) {
+		d.window = window
+	}
+}
+
+// WithLookback sets the period of the recent submissions to check. The
+// submissions in this period are compared with each other and with the ones
+// submitted within the window before it.
+func WithLookback(lookback time.Duration) Option {
+	return func(d *Detector) {
+		d.lookback = lookback
+	}
+}
+
+// WithMinTokens sets the minimum number of the tokens of a solution to check.
+func WithMinTokens(minTokens int) Option {
+	return func(d *Detector) {
+		d.minTokens = minTokens
+	}
+}
+
+// WithMaxSharedUsers sets the maximum number of the users in a group sharing
+// a solution to check.
+func WithMaxSharedUsers(maxSharedUsers int) Option {
+	return func(d *Detector) {
+		d.maxSharedUsers = maxSharedUsers
+	}
+}
+
+// NewDetector creates a new plagiarism detector.
+func NewDetector(client *ent.Client, opts ...Option) *Detector {
+	d := &Detector{
+		client:         client,
+		window:         DefaultWindow,
+		lookback:       DefaultLookback,
+		minTokens:      DefaultMinTokens,
+		maxSharedUsers: DefaultMaxSharedUsers,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// solution is a successful submission with its normalized SQL.
+type solution struct {
+	submission *ent.Submission
+	userID     int
+	groupID    int
+	hash       string
+}
+
+// bucketKey identifies the users in a group submitting the same solution to a question.
+type bucketKey struct {
+	questionID int
+	groupID    int
+	hash       string
+}
+
+// Detect checks the successful submissions since now minus the lookback, and
+// returns the number of the created cheat records. The suspicious pairs that
+// have been recorded are skipped, so it is safe to run it again.
+//
+// Only the submissions since now minus the lookback and the window are loaded,
+// so the cost of a run does not grow with the history of the questions.
+func (d *Detector) Detect(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "Detect",
+		trace.WithAttributes(
+			attribute.String("plagiarism.window", d.window.String()),
+			attribute.String("plagiarism.lookback", d.lookback.String()),
+		))
+	defer span.End()
+
+	since := now.Add(-d.lookback)
+
+	span.AddEvent("questions.querying")
+	questionIDs, err := d.client.Submission.Query().
+		Where(
+			submission.StatusEQ(submission.StatusSuccess),
+			submission.SubmittedAtGTE(since),
+		).
+		QueryQuestion().
+		IDs(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query recent questions")
+		span.RecordError(err)
+		return 0, fmt.Errorf("query recent questions: %w", err)
+	}
+	span.SetAttributes(attribute.Int("plagiarism.questions", len(questionIDs)))
+	if len(questionIDs) == 0 {
+		span.SetStatus(otelcodes.Ok, "No recent submissions")
+		return 0, nil
+	}
+
+	// The submissions within the window before the lookback can still be
+	// submitted closely with the recent ones.
+	span.AddEvent("submissions.querying")
+	submissions, err := d.client.Submission.Query().
+		Where(
+			submission.StatusEQ(submission.StatusSuccess),
+			submission.HasQuestionWith(question.IDIn(questionIDs...)),
+			submission.SubmittedAtGTE(since.Add(-d.window)),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.WithGroup()
+		}).
+		WithQuestion().
+		Order(ent.Asc(submission.FieldSubmittedAt), ent.Asc(submission.FieldID)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query successful submissions")
+		span.RecordError(err)
+		return 0, fmt.Errorf("query successful submissions: %w", err)
+	}
+
+	span.AddEvent("submissions.bucketing")
+	buckets := make(map[bucketKey][]solution)
+	for _, s := range submissions {
+		u, q := s.Edges.User, s.Edges.Question
+		if u == nil || q == nil || u.Edges.Group == nil {
+			continue
+		}
+		if countTokens(s.SubmittedCode) < d.minTokens {
+			continue
+		}
+
+		sol := solution{submission: s, userID: u.ID, groupID: u.Edges.Group.ID, hash: sqlnorm.Hash(s.SubmittedCode)}
+		key := bucketKey{questionID: q.ID, groupID: sol.groupID, hash: sol.hash}
+		buckets[key] = append(buckets[key], sol)
+	}
+
+	suspected := make(map[string]plagiarismEvidence)
+	for key, solutions := range buckets {
+		for _, p := range d.suspiciousPairs(solutions) {
+			for _, evidence := range p.evidences(key) {
+				// the two users may share more than one solution to the question, record one of them
+				if _, ok := suspected[detectionKey(evidence)]; !ok {
+					suspected[detectionKey(evidence)] = evidence
+				}
+			}
+		}
+	}
+	span.SetAttributes(attribute.Int("plagiarism.suspected", len(suspected)))
+	if len(suspected) == 0 {
+		span.SetStatus(otelcodes.Ok, "No suspicious submissions")
+		return 0, nil
+	}
+
+	span.AddEvent("records.deduplicating")
+	recorded, err := d.client.CheatRecord.Query().
+		Where(cheatrecord.DetectionKeyIn(slices.Collect(maps.Keys(suspected))...)).
+		Select(cheatrecord.FieldDetectionKey).
+		Strings(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query recorded incidents")
+		span.RecordError(err)
+		return 0, fmt.Errorf("query recorded incidents: %w", err)
+	}
+	for _, key := range recorded {
+		delete(suspected, key)
+	}
+	if len(suspected) == 0 {
+		span.SetStatus(otelcodes.Ok, "All suspicious submissions have been recorded")
+		return 0, nil
+	}
+
+	creates := make([]*ent.CheatRecordCreate, 0, len(suspected))
+	for key, evidence := range suspected {
+		creates = append(creates, d.client.CheatRecord.Create().
+			SetUserID(evidence.userID).
+			SetKind(cheatrecord.KindPlagiarism).
+			SetReason(fmt.Sprintf("Suspected plagiarism: submitted the same solution to question %d as user %d.", evidence.QuestionID, evidence.MatchedUserID)).
+			SetCheatedAt(evidence.submittedAt).
+			SetState(cheatrecord.StatePending).
+			SetEvidence(&models.CheatEvidence{
+				SubmissionIDs: []int{evidence.SubmissionID, evidence.MatchedSubmissionID},
+				Plagiarism:    &evidence.PlagiarismEvidence,
+			}).
+			SetDetectionKey(key))
+	}
+
+	span.AddEvent("records.creating")
+	if err := d.client.CheatRecord.CreateBulk(creates...).Exec(ctx); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to create cheat records")
+		span.RecordError(err)
+		return 0, fmt.Errorf("create cheat records: %w", err)
+	}
+
+	SuspectedTotal.Add(float64(len(creates)))
+	span.SetAttributes(attribute.Int("plagiarism.created", len(creates)))
+	span.SetStatus(otelcodes.Ok, "Plagiarism detected successfully")
+	return len(creates), nil
+}
+
+// pair is a suspicious pair of the solutions of two users.
+type pair struct {
+	a, b        solution
+	sharedUsers int
+	closely     bool
+	rare        bool
+}
+
+// suspiciousPairs returns the suspicious pairs among the solutions with the
+// same normalized SQL, ordered by the time. For each two users, the pair with
+// the closest submissions is chosen.
+func (d *Detector) suspiciousPairs(solutions []solution) []pair {
+	users := make(map[int][]solution)
+	var userIDs []int
+	for _, s := range solutions {
+		if _, ok := users[s.userID]; !ok {
+			userIDs = append(userIDs, s.userID)
+		}
+		users[s.userID] = append(users[s.userID], s)
+	}
+
+	sharedUsers := len(userIDs)
+	if sharedUsers < 2 || sharedUsers > d.maxSharedUsers {
+		return nil
+	}
+
+	var pairs []pair
+	for i, userA := range userIDs {
+		for _, userB := range userIDs[i+1:] {
+			p := pair{sharedUsers: sharedUsers, rare: sharedUsers == 2}
+			for _, a := range users[userA] {
+				for _, b := range users[userB] {
+					if p.a.submission == nil || interval(a, b) < interval(p.a, p.b) {
+						p.a, p.b = a, b
+					}
+				}
+			}
+			p.closely = interval(p.a, p.b) <= d.window
+
+			if p.closely {
+				pairs = append(pairs, p)
+			}
+		}
+	}
+
+	return pairs
+}
+
+// plagiarismEvidence is the evidence of a user in a suspicious pair.
+type plagiarismEvidence struct {
+	models.PlagiarismEvidence
+
+	userID      int
+	submittedAt time.Time
+}
+
+// evidences returns the evidences of both users in the pair.
+func (p pair) evidences(key bucketKey) []plagiarismEvidence {
+	of := func(self, other solution) plagiarismEvidence {
+		return plagiarismEvidence{
+			PlagiarismEvidence: models.PlagiarismEvidence{
+				QuestionID:          key.questionID,
+				SubmissionID:        self.submission.ID,
+				MatchedUserID:       other.userID,
+				MatchedSubmissionID: other.submission.ID,
+				NormalizedHash:      key.hash,
+				IntervalSeconds:     int(interval(self, other).Seconds()),
+				SharedUsers:         p.sharedUsers,
+				IdenticalCode:       self.submission.SubmittedCode == other.submission.SubmittedCode,
+				SubmittedClosely:    p.closely,
+				RareSolution:        p.rare,
+			},
+			userID:      self.userID,
+			submittedAt: self.submission.SubmittedAt,
+		}
+	}
+
+	// the earlier submission first
+	a, b := p.a, p.b
+	if cmp.Or(a.submission.SubmittedAt.Compare(b.submission.SubmittedAt), cmp.Compare(a.submission.ID, b.submission.ID)) > 0 {
+		a, b = b, a
+	}
+	return []plagiarismEvidence{of(a, b), of(b, a)}
+}
+
+// detectionKey identifies the suspected plagiarism of a user from another user on a question.
+func detectionKey(e plagiarismEvidence) string {
+	return fmt.Sprintf("plagiarism:%d:%d:%d", e.QuestionID, e.userID, e.MatchedUserID)
+}
+
+func interval(a, b solution) time.Duration {
+	d := a.submission.SubmittedAt.Sub(b.submission.SubmittedAt)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// countTokens returns the number of the tokens in the SQL, excluding the comments.
+func countTokens(sql string) int {
+	count := 0
+	for _, t := range sqlnorm.Tokenize(sql) {
+		if t.Kind != sqlnorm.TokenComment {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,244 @@
+package plagiarism_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	entQuestion "github.com/database-playground/backend-v2/ent/question"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/internal/plagiarism"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	solutionA = "SELECT u.name, COUNT(o.id) FROM users u JOIN orders o ON o.user_id = u.id GROUP BY u.name"
+	solutionB = "SELECT name FROM users WHERE id IN (SELECT user_id FROM orders WHERE total > 100)"
+	solutionC = "SELECT o.id, o.total FROM orders o WHERE o.total > (SELECT AVG(total) FROM orders)"
+	trivial   = "SELECT * FROM users"
+)
+
+type fixture struct {
+	client   *ent.Client
+	users    []*ent.User
+	outsider *ent.User
+	question *ent.Question
+}
+
+// setupFixture creates 6 users in a group, another user in another group, and a question.
+func setupFixture(t *testing.T) fixture {
+	t.Helper()
+	ctx := context.Background()
+	client := testhelper.NewEntSqliteClient(t)
+
+	g, err := client.Group.Create().SetName("Class A").Save(ctx)
+	require.NoError(t, err)
+	other, err := client.Group.Create().SetName("Class B").Save(ctx)
+	require.NoError(t, err)
+
+	users := make([]*ent.User, 6)
+	for i := range users {
+		users[i], err = client.User.Create().
+			SetName("User " + strconv.Itoa(i+1)).
+			SetEmail("user" + strconv.Itoa(i+1) + "@example.com").
+			SetGroup(g).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	outsider, err := client.User.Create().
+		SetName("Outsider").
+		SetEmail("outsider@example.com").
+		SetGroup(other).
+		Save(ctx)
+	require.NoError(t, err)
+
+	database, err := client.Database.Create().
+		SetSlug("test_db").
+		SetSchema(`{"tables": []}`).
+		SetRelationFigure("test_figure").
+		Save(ctx)
+	require.NoError(t, err)
+
+	question, err := client.Question.Create().
+		SetCategory("test").
+		SetTitle("Question").
+		SetDescription("Test question").
+		SetReferenceAnswer("SELECT 1").
+		SetDifficulty(entQuestion.DifficultyEasy).
+		SetDatabase(database).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return fixture{client: client, users: users, outsider: outsider, question: question}
+}
+
+func (f fixture) submit(t *testing.T, u *ent.User, code string, status entSubmission.Status, at time.Time) *ent.Submission {
+	t.Helper()
+
+	s, err := f.client.Submission.Create().
+		SetUser(u).
+		SetQuestion(f.question).
+		SetSubmittedCode(code).
+		SetStatus(status).
+		SetSubmittedAt(at).
+		Save(context.Background())
+	require.NoError(t, err)
+	return s
+}
+
+func TestDetect(t *testing.T) {
+	f := setupFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	// User 1 and User 2 submitted the same solution (differing only in the
+	// formatting and aliases) two minutes apart, and nobody else did.
+	s1 := f.submit(t, f.users[0], solutionA, entSubmission.StatusSuccess, now.Add(-3*time.Hour))
+	s2 := f.submit(t, f.users[1], "select x.name, count(y.id)\nfrom users x join orders y on y.user_id = x.id\ngroup by x.name;", entSubmission.StatusSuccess, now.Add(-3*time.Hour+2*time.Minute))
+
+	// User 5 and User 6 submitted the same rare solution an hour apart, which
+	// is not suspicious by itself.
+	f.submit(t, f.users[4], solutionC, entSubmission.StatusSuccess, now.Add(-3*time.Hour))
+	f.submit(t, f.users[5], solutionC, entSubmission.StatusSuccess, now.Add(-2*time.Hour))
+
+	// User 3, User 4 and User 5 submitted the same solution, and only User 3 and User 4
+	// submitted within the window.
+	s3 := f.submit(t, f.users[2], solutionB, entSubmission.StatusSuccess, now.Add(-time.Hour))
+	s4 := f.submit(t, f.users[3], solutionB, entSubmission.StatusSuccess, now.Add(-time.Hour+3*time.Minute))
+	f.submit(t, f.users[4], solutionB, entSubmission.StatusSuccess, now.Add(-10*time.Minute))
+
+	// The trivial solutions, the failed submissions and the users in the other groups are not compared.
+	f.submit(t, f.users[4], trivial, entSubmission.StatusSuccess, now.Add(-time.Minute))
+	f.submit(t, f.users[5], trivial, entSubmission.StatusSuccess, now.Add(-time.Minute))
+	f.submit(t, f.users[5], solutionA, entSubmission.StatusFailed, now.Add(-time.Minute))
+	f.submit(t, f.outsider, solutionA, entSubmission.StatusSuccess, now.Add(-3*time.Hour))
+
+	detector := plagiarism.NewDetector(f.client)
+	created, err := detector.Detect(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 4, created)
+
+	records, err := f.client.CheatRecord.Query().
+		WithUser().
+		Order(ent.Asc(cheatrecord.FieldCheatedAt)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	for _, r := range records {
+		assert.Equal(t, cheatrecord.KindPlagiarism, r.Kind)
+		assert.Equal(t, cheatrecord.StatePending, r.State)
+		assert.True(t, r.ResolvedAt.IsZero())
+		require.NotNil(t, r.Evidence)
+		require.NotNil(t, r.Evidence.Plagiarism)
+		assert.Equal(t, f.question.ID, r.Evidence.Plagiarism.QuestionID)
+	}
+
+	// User 1 and User 2: a rare solution, submitted closely.
+	e1 := records[0].Evidence.Plagiarism
+	assert.Equal(t, f.users[0].ID, records[0].Edges.User.ID)
+	assert.Equal(t, s1.ID, e1.SubmissionID)
+	assert.Equal(t, f.users[1].ID, e1.MatchedUserID)
+	assert.Equal(t, s2.ID, e1.MatchedSubmissionID)
+	assert.Equal(t, []int{s1.ID, s2.ID}, records[0].Evidence.SubmissionIDs)
+	assert.Equal(t, 120, e1.IntervalSeconds)
+	assert.Equal(t, 2, e1.SharedUsers)
+	assert.True(t, e1.RareSolution)
+	assert.True(t, e1.SubmittedClosely)
+	assert.False(t, e1.IdenticalCode)
+
+	e2 := records[1].Evidence.Plagiarism
+	assert.Equal(t, f.users[1].ID, records[1].Edges.User.ID)
+	assert.Equal(t, f.users[0].ID, e2.MatchedUserID)
+	assert.Equal(t, e1.NormalizedHash, e2.NormalizedHash)
+
+	// User 3 and User 4: submitted closely, shared by 3 users.
+	e3 := records[2].Evidence.Plagiarism
+	assert.Equal(t, f.users[2].ID, records[2].Edges.User.ID)
+	assert.Equal(t, s3.ID, e3.SubmissionID)
+	assert.Equal(t, s4.ID, e3.MatchedSubmissionID)
+	assert.Equal(t, 180, e3.IntervalSeconds)
+	assert.Equal(t, 3, e3.SharedUsers)
+	assert.True(t, e3.SubmittedClosely)
+	assert.False(t, e3.RareSolution)
+	assert.True(t, e3.IdenticalCode)
+	assert.Equal(t, f.users[3].ID, records[3].Edges.User.ID)
+
+	// The recorded pairs are not recorded again.
+	created, err = detector.Detect(ctx, now)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+}
+
+func TestDetect_Options(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// setup creates 3 users submitting the same solution a minute apart, and
+	// 2 users submitting the same rare solution a minute apart.
+	setup := func(t *testing.T) fixture {
+		f := setupFixture(t)
+		for i := range 3 {
+			f.submit(t, f.users[i], solutionB, entSubmission.StatusSuccess, now.Add(-time.Duration(i)*time.Minute))
+		}
+		f.submit(t, f.users[3], solutionA, entSubmission.StatusSuccess, now.Add(-30*time.Minute))
+		f.submit(t, f.users[4], solutionA, entSubmission.StatusSuccess, now.Add(-29*time.Minute))
+		return f
+	}
+
+	t.Run("default", func(t *testing.T) {
+		f := setup(t)
+		created, err := plagiarism.NewDetector(f.client).Detect(ctx, now)
+		require.NoError(t, err)
+		// 3 pairs of User 1, User 2 and User 3, and User 4 and User 5
+		assert.Equal(t, 8, created)
+	})
+
+	t.Run("common solutions are skipped", func(t *testing.T) {
+		f := setup(t)
+		created, err := plagiarism.NewDetector(f.client, plagiarism.WithMaxSharedUsers(2)).Detect(ctx, now)
+		require.NoError(t, err)
+		// User 4 and User 5
+		assert.Equal(t, 2, created)
+	})
+
+	t.Run("trivial solutions are skipped", func(t *testing.T) {
+		f := setup(t)
+		created, err := plagiarism.NewDetector(f.client, plagiarism.WithMinTokens(100)).Detect(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, created)
+	})
+
+	t.Run("the pairs are checked in the window", func(t *testing.T) {
+		f := setup(t)
+		created, err := plagiarism.NewDetector(f.client, plagiarism.WithWindow(90*time.Second)).Detect(ctx, now)
+		require.NoError(t, err)
+		// User 1 and User 2, User 2 and User 3, and User 4 and User 5
+		assert.Equal(t, 6, created)
+	})
+
+	t.Run("the submissions before the lookback are not compared", func(t *testing.T) {
+		f := setup(t)
+		// Otherwise the solution would be shared by 4 users.
+		f.submit(t, f.users[5], solutionB, entSubmission.StatusSuccess, now.Add(-48*time.Hour))
+
+		created, err := plagiarism.NewDetector(f.client, plagiarism.WithMaxSharedUsers(3)).Detect(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 8, created)
+	})
+
+	t.Run("the questions without recent submissions are skipped", func(t *testing.T) {
+		f := setup(t)
+		created, err := plagiarism.NewDetector(f.client, plagiarism.WithLookback(time.Hour)).Detect(ctx, now.Add(72*time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, created)
+	})
+}
@@ -0,0 +1,200 @@
+package proctoring
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HandlerName is the name of the Escalator registered to the events.EventService.
+const HandlerName = "proctoring"
+
+// EscalationsTotal tracks the cheat records created by the Escalator.
+var EscalationsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_proctoring_escalations_total",
+		Help: "Total number of cheat records escalated from the proctoring signals",
+	},
+	[]string{"signal"},
+)
+
+// maxEvidenceEvents is the maximum number of the events linked to an escalated cheat record.
+const maxEvidenceEvents = 100
+
+// Rule escalates the signals into a cheat record when the user reports
+// more than Threshold signals of the kind occurred in Window.
+type Rule struct {
+	Signal    events.ProctoringSignal
+	Threshold int
+	Window    time.Duration
+	// Kind is the kind of the escalated cheat record.
+	Kind cheatrecord.Kind
+}
+
+// Escalator is an events.EventHandler checking the "proctoring_signal"
+// events against the rules, and creates a pending cheat record for the
+// user breaking a rule. A rule escalates the signals of a user at most
+// once in its window, so the handler is idempotent.
+//
+// The rules are evaluated on the time the signals occurred on the client,
+// not the time they are stored, so a batch of signals reported late is
+// still counted in the window they occurred in.
+type Escalator struct {
+	client *ent.Client
+	rules  []Rule
+}
+
+// NewEscalator creates a new Escalator with the rules. The rules with a
+// non-positive threshold or window are ignored.
+func NewEscalator(client *ent.Client, rules []Rule) *Escalator {
+	e := &Escalator{client: client}
+	for _, rule := range rules {
+		if rule.Threshold > 0 && rule.Window > 0 {
+			e.rules = append(e.rules, rule)
+		}
+	}
+
+	return e
+}
+
+// HandleEvent handles the "proctoring_signal" events, and ignores the others.
+func (e *Escalator) HandleEvent(ctx context.Context, ev *ent.Event, payload events.Payload) error {
+	signal, ok := payload.(events.ProctoringSignalPayload)
+	if !ok {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "HandleEvent",
+		trace.WithAttributes(
+			attribute.Int("event.id", ev.ID),
+			attribute.Int("user.id", ev.UserID),
+			attribute.String("proctoring.signal", string(signal.Signal)),
+		))
+	defer span.End()
+
+	for _, rule := range e.rules {
+		if rule.Signal != signal.Signal {
+			continue
+		}
+
+		if err := e.check(ctx, ev, signal, rule); err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to check the rule")
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	span.SetStatus(otelcodes.Ok, "Signal handled")
+	return nil
+}
+
+// check escalates the signals in the window ending at the signal if the user breaks the rule.
+func (e *Escalator) check(ctx context.Context, ev *ent.Event, signal events.ProctoringSignalPayload, rule Rule) error {
+	occurredAt := signal.OccurredAt
+	since := occurredAt.Add(-rule.Window)
+	keyPrefix := fmt.Sprintf("proctoring:%s:%d:", rule.Signal, ev.UserID)
+
+	escalated, err := e.client.CheatRecord.Query().
+		Where(
+			cheatrecord.HasUserWith(user.ID(ev.UserID)),
+			cheatrecord.DetectionKeyHasPrefix(keyPrefix),
+			cheatrecord.CheatedAtGT(since),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query escalated records: %w", err)
+	}
+	if escalated {
+		return nil
+	}
+
+	// a signal is stored at most maxClockSkew before and maxSignalAge after
+	// it occurred, so the signals occurred in the window are stored in it
+	// extended by them.
+	recent, err := e.client.Event.Query().
+		Where(
+			event.UserID(ev.UserID),
+			event.Type(string(events.EventTypeProctoringSignal)),
+			event.TriggeredAtGT(since.Add(-maxClockSkew)),
+			event.TriggeredAtLTE(occurredAt.Add(maxSignalAge)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query recent signals: %w", err)
+	}
+
+	type occurrence struct {
+		eventID    int
+		occurredAt time.Time
+	}
+	var occurrences []occurrence
+	for _, r := range recent {
+		payload, err := events.DecodePayload(r)
+		if err != nil {
+			return fmt.Errorf("decode signal %d: %w", r.ID, err)
+		}
+		p, ok := payload.(events.ProctoringSignalPayload)
+		if !ok || p.Signal != rule.Signal || !p.OccurredAt.After(since) || p.OccurredAt.After(occurredAt) {
+			continue
+		}
+		occurrences = append(occurrences, occurrence{eventID: r.ID, occurredAt: p.OccurredAt})
+	}
+	if len(occurrences) <= rule.Threshold {
+		return nil
+	}
+
+	slices.SortFunc(occurrences, func(a, b occurrence) int {
+		return cmp.Or(a.occurredAt.Compare(b.occurredAt), cmp.Compare(a.eventID, b.eventID))
+	})
+	eventIDs := make([]int, len(occurrences))
+	for i, o := range occurrences {
+		eventIDs[i] = o.eventID
+	}
+
+	count := len(eventIDs)
+	if len(eventIDs) > maxEvidenceEvents {
+		eventIDs = eventIDs[len(eventIDs)-maxEvidenceEvents:]
+	}
+
+	// the records of a rule are keyed by the first signal in the window, so
+	// that the concurrent handlers of the signals in the same burst, which
+	// have all passed the check above, can not escalate it twice.
+	key := keyPrefix + strconv.Itoa(occurrences[0].eventID)
+	err = e.client.CheatRecord.Create().
+		SetUserID(ev.UserID).
+		SetKind(rule.Kind).
+		SetState(cheatrecord.StatePending).
+		SetReason(fmt.Sprintf("Reported %d %s signals in %s.", count, rule.Signal, rule.Window)).
+		SetCheatedAt(occurredAt).
+		SetEvidence(&models.CheatEvidence{EventIDs: eventIDs}).
+		SetDetectionKey(key).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fmt.Errorf("create cheat record: %w", err)
+	}
+
+	EscalationsTotal.WithLabelValues(string(rule.Signal)).Inc()
+	slog.Info("escalated proctoring signals", "user_id", ev.UserID, "signal", rule.Signal, "count", count)
+	return nil
+}
+
+var _ events.EventHandler = (*Escalator)(nil)
@@ -0,0 +1,184 @@
+// Package proctoring ingests the proctoring signals detected by the client,
+// and escalates the suspicious ones into cheat records.
+package proctoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.proctoring")
+
+// SignalsTotal tracks the proctoring signals reported by the clients.
+var SignalsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_proctoring_signals_total",
+		Help: "Total number of proctoring signals reported by the clients",
+	},
+	[]string{"signal"},
+)
+
+const (
+	// MaxBatchSize is the maximum number of the signals in a report.
+	MaxBatchSize = 50
+	// maxClockSkew is how far in the future the time of a signal can be.
+	maxClockSkew = time.Minute
+	// maxSignalAge is how far in the past the time of a signal can be, so
+	// the signals are escalated on time and can not be backdated.
+	maxSignalAge = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidSignal is returned when a reported signal is invalid.
+	ErrInvalidSignal = errors.New("invalid signal")
+	// ErrRateLimited is returned when the user reports too many signals.
+	//
+	// The error also wraps a *ratelimit.ExceededError with the time to wait.
+	ErrRateLimited = errors.New("too many signals")
+)
+
+// Reporter stores the proctoring signals reported by the users as the
+// "proctoring_signal" events. The Escalator handles the stored events.
+type Reporter struct {
+	eventService *events.EventService
+
+	limiter *ratelimit.Limiter
+	limit   ratelimit.Limit
+	now     func() time.Time
+}
+
+// ReporterOption configures a Reporter.
+type ReporterOption func(*Reporter)
+
+// WithRateLimit limits the signals a user can report with limit. Each
+// signal in a batch takes a token. A disabled limit is not checked.
+func WithRateLimit(limiter *ratelimit.Limiter, limit ratelimit.Limit) ReporterOption {
+	return func(r *Reporter) {
+		r.limiter = limiter
+		r.limit = limit
+	}
+}
+
+// WithReporterClock sets the function returning the current time.
+func WithReporterClock(now func() time.Time) ReporterOption {
+	return func(r *Reporter) {
+		r.now = now
+	}
+}
+
+// NewReporter creates a new proctoring signal reporter.
+func NewReporter(eventService *events.EventService, opts ...ReporterOption) *Reporter {
+	r := &Reporter{
+		eventService: eventService,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Report validates the signals of the user and stores them as events, and
+// returns the number of the stored signals. The whole batch is rejected with
+// ErrRateLimited if the user would exceed the rate limit.
+func (r *Reporter) Report(ctx context.Context, userID int, signals []events.ProctoringSignalPayload) (int, error) {
+	ctx, span := tracer.Start(ctx, "Report",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.Int("proctoring.signals", len(signals)),
+		))
+	defer span.End()
+
+	if len(signals) > MaxBatchSize {
+		span.SetStatus(otelcodes.Error, "Too many signals in a batch")
+		return 0, fmt.Errorf("%w: at most %d signals can be reported at once", ErrInvalidSignal, MaxBatchSize)
+	}
+
+	now := r.now()
+	for i, signal := range signals {
+		if err := validate(signal, now); err != nil {
+			span.SetStatus(otelcodes.Error, "Invalid signal")
+			return 0, fmt.Errorf("signal %d: %w", i, err)
+		}
+	}
+	if len(signals) == 0 {
+		span.SetStatus(otelcodes.Ok, "No signals")
+		return 0, nil
+	}
+
+	span.AddEvent("rate_limit.checking")
+	if err := r.checkRateLimit(ctx, userID, len(signals)); err != nil {
+		span.SetStatus(otelcodes.Error, "Rate limited")
+		return 0, err
+	}
+
+	span.AddEvent("events.triggering")
+	for _, signal := range signals {
+		r.eventService.TriggerEvent(ctx, events.Event{
+			Type:    events.EventTypeProctoringSignal,
+			Payload: signal,
+			UserID:  userID,
+		})
+		SignalsTotal.WithLabelValues(string(signal.Signal)).Inc()
+	}
+
+	span.SetStatus(otelcodes.Ok, "Signals reported successfully")
+	return len(signals), nil
+}
+
+// checkRateLimit takes a token for each of the n signals from the bucket of
+// the user, and returns ErrRateLimited if there are not enough. The signals
+// are allowed if the rate limit can not be checked, so a Redis outage does
+// not lose the signals.
+func (r *Reporter) checkRateLimit(ctx context.Context, userID int, n int) error {
+	if r.limiter == nil {
+		return nil
+	}
+
+	result, err := r.limiter.AllowN(ctx, "user:"+strconv.Itoa(userID), n,
+		ratelimit.Bucket{Key: "signals", Limit: r.limit},
+	)
+	if err != nil {
+		slog.Warn("failed to check the proctoring rate limit", "error", err, "user_id", userID)
+		return nil
+	}
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+
+	return nil
+}
+
+func validate(signal events.ProctoringSignalPayload, now time.Time) error {
+	if !signal.Signal.IsValid() {
+		return fmt.Errorf("%w: unknown signal %q", ErrInvalidSignal, signal.Signal)
+	}
+	if signal.OccurredAt.IsZero() || signal.OccurredAt.After(now.Add(maxClockSkew)) {
+		return fmt.Errorf("%w: the time of the signal is missing or in the future", ErrInvalidSignal)
+	}
+	if signal.OccurredAt.Before(now.Add(-maxSignalAge)) {
+		return fmt.Errorf("%w: the signal is older than %s", ErrInvalidSignal, maxSignalAge)
+	}
+	if signal.QuestionID != nil && *signal.QuestionID <= 0 {
+		return fmt.Errorf("%w: invalid question ID", ErrInvalidSignal)
+	}
+	if (signal.DurationMs != nil && *signal.DurationMs < 0) || (signal.Length != nil && *signal.Length < 0) {
+		return fmt.Errorf("%w: the duration and the length should not be negative", ErrInvalidSignal)
+	}
+
+	return nil
+}
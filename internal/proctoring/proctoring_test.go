@@ -0,0 +1,266 @@
+package proctoring_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/event"
+	"github.com/database-playground/backend-v2/ent/hook"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/proctoring"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setup(t *testing.T) (*ent.Client, *events.EventService, *ent.User) {
+	t.Helper()
+	ctx := context.Background()
+	client := testhelper.NewEntSqliteClient(t)
+
+	g, err := client.Group.Create().SetName("Class A").Save(ctx)
+	require.NoError(t, err)
+	u, err := client.User.Create().
+		SetName("User").
+		SetEmail("user@example.com").
+		SetGroup(g).
+		Save(ctx)
+	require.NoError(t, err)
+
+	eventService := events.NewEventService(client, nil)
+	eventService.RegisterHandler(proctoring.HandlerName, proctoring.NewEscalator(client, []proctoring.Rule{
+		{Signal: events.ProctoringSignalTabSwitch, Threshold: 5, Window: 30 * time.Minute, Kind: cheatrecord.KindTabSwitch},
+		{Signal: events.ProctoringSignalPaste, Threshold: 10, Window: 30 * time.Minute, Kind: cheatrecord.KindExamViolation},
+	}))
+
+	return client, eventService, u
+}
+
+func signals(signal events.ProctoringSignal, n int) []events.ProctoringSignalPayload {
+	return signalsAt(signal, n, time.Now())
+}
+
+func signalsAt(signal events.ProctoringSignal, n int, occurredAt time.Time) []events.ProctoringSignalPayload {
+	result := make([]events.ProctoringSignalPayload, n)
+	for i := range result {
+		result[i] = events.ProctoringSignalPayload{Signal: signal, OccurredAt: occurredAt}
+	}
+	return result
+}
+
+func TestReporter_Report(t *testing.T) {
+	client, eventService, u := setup(t)
+	ctx := context.Background()
+
+	redisClient := testhelper.NewRedisClient(t, testhelper.NewRedisContainer(t))
+	reporter := proctoring.NewReporter(eventService, proctoring.WithRateLimit(
+		ratelimit.NewLimiter(redisClient, "proctoring"),
+		ratelimit.Limit{Burst: 3, Interval: time.Hour},
+	))
+
+	questionID := 1
+	length := 42
+	reported, err := reporter.Report(ctx, u.ID, []events.ProctoringSignalPayload{
+		{Signal: events.ProctoringSignalPaste, OccurredAt: time.Now(), QuestionID: &questionID, Length: &length},
+		{Signal: events.ProctoringSignalFocusLoss, OccurredAt: time.Now()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, reported)
+
+	stored, err := client.Event.Query().
+		Where(event.Type(string(events.EventTypeProctoringSignal))).
+		Order(ent.Asc(event.FieldID)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	payload, err := events.DecodePayload(stored[0])
+	require.NoError(t, err)
+	assert.Equal(t, events.ProctoringSignalPaste, payload.(events.ProctoringSignalPayload).Signal)
+	assert.Equal(t, &length, payload.(events.ProctoringSignalPayload).Length)
+
+	// the whole batch is rejected if it exceeds the rate limit
+	_, err = reporter.Report(ctx, u.ID, signals(events.ProctoringSignalTabSwitch, 2))
+	require.ErrorIs(t, err, proctoring.ErrRateLimited)
+	var exceeded *ratelimit.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Positive(t, exceeded.RetryAfter)
+
+	count, err := client.Event.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// the rejected batch does not take the remaining token
+	reported, err = reporter.Report(ctx, u.ID, signals(events.ProctoringSignalTabSwitch, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, reported)
+}
+
+func TestReporter_Report_Invalid(t *testing.T) {
+	client, eventService, u := setup(t)
+	ctx := context.Background()
+	reporter := proctoring.NewReporter(eventService)
+
+	negative := -1
+	testCases := []struct {
+		name    string
+		signals []events.ProctoringSignalPayload
+	}{
+		{name: "unknown signal", signals: signals("copy", 1)},
+		{name: "missing time", signals: []events.ProctoringSignalPayload{{Signal: events.ProctoringSignalPaste}}},
+		{name: "future time", signals: signalsAt(events.ProctoringSignalPaste, 1, time.Now().Add(time.Hour))},
+		{name: "outdated time", signals: signalsAt(events.ProctoringSignalPaste, 1, time.Now().Add(-time.Hour))},
+		{name: "negative duration", signals: []events.ProctoringSignalPayload{{Signal: events.ProctoringSignalFocusLoss, OccurredAt: time.Now(), DurationMs: &negative}}},
+		{name: "too many signals", signals: signals(events.ProctoringSignalPaste, proctoring.MaxBatchSize+1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := reporter.Report(ctx, u.ID, tc.signals)
+			require.ErrorIs(t, err, proctoring.ErrInvalidSignal)
+		})
+	}
+
+	count, err := client.Event.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestEscalator(t *testing.T) {
+	client, eventService, u := setup(t)
+	ctx := context.Background()
+
+	reporter := proctoring.NewReporter(eventService)
+	dispatcher := events.NewDispatcher(eventService, events.WithWorkers(1))
+
+	// 5 tab switches and 10 pastes do not break the rules
+	_, err := reporter.Report(ctx, u.ID, append(signals(events.ProctoringSignalTabSwitch, 5), signals(events.ProctoringSignalPaste, 10)...))
+	require.NoError(t, err)
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	count, err := client.CheatRecord.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// the 6th tab switch does
+	_, err = reporter.Report(ctx, u.ID, signals(events.ProctoringSignalTabSwitch, 1))
+	require.NoError(t, err)
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	records, err := client.CheatRecord.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, cheatrecord.KindTabSwitch, records[0].Kind)
+	assert.Equal(t, cheatrecord.StatePending, records[0].State)
+	require.NotNil(t, records[0].Evidence)
+	assert.Len(t, records[0].Evidence.EventIDs, 6)
+
+	// the escalated record waits for a teacher to confirm it
+	counted, err := client.CheatRecord.Query().Where(cheating.Counted()).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, counted)
+
+	// the rule escalates the signals only once in the window
+	_, err = reporter.Report(ctx, u.ID, signals(events.ProctoringSignalTabSwitch, 3))
+	require.NoError(t, err)
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	count, err = client.CheatRecord.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestEscalator_OccurredAt(t *testing.T) {
+	client, eventService, u := setup(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	clock := now.Add(-35 * time.Minute)
+	reporter := proctoring.NewReporter(eventService, proctoring.WithReporterClock(func() time.Time { return clock }))
+	dispatcher := events.NewDispatcher(eventService, events.WithWorkers(1))
+
+	// the signals are stored together, but occurred 35 minutes apart
+	_, err := reporter.Report(ctx, u.ID, signalsAt(events.ProctoringSignalTabSwitch, 3, clock))
+	require.NoError(t, err)
+	clock = now
+	_, err = reporter.Report(ctx, u.ID, signalsAt(events.ProctoringSignalTabSwitch, 3, now))
+	require.NoError(t, err)
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	count, err := client.CheatRecord.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// 6 tab switches occurred in the window
+	occurredAt := now.Add(time.Second)
+	_, err = reporter.Report(ctx, u.ID, signalsAt(events.ProctoringSignalTabSwitch, 3, occurredAt))
+	require.NoError(t, err)
+	_, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+
+	record, err := client.CheatRecord.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.True(t, record.CheatedAt.Equal(occurredAt))
+	require.NotNil(t, record.Evidence)
+	assert.Len(t, record.Evidence.EventIDs, 6)
+}
+
+func TestEscalator_ConcurrentHandlers(t *testing.T) {
+	client, eventService, u := setup(t)
+	ctx := context.Background()
+
+	reporter := proctoring.NewReporter(eventService)
+	escalator := proctoring.NewEscalator(client, []proctoring.Rule{
+		{Signal: events.ProctoringSignalTabSwitch, Threshold: 2, Window: time.Minute, Kind: cheatrecord.KindTabSwitch},
+	})
+
+	// the last two signals are on either side of the start of a minute
+	boundary := time.Now().Truncate(time.Minute)
+	var batch []events.ProctoringSignalPayload
+	for _, at := range []time.Time{boundary.Add(-3 * time.Second), boundary.Add(-2 * time.Second), boundary.Add(-time.Second), boundary} {
+		batch = append(batch, signalsAt(events.ProctoringSignalTabSwitch, 1, at)...)
+	}
+	_, err := reporter.Report(ctx, u.ID, batch)
+	require.NoError(t, err)
+
+	stored, err := client.Event.Query().
+		Where(event.Type(string(events.EventTypeProctoringSignal))).
+		Order(event.ByID()).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, stored, 4)
+
+	handle := func(ev *ent.Event) error {
+		payload, err := events.DecodePayload(ev)
+		require.NoError(t, err)
+		return escalator.HandleEvent(ctx, ev, payload)
+	}
+
+	// the handler of the last signal passes the check at the same time, and
+	// creates its record right before the handler of the third one
+	raced := false
+	client.CheatRecord.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.CheatRecordFunc(func(ctx context.Context, m *ent.CheatRecordMutation) (ent.Value, error) {
+			if !raced {
+				raced = true
+				require.NoError(t, handle(stored[3]))
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	require.NoError(t, handle(stored[2]))
+
+	count, err := client.CheatRecord.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
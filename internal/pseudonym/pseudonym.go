@@ -0,0 +1,35 @@
+package pseudonym
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+var adjectives = []string{
+	"Agile", "Bold", "Brave", "Bright", "Calm", "Clever", "Cosmic", "Curious",
+	"Daring", "Eager", "Fancy", "Fearless", "Gentle", "Happy", "Humble", "Jolly",
+	"Keen", "Kind", "Lively", "Lucky", "Mellow", "Mighty", "Nimble", "Noble",
+	"Patient", "Plucky", "Quick", "Quiet", "Rapid", "Sharp", "Shiny", "Silent",
+	"Sleepy", "Smart", "Snappy", "Steady", "Sunny", "Swift", "Tidy", "Witty",
+}
+
+var animals = []string{
+	"Alpaca", "Badger", "Beaver", "Bison", "Chinchilla", "Cheetah", "Crane", "Dolphin",
+	"Eagle", "Falcon", "Ferret", "Fox", "Gecko", "Hedgehog", "Heron", "Koala",
+	"Lemur", "Leopard", "Lynx", "Magpie", "Marmot", "Narwhal", "Ocelot", "Otter",
+	"Owl", "Panda", "Pangolin", "Penguin", "Puffin", "Quokka", "Raccoon", "Salmon",
+	"Sparrow", "Squirrel", "Tapir", "Tiger", "Turtle", "Walrus", "Wombat", "Yak",
+}
+
+// Generate generates a random pseudonym, e.g. "Brave Otter 4821".
+//
+// It is random rather than derived from the user, so the pseudonym cannot be
+// linked back to the user. There are 16 million pseudonyms, which are not
+// guaranteed to be unique.
+func Generate() string {
+	return fmt.Sprintf("%s %s %04d",
+		adjectives[rand.IntN(len(adjectives))],
+		animals[rand.IntN(len(animals))],
+		rand.IntN(10000),
+	)
+}
@@ -0,0 +1,26 @@
+package pseudonym_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/database-playground/backend-v2/internal/pseudonym"
+)
+
+func TestGenerate(t *testing.T) {
+	format := regexp.MustCompile(`^[A-Z][a-z]+ [A-Z][a-z]+ [0-9]{4}$`)
+
+	seen := make(map[string]struct{}, 1000)
+	for range 1000 {
+		name := pseudonym.Generate()
+		if !format.MatchString(name) {
+			t.Fatalf("pseudonym is not in the format: %q", name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	// collisions are possible, but should be rare
+	if len(seen) < 990 {
+		t.Fatalf("too many collisions - %d pseudonyms are unique", len(seen))
+	}
+}
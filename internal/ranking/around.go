@@ -0,0 +1,134 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/models"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MaxNeighbours is the maximum number of the neighbours above and below in GetMyRanking.
+const MaxNeighbours = 50
+
+// GetMyRanking retrieves the rank of the user, with the neighbours above and below.
+//
+// The rank and score are nil if the user is not ranked, e.g. the user has no
+// points in the period, or is excluded from the ranking.
+func (s *Service) GetMyRanking(ctx context.Context, userID int, neighbours int, filter model.RankingFilter) (*model.MyRanking, error) {
+	ctx, span := tracer.Start(ctx, "GetMyRanking",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.Int("ranking.neighbours", neighbours),
+			attribute.String("ranking.by", string(filter.By)),
+			attribute.String("ranking.period", string(filter.Period)),
+			attribute.String("ranking.order", string(filter.Order)),
+		))
+	defer span.End()
+
+	if neighbours < 0 || neighbours > MaxNeighbours {
+		span.SetStatus(otelcodes.Error, "Invalid neighbours")
+		return nil, fmt.Errorf("%w: neighbours must be between 0 and %d", ErrInvalidFilter, MaxNeighbours)
+	}
+
+	timeRange, err := s.getTimeRange(time.Now(), filter)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	scope, err := s.getScope(filter)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var (
+		page   rankingPage
+		served bool
+	)
+	if s.leaderboard != nil && s.leaderboard.supports(filter) {
+		span.AddEvent("ranking.from_leaderboard")
+		page, err = s.leaderboard.getAround(ctx, filter, userID, neighbours)
+		if err != nil {
+			// The database is the source of truth, so the ranking is still available without Redis.
+			span.RecordError(err)
+			slog.Warn("failed to get the ranking from the leaderboard, falling back to the database", "error", err)
+		} else {
+			served = true
+		}
+	}
+	if !served {
+		span.AddEvent("ranking.from_database")
+		page, err = s.getAroundFromDatabase(ctx, filter, timeRange, scope, userID, neighbours)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to get user scores")
+			span.RecordError(err)
+			return nil, fmt.Errorf("failed to get user scores: %w", err)
+		}
+	}
+
+	edges, err := s.buildEdges(ctx, page.Scores)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to fetch users")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	result := &model.MyRanking{
+		Edges:      edges,
+		TotalCount: page.TotalCount,
+	}
+	for _, us := range page.Scores {
+		if us.UserID == userID {
+			result.Rank = &us.Rank
+			result.Score = &us.Score
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Bool("ranking.ranked", result.Rank != nil))
+	span.SetStatus(otelcodes.Ok, "My ranking retrieved successfully")
+	return result, nil
+}
+
+// getAroundFromDatabase aggregates the scores of every user from the database,
+// and returns the user with the neighbours above and below. The page is empty
+// if the user is not ranked.
+func (s *Service) getAroundFromDatabase(ctx context.Context, filter model.RankingFilter, timeRange timeRange, scope scope, userID int, neighbours int) (rankingPage, error) {
+	ctx, span := tracer.Start(ctx, "getAroundFromDatabase")
+	defer span.End()
+
+	userScores, err := s.getSortedScores(ctx, filter, timeRange, scope)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get user scores")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	page := rankingPage{TotalCount: len(userScores)}
+	for i, us := range userScores {
+		if us.UserID == userID {
+			page.Scores, page.StartIndex = around(userScores, i, neighbours)
+			break
+		}
+	}
+
+	span.SetStatus(otelcodes.Ok, "Ranking around the user retrieved successfully")
+	return page, nil
+}
+
+// around returns the scores from neighbours before index to neighbours after
+// index, and the index of the first one.
+func around(scores []models.UserScore, index int, neighbours int) ([]models.UserScore, int) {
+	start := max(index-neighbours, 0)
+	end := min(index+neighbours+1, len(scores))
+	return scores[start:end], start
+}
@@ -0,0 +1,83 @@
+package ranking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestService_GetMyRanking(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, _, _ := setupTestRankingData(t, entClient)
+
+	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// User 1 (50) > User 2 (40) > User 3 (30) > User 4 (20), User 5 has no points.
+	for i, u := range users[:4] {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((5 - i) * 10).
+			SetGrantedAt(today.Add(time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+	ids := func(result *model.MyRanking) []int {
+		var ids []int
+		for _, edge := range result.Edges {
+			ids = append(ids, edge.Node.UserID)
+		}
+		return ids
+	}
+
+	t.Run("in the middle", func(t *testing.T) {
+		result, err := service.GetMyRanking(ctx, users[2].ID, 1, filter)
+		require.NoError(t, err)
+		require.NotNil(t, result.Rank)
+		assert.Equal(t, 3, *result.Rank)
+		assert.Equal(t, 30, *result.Score)
+		assert.Equal(t, 4, result.TotalCount)
+		assert.Equal(t, []int{users[1].ID, users[2].ID, users[3].ID}, ids(result))
+	})
+
+	t.Run("at the top", func(t *testing.T) {
+		result, err := service.GetMyRanking(ctx, users[0].ID, 2, filter)
+		require.NoError(t, err)
+		require.NotNil(t, result.Rank)
+		assert.Equal(t, 1, *result.Rank)
+		assert.Equal(t, []int{users[0].ID, users[1].ID, users[2].ID}, ids(result))
+	})
+
+	t.Run("not ranked", func(t *testing.T) {
+		result, err := service.GetMyRanking(ctx, users[4].ID, 2, filter)
+		require.NoError(t, err)
+		assert.Nil(t, result.Rank)
+		assert.Nil(t, result.Score)
+		assert.Empty(t, result.Edges)
+		assert.Equal(t, 4, result.TotalCount)
+	})
+
+	t.Run("invalid neighbours", func(t *testing.T) {
+		_, err := service.GetMyRanking(ctx, users[0].ID, -1, filter)
+		require.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = service.GetMyRanking(ctx, users[0].ID, MaxNeighbours+1, filter)
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
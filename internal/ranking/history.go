@@ -0,0 +1,136 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+	"github.com/database-playground/backend-v2/graph/model"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MaxHistoryDays is the maximum number of the days in GetRankHistory.
+const MaxHistoryDays = 366
+
+// snapshotBatchSize is the number of the snapshots created in a statement.
+const snapshotBatchSize = 1000
+
+// Snapshot records the all-time rank and score of every ranked user of the
+// day containing now, in the location of the service. The snapshots of the day are
+// replaced if they have been recorded, so it is safe to run it again.
+//
+// The ranking is the default one: the administrators and the users with
+// cheat records counted as cheating are excluded, and the ties share the competition rank.
+func (s *Service) Snapshot(ctx context.Context, now time.Time) error {
+	date := periodStart(now.In(s.location), model.RankingPeriodDaily)
+
+	ctx, span := tracer.Start(ctx, "Snapshot",
+		trace.WithAttributes(
+			attribute.String("ranking.snapshot.date", date.Format(time.DateOnly)),
+		))
+	defer span.End()
+
+	for _, by := range leaderboardRankings {
+		filter := model.RankingFilter{
+			By:     by,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodAllTime,
+		}
+
+		sc, err := s.getScope(filter)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+			span.RecordError(err)
+			return err
+		}
+
+		scores, err := s.getSortedScores(ctx, filter, timeRange{}, sc)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to get user scores")
+			span.RecordError(err)
+			return fmt.Errorf("get user scores: %w", err)
+		}
+
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to start transaction")
+			span.RecordError(err)
+			return fmt.Errorf("start transaction: %w", err)
+		}
+
+		_, err = tx.RankSnapshot.Delete().
+			Where(ranksnapshot.ByEQ(ranksnapshot.By(by)), ranksnapshot.DateEQ(date)).
+			Exec(ctx)
+		for start := 0; err == nil && start < len(scores); start += snapshotBatchSize {
+			batch := scores[start:min(start+snapshotBatchSize, len(scores))]
+			err = tx.RankSnapshot.MapCreateBulk(batch, func(c *ent.RankSnapshotCreate, i int) {
+				c.SetUserID(batch[i].UserID).
+					SetBy(ranksnapshot.By(by)).
+					SetDate(date).
+					SetRank(batch[i].Rank).
+					SetScore(batch[i].Score)
+			}).Exec(ctx)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			span.SetStatus(otelcodes.Error, "Failed to write snapshots")
+			span.RecordError(err)
+			return fmt.Errorf("write %s snapshots: %w", by, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to commit transaction")
+			span.RecordError(err)
+			return fmt.Errorf("commit transaction: %w", err)
+		}
+
+		span.SetAttributes(attribute.Int("ranking.snapshot."+string(by)+".users_count", len(scores)))
+	}
+
+	span.SetStatus(otelcodes.Ok, "Ranking snapshots recorded successfully")
+	return nil
+}
+
+// GetRankHistory retrieves the daily snapshots of the user in the recent days,
+// oldest first.
+func (s *Service) GetRankHistory(ctx context.Context, userID int, by model.RankingBy, days int) ([]*ent.RankSnapshot, error) {
+	ctx, span := tracer.Start(ctx, "GetRankHistory",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.String("ranking.by", string(by)),
+			attribute.Int("ranking.history.days", days),
+		))
+	defer span.End()
+
+	if days <= 0 || days > MaxHistoryDays {
+		span.SetStatus(otelcodes.Error, "Invalid days")
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidFilter, MaxHistoryDays)
+	}
+	if !by.IsValid() {
+		span.SetStatus(otelcodes.Error, "Invalid ranking")
+		return nil, fmt.Errorf("%w: unsupported ranking type %s", ErrInvalidFilter, by)
+	}
+
+	since := periodStart(time.Now().In(s.location), model.RankingPeriodDaily).AddDate(0, 0, -(days - 1))
+	snapshots, err := s.client.RankSnapshot.Query().
+		Where(
+			ranksnapshot.UserID(userID),
+			ranksnapshot.ByEQ(ranksnapshot.By(by)),
+			ranksnapshot.DateGTE(since),
+		).
+		Order(ent.Asc(ranksnapshot.FieldDate)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query snapshots")
+		span.RecordError(err)
+		return nil, fmt.Errorf("query snapshots: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("ranking.history.snapshots_count", len(snapshots)))
+	span.SetStatus(otelcodes.Ok, "Rank history retrieved successfully")
+	return snapshots, nil
+}
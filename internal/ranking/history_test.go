@@ -0,0 +1,68 @@
+package ranking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent/ranksnapshot"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestService_Snapshot(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, _, questions := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	_, err := entClient.Point.Create().SetUser(users[0]).SetPoints(10).Save(ctx)
+	require.NoError(t, err)
+	_, err = entClient.Point.Create().SetUser(users[1]).SetPoints(20).Save(ctx)
+	require.NoError(t, err)
+	_, err = entClient.Submission.Create().
+		SetUser(users[0]).
+		SetQuestion(questions[0]).
+		SetSubmittedCode("SELECT 1").
+		SetStatus(entSubmission.StatusSuccess).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	require.NoError(t, service.Snapshot(ctx, yesterday))
+	require.NoError(t, service.Snapshot(ctx, now))
+
+	// The snapshots of today are replaced when it runs again.
+	_, err = entClient.Point.Create().SetUser(users[0]).SetPoints(20).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, service.Snapshot(ctx, now))
+
+	count, err := entClient.RankSnapshot.Query().Where(ranksnapshot.ByEQ(ranksnapshot.ByPOINTS)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	history, err := service.GetRankHistory(ctx, users[0].ID, model.RankingByPoints, 7)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.True(t, history[0].Date.Before(history[1].Date))
+	assert.Equal(t, 2, history[0].Rank)
+	assert.Equal(t, 10, history[0].Score)
+	assert.Equal(t, 1, history[1].Rank)
+	assert.Equal(t, 30, history[1].Score)
+
+	history, err = service.GetRankHistory(ctx, users[0].ID, model.RankingByCompletedQuestions, 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, 1, history[0].Rank)
+	assert.Equal(t, 1, history[0].Score)
+
+	_, err = service.GetRankHistory(ctx, users[0].ID, model.RankingByPoints, 0)
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
@@ -0,0 +1,1073 @@
+package ranking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	"github.com/database-playground/backend-v2/ent/group"
+	"github.com/database-playground/backend-v2/ent/hook"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"github.com/database-playground/backend-v2/ent/question"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/models"
+	"github.com/redis/rueidis"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+const redisLeaderboardPrefix = "ranking:"
+
+// leaderboardPeriods are the periods kept in the leaderboards.
+var leaderboardPeriods = []model.RankingPeriod{
+	model.RankingPeriodDaily,
+	model.RankingPeriodWeekly,
+	model.RankingPeriodMonthly,
+	model.RankingPeriodAllTime,
+}
+
+// leaderboardRankings are the rankings kept in the leaderboards.
+var leaderboardRankings = []model.RankingBy{
+	model.RankingByPoints,
+	model.RankingByCompletedQuestions,
+}
+
+// setScript replaces the score of a member only if the leaderboard has been
+// built, or removes the member if the score is empty.
+//
+// ARGV is the encoded score and the member.
+var setScript = rueidis.NewLuaScript(`
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	return false
+end
+if ARGV[1] == "" then
+	return redis.call("ZREM", KEYS[1], ARGV[2])
+end
+return redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+`)
+
+// incrementScript increments the score of a member only if the leaderboard
+// has been built, since a partial leaderboard would be served as complete.
+//
+// ARGV is the delta, the encoded achievement time and the member. See
+// encodeScore for the encoding of the sorted set scores.
+var incrementScript = rueidis.NewLuaScript(`
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	return false
+end
+local base = 4294967296
+local score, achieved = 0, tonumber(ARGV[2])
+local current = redis.call("ZSCORE", KEYS[1], ARGV[3])
+if current then
+	current = tonumber(current)
+	score = math.floor(current / base)
+	achieved = math.min(current - score * base, achieved)
+end
+score = score + tonumber(ARGV[1])
+return redis.call("ZADD", KEYS[1], string.format("%.0f", score * base + achieved), ARGV[3])
+`)
+
+const (
+	// scoreBase is the multiplier of the score in the sorted set scores.
+	scoreBase = 1 << 32
+	// achievedEpoch is the earliest achievement time kept in the sorted set scores.
+	achievedEpoch = 1577836800 // 2020-01-01T00:00:00Z
+)
+
+// encodeScore encodes the score and the achievement time into a sorted set score,
+// so that the higher scores, and then the earlier achievements, are ranked higher:
+//
+//	score * 2^32 + (2^32 - 1 - seconds since 2020-01-01)
+//
+// The scores are exact up to about ±2 million, within the precision of float64.
+func encodeScore(score int, achievedAt time.Time) float64 {
+	return float64(score)*scoreBase + float64(encodeAchievedAt(achievedAt))
+}
+
+// encodeAchievedAt encodes the achievement time so that the earlier ones are larger.
+func encodeAchievedAt(achievedAt time.Time) int64 {
+	seconds := min(max(achievedAt.Unix()-achievedEpoch, 0), scoreBase-1)
+	return scoreBase - 1 - seconds
+}
+
+// decodeScore decodes the sorted set score into the score and the achievement time.
+func decodeScore(encoded float64) (int, time.Time) {
+	score := math.Floor(encoded / scoreBase)
+	seconds := scoreBase - 1 - int64(encoded-score*scoreBase)
+	return int(score), time.Unix(seconds+achievedEpoch, 0)
+}
+
+// formatScore formats the sorted set score as an exact integer.
+func formatScore(encoded float64) string {
+	return strconv.FormatFloat(encoded, 'f', -1, 64)
+}
+
+// memberOf returns the sorted set member of the user. The members with the same
+// score are ordered lexicographically, so the member is the zero-padded complement
+// of the user ID to list the tied users by the ascending ID in the descending order.
+func memberOf(userID int) string {
+	return fmt.Sprintf("%010d", math.MaxInt32-userID)
+}
+
+// userIDOf returns the user ID of the sorted set member.
+func userIDOf(member string) (int, error) {
+	complement, err := strconv.Atoi(member)
+	if err != nil {
+		return 0, fmt.Errorf("invalid leaderboard member %q: %w", member, err)
+	}
+
+	return math.MaxInt32 - complement, nil
+}
+
+// leaderboard keeps the rankings of the current periods in the Redis sorted sets.
+//
+// There is a sorted set for every ranking (points or completed questions),
+// period bucket (e.g. the day of a daily ranking) and group, plus one with all
+// the users. The sets are built from the database when a ranking is first
+// requested, and then incremented when the points are granted or the questions
+// are solved. The users excluded from the default ranking (the administrators,
+// the hidden users and the cheaters) are kept out of the sets, and are removed
+// or added back when their visibility, group or cheat records change.
+//
+// The database is still the source of truth: a set which is missing (e.g. Redis
+// restarted) is built again, and Rebuild rebuilds every set of the current
+// periods to repair the increments lost (e.g. the points deleted).
+type leaderboard struct {
+	service  *Service
+	redis    rueidis.Client
+	location *time.Location
+
+	builds singleflight.Group
+}
+
+func newLeaderboard(service *Service, redis rueidis.Client, location *time.Location) *leaderboard {
+	if location == nil {
+		location = time.Local
+	}
+
+	return &leaderboard{
+		service:  service,
+		redis:    redis,
+		location: location,
+	}
+}
+
+// leaderboardKey identifies a sorted set of the leaderboard.
+type leaderboardKey struct {
+	by      model.RankingBy
+	period  model.RankingPeriod
+	start   time.Time
+	groupID *int
+}
+
+func (k leaderboardKey) String() string {
+	bucket := "all"
+	switch k.period {
+	case model.RankingPeriodDaily, model.RankingPeriodWeekly:
+		bucket = k.start.Format("20060102")
+	case model.RankingPeriodMonthly:
+		bucket = k.start.Format("200601")
+	}
+
+	name := fmt.Sprintf("%s:%s:%s", k.by, k.period, bucket)
+	if k.groupID != nil {
+		name += ":group:" + strconv.Itoa(*k.groupID)
+	}
+
+	// The name is a hash tag, so the set and its ready marker are in the same slot.
+	return redisLeaderboardPrefix + "{" + name + "}"
+}
+
+// readyKey is the key marking the sorted set as built.
+func (k leaderboardKey) readyKey() string {
+	return k.String() + ":ready"
+}
+
+// ttl is how long the sorted set is kept after built, which covers the whole
+// period bucket. The ALL_TIME sets are kept forever.
+func (k leaderboardKey) ttl() time.Duration {
+	switch k.period {
+	case model.RankingPeriodDaily:
+		return 2 * 24 * time.Hour
+	case model.RankingPeriodWeekly:
+		return 8 * 24 * time.Hour
+	case model.RankingPeriodMonthly:
+		return 32 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// keyOf returns the key of the period bucket containing t.
+func (l *leaderboard) keyOf(by model.RankingBy, period model.RankingPeriod, t time.Time, groupID *int) leaderboardKey {
+	return leaderboardKey{
+		by:      by,
+		period:  period,
+		start:   periodStart(t.In(l.location), period),
+		groupID: groupID,
+	}
+}
+
+// supports returns true if the leaderboard can serve the ranking of the filter.
+//
+// The custom periods, the question scopes, the other time zones, the dense
+// ranks and the rankings including the administrators or the cheaters are
+// served from the database.
+func (l *leaderboard) supports(filter model.RankingFilter) bool {
+	if filter.Period == model.RankingPeriodCustom {
+		return false
+	}
+	if (filter.IncludeAdmins != nil && *filter.IncludeAdmins) || (filter.IncludeCheaters != nil && *filter.IncludeCheaters) {
+		return false
+	}
+	if filter.RankMode != nil && *filter.RankMode != model.RankingModeCompetition {
+		return false
+	}
+	if filter.QuestionIDs != nil || filter.Category != nil {
+		return false
+	}
+	if filter.Timezone != nil && *filter.Timezone != l.location.String() {
+		return false
+	}
+
+	return true
+}
+
+// getPage returns the page of the ranking after the cursor.
+func (l *leaderboard) getPage(ctx context.Context, filter model.RankingFilter, after *entgql.Cursor[int], limit int) (rankingPage, error) {
+	ctx, span := tracer.Start(ctx, "leaderboard.getPage")
+	defer span.End()
+
+	key := l.keyOf(filter.By, filter.Period, time.Now(), filter.GroupID)
+	span.SetAttributes(attribute.String("ranking.leaderboard.key", key.String()))
+
+	if err := l.ensureBuilt(ctx, key); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to build leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	totalCount, err := l.count(ctx, key)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to count leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	desc := filter.Order == model.RankingOrderDesc
+
+	start := int64(0)
+	if after != nil {
+		span.AddEvent("pagination.cursor_applied")
+		start, err = l.indexAfter(ctx, key, after, desc)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to get cursor position")
+			span.RecordError(err)
+			return rankingPage{}, err
+		}
+	}
+
+	// Fetch one more member to tell if there is a next page.
+	stop := start + int64(limit)
+	cmd := l.redis.B().Zrange().Key(key.String()).Min(strconv.FormatInt(start, 10)).Max(strconv.FormatInt(stop, 10))
+	var members []rueidis.ZScore
+	if desc {
+		members, err = l.redis.Do(ctx, cmd.Rev().Withscores().Build()).AsZScores()
+	} else {
+		members, err = l.redis.Do(ctx, cmd.Withscores().Build()).AsZScores()
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to read leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, fmt.Errorf("read leaderboard: %w", err)
+	}
+
+	scores := make([]models.UserScore, 0, len(members))
+	for _, member := range members {
+		userID, err := userIDOf(member.Member)
+		if err != nil {
+			return rankingPage{}, err
+		}
+
+		score, achievedAt := decodeScore(member.Score)
+		scores = append(scores, models.UserScore{UserID: userID, Score: score, AchievedAt: achievedAt})
+	}
+
+	hasNextPage := len(scores) > limit
+	if hasNextPage {
+		scores = scores[:limit]
+	}
+
+	if err := l.assignRanks(ctx, key, scores, desc); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to rank leaderboard page")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+	if err := l.assignTies(ctx, key, scores, start, desc); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to rank leaderboard page")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Leaderboard page retrieved successfully")
+	return rankingPage{
+		Scores:      scores,
+		TotalCount:  totalCount,
+		StartIndex:  int(start),
+		HasNextPage: hasNextPage,
+	}, nil
+}
+
+// getAround returns the user with the neighbours above and below.
+// The page is empty if the user is not ranked.
+func (l *leaderboard) getAround(ctx context.Context, filter model.RankingFilter, userID int, neighbours int) (rankingPage, error) {
+	ctx, span := tracer.Start(ctx, "leaderboard.getAround")
+	defer span.End()
+
+	key := l.keyOf(filter.By, filter.Period, time.Now(), filter.GroupID)
+	span.SetAttributes(attribute.String("ranking.leaderboard.key", key.String()))
+
+	if err := l.ensureBuilt(ctx, key); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to build leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	totalCount, err := l.count(ctx, key)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to count leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	page := rankingPage{TotalCount: totalCount}
+
+	desc := filter.Order == model.RankingOrderDesc
+	rank, found, err := l.rank(ctx, key, userID, desc)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get user rank")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+	if !found {
+		span.SetStatus(otelcodes.Ok, "User is not ranked")
+		return page, nil
+	}
+
+	start := max(rank-int64(neighbours), 0)
+	stop := rank + int64(neighbours)
+	cmd := l.redis.B().Zrange().Key(key.String()).Min(strconv.FormatInt(start, 10)).Max(strconv.FormatInt(stop, 10))
+	var members []rueidis.ZScore
+	if desc {
+		members, err = l.redis.Do(ctx, cmd.Rev().Withscores().Build()).AsZScores()
+	} else {
+		members, err = l.redis.Do(ctx, cmd.Withscores().Build()).AsZScores()
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to read leaderboard")
+		span.RecordError(err)
+		return rankingPage{}, fmt.Errorf("read leaderboard: %w", err)
+	}
+
+	var (
+		scores []models.UserScore
+		index  = -1
+	)
+	for _, member := range members {
+		memberID, err := userIDOf(member.Member)
+		if err != nil {
+			return rankingPage{}, err
+		}
+		if memberID == userID {
+			index = len(scores)
+		}
+
+		score, achievedAt := decodeScore(member.Score)
+		scores = append(scores, models.UserScore{UserID: memberID, Score: score, AchievedAt: achievedAt})
+	}
+	if index < 0 {
+		// The user has been removed since the rank was read.
+		span.SetStatus(otelcodes.Ok, "User is not ranked")
+		return page, nil
+	}
+
+	var offset int
+	page.Scores, offset = around(scores, index, neighbours)
+	page.StartIndex = int(start) + offset
+	if err := l.assignRanks(ctx, key, page.Scores, desc); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to rank leaderboard page")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+	if err := l.assignTies(ctx, key, page.Scores, int64(page.StartIndex), desc); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to rank leaderboard page")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Leaderboard around the user retrieved successfully")
+	return page, nil
+}
+
+// count returns the number of the ranked users in the sorted set.
+func (l *leaderboard) count(ctx context.Context, key leaderboardKey) (int, error) {
+	total, err := l.redis.Do(ctx, l.redis.B().Zcard().Key(key.String()).Build()).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("count leaderboard: %w", err)
+	}
+
+	return int(total), nil
+}
+
+// indexAfter returns the 0-based index in the sorted set of the first member
+// after the cursor.
+//
+// The cursors with the score are positioned by the score, so the next page
+// starts at the same position even if the user has moved since. The cursors
+// without it fall back to the rank of the user.
+func (l *leaderboard) indexAfter(ctx context.Context, key leaderboardKey, cursor *entgql.Cursor[int], desc bool) (int64, error) {
+	at, ok := parseCursor(cursor)
+	if !ok {
+		rank, found, err := l.rank(ctx, key, cursor.ID, desc)
+		if err != nil || !found {
+			return 0, err
+		}
+		return rank + 1, nil
+	}
+
+	encoded := formatScore(encodeScore(at.Score, at.AchievedAt))
+
+	// The members ranked before the cursor have the higher (or lower) scores, or
+	// the same score and are ordered before (or at) the cursor among the ties,
+	// unless some ties have moved since.
+	replies := l.redis.DoMulti(ctx,
+		l.countBefore(key, encoded, desc),
+		l.redis.B().Zcount().Key(key.String()).Min(encoded).Max(encoded).Build(),
+	)
+
+	index, err := replies[0].AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("count leaderboard: %w", err)
+	}
+	tied, err := replies[1].AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("count leaderboard: %w", err)
+	}
+
+	return index + min(int64(at.Tie)+1, tied), nil
+}
+
+// countBefore returns the command counting the members ranked before the
+// encoded sorted set score.
+func (l *leaderboard) countBefore(key leaderboardKey, encoded string, desc bool) rueidis.Completed {
+	if desc {
+		return l.redis.B().Zcount().Key(key.String()).Min("(" + encoded).Max("+inf").Build()
+	}
+	return l.redis.B().Zcount().Key(key.String()).Min("-inf").Max("(" + encoded).Build()
+}
+
+// assignTies assigns the positions among the ties to the scores of the page,
+// where start is the index of the first score in the sorted set.
+func (l *leaderboard) assignTies(ctx context.Context, key leaderboardKey, scores []models.UserScore, start int64, desc bool) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	// Only the ties of the first score may start before the page.
+	encoded := formatScore(encodeScore(scores[0].Score, scores[0].AchievedAt))
+	before, err := l.redis.Do(ctx, l.countBefore(key, encoded, desc)).AsInt64()
+	if err != nil {
+		return fmt.Errorf("count leaderboard: %w", err)
+	}
+
+	assignTies(scores, int(start-before))
+	return nil
+}
+
+// rank returns the 0-based rank of the user in the sorted set.
+func (l *leaderboard) rank(ctx context.Context, key leaderboardKey, userID int, desc bool) (int64, bool, error) {
+	var cmd rueidis.Completed
+	if desc {
+		cmd = l.redis.B().Zrevrank().Key(key.String()).Member(memberOf(userID)).Build()
+	} else {
+		cmd = l.redis.B().Zrank().Key(key.String()).Member(memberOf(userID)).Build()
+	}
+
+	rank, err := l.redis.Do(ctx, cmd).AsInt64()
+	if rueidis.IsRedisNil(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("get rank: %w", err)
+	}
+
+	return rank, true, nil
+}
+
+// assignRanks assigns the competition ranks to the scores of the page, which
+// is 1 plus the number of the ranked users with a better score.
+func (l *leaderboard) assignRanks(ctx context.Context, key leaderboardKey, scores []models.UserScore, desc bool) error {
+	var (
+		distinct []int
+		cmds     rueidis.Commands
+	)
+	for _, us := range scores {
+		if len(distinct) > 0 && distinct[len(distinct)-1] == us.Score {
+			continue
+		}
+		distinct = append(distinct, us.Score)
+
+		if desc {
+			cmds = append(cmds, l.redis.B().Zcount().Key(key.String()).
+				Min(formatScore(float64(us.Score+1)*scoreBase)).Max("+inf").Build())
+		} else {
+			cmds = append(cmds, l.redis.B().Zcount().Key(key.String()).
+				Min("-inf").Max("("+formatScore(float64(us.Score)*scoreBase)).Build())
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+
+	ranks := make(map[int]int, len(distinct))
+	for i, reply := range l.redis.DoMulti(ctx, cmds...) {
+		better, err := reply.AsInt64()
+		if err != nil {
+			return fmt.Errorf("count leaderboard: %w", err)
+		}
+
+		ranks[distinct[i]] = int(better) + 1
+	}
+
+	for i := range scores {
+		scores[i].Rank = ranks[scores[i].Score]
+	}
+
+	return nil
+}
+
+// ensureBuilt builds the sorted set from the database if it has not been built.
+func (l *leaderboard) ensureBuilt(ctx context.Context, key leaderboardKey) error {
+	ready, err := l.redis.Do(ctx, l.redis.B().Exists().Key(key.readyKey()).Build()).AsInt64()
+	if err != nil {
+		return fmt.Errorf("check leaderboard: %w", err)
+	}
+	if ready == 1 {
+		return nil
+	}
+
+	// Build a sorted set only once when many requests miss it at the same time.
+	_, err, _ = l.builds.Do(key.String(), func() (any, error) {
+		return nil, l.build(context.WithoutCancel(ctx), key)
+	})
+	return err
+}
+
+// build replaces the sorted set with the scores aggregated from the database.
+func (l *leaderboard) build(ctx context.Context, key leaderboardKey) error {
+	ctx, span := tracer.Start(ctx, "leaderboard.build",
+		trace.WithAttributes(
+			attribute.String("ranking.leaderboard.key", key.String()),
+		))
+	defer span.End()
+
+	scores, err := l.userScores(ctx, key)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get user scores")
+		span.RecordError(err)
+		return err
+	}
+
+	cmds := rueidis.Commands{
+		l.redis.B().Multi().Build(),
+		l.redis.B().Del().Key(key.String()).Build(),
+	}
+	if len(scores) > 0 {
+		zadd := l.redis.B().Zadd().Key(key.String()).ScoreMember()
+		for _, score := range scores {
+			zadd = zadd.ScoreMember(encodeScore(score.Score, score.AchievedAt), memberOf(score.UserID))
+		}
+		cmds = append(cmds, zadd.Build())
+	}
+	if ttl := key.ttl(); ttl > 0 {
+		cmds = append(cmds,
+			l.redis.B().Pexpire().Key(key.String()).Milliseconds(ttl.Milliseconds()).Build(),
+			l.redis.B().Set().Key(key.readyKey()).Value("1").Px(ttl).Build(),
+		)
+	} else {
+		cmds = append(cmds, l.redis.B().Set().Key(key.readyKey()).Value("1").Build())
+	}
+	cmds = append(cmds, l.redis.B().Exec().Build())
+
+	for _, reply := range l.redis.DoMulti(ctx, cmds...) {
+		if err := reply.Error(); err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to write leaderboard")
+			span.RecordError(err)
+			return fmt.Errorf("write leaderboard: %w", err)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("ranking.leaderboard.users_count", len(scores)))
+	span.SetStatus(otelcodes.Ok, "Leaderboard built successfully")
+	return nil
+}
+
+// userScores aggregates the scores of the ranked users in the sorted set from
+// the database, narrowed down to the users matching the predicates.
+func (l *leaderboard) userScores(ctx context.Context, key leaderboardKey, users ...predicate.User) ([]models.UserScore, error) {
+	sc := scope{
+		users: []predicate.User{user.Not(user.Or(excludedUsers(model.RankingFilter{})...))},
+	}
+	sc.users = append(sc.users, users...)
+	if key.groupID != nil {
+		sc.users = append(sc.users, user.HasGroupWith(group.ID(*key.groupID)))
+	}
+
+	var (
+		scores []models.UserScore
+		err    error
+	)
+	timeRange := timeRange{Start: key.start}
+	switch key.by {
+	case model.RankingByPoints:
+		scores, err = l.service.getUserScoresByPoints(ctx, timeRange, sc)
+	case model.RankingByCompletedQuestions:
+		scores, err = l.service.getUserScoresByCompletedQuestions(ctx, timeRange, sc)
+	default:
+		err = fmt.Errorf("unsupported ranking type: %s", key.by)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user scores: %w", err)
+	}
+
+	return scores, nil
+}
+
+// Rebuild rebuilds the leaderboards of the current periods from the database,
+// for all the users and every group.
+//
+// It is a no-op if the leaderboard is not enabled.
+func (s *Service) Rebuild(ctx context.Context) error {
+	if s.leaderboard == nil {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "Rebuild")
+	defer span.End()
+
+	groupIDs, err := s.client.Group.Query().IDs(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query groups")
+		span.RecordError(err)
+		return fmt.Errorf("query groups: %w", err)
+	}
+
+	now := time.Now()
+	groups := []*int{nil}
+	for _, id := range groupIDs {
+		groups = append(groups, &id)
+	}
+
+	var errs []error
+	for _, by := range leaderboardRankings {
+		for _, period := range leaderboardPeriods {
+			for _, groupID := range groups {
+				key := s.leaderboard.keyOf(by, period, now, groupID)
+				if err := s.leaderboard.build(ctx, key); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", key, err))
+				}
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to rebuild leaderboards")
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Leaderboards rebuilt successfully")
+	return nil
+}
+
+// RegisterHooks registers the hooks updating the leaderboards to the ent client
+// of the service, when the points are granted, the questions are solved, or the
+// users are excluded from (or included in) the ranking.
+//
+// It is a no-op if the leaderboard is not enabled.
+func (s *Service) RegisterHooks() {
+	if s.leaderboard == nil {
+		return
+	}
+
+	s.client.Point.Use(hook.On(s.leaderboard.pointHook, ent.OpCreate))
+	s.client.Submission.Use(hook.On(s.leaderboard.submissionHook, ent.OpCreate))
+	s.client.User.Use(hook.On(s.leaderboard.userHook, ent.OpUpdate|ent.OpUpdateOne))
+	s.client.CheatRecord.Use(s.leaderboard.cheatRecordHook)
+}
+
+func (l *leaderboard) pointHook(next ent.Mutator) ent.Mutator {
+	return hook.PointFunc(func(ctx context.Context, m *ent.PointMutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if p, ok := v.(*ent.Point); ok {
+			userID, _ := m.UserID()
+			afterCommit(ctx, m, func(ctx context.Context) {
+				if err := l.recordPoint(ctx, userID, p.Points, p.GrantedAt); err != nil {
+					slog.Warn("failed to update the points leaderboard", "error", err, "user_id", userID)
+				}
+			})
+		}
+
+		return v, nil
+	})
+}
+
+func (l *leaderboard) submissionHook(next ent.Mutator) ent.Mutator {
+	return hook.SubmissionFunc(func(ctx context.Context, m *ent.SubmissionMutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if sub, ok := v.(*ent.Submission); ok && sub.Status == entSubmission.StatusSuccess {
+			userID, _ := m.UserID()
+			questionID, _ := m.QuestionID()
+			afterCommit(ctx, m, func(ctx context.Context) {
+				if err := l.recordSolve(ctx, userID, questionID, sub); err != nil {
+					slog.Warn("failed to update the completed questions leaderboard", "error", err, "user_id", userID)
+				}
+			})
+		}
+
+		return v, nil
+	})
+}
+
+// userHook refreshes the users in the leaderboards when their ranking visibility
+// or group changes, which can exclude them from the ranking.
+func (l *leaderboard) userHook(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+		_, visibilityChanged := m.RankingVisibility()
+		if !visibilityChanged && len(m.GroupIDs()) == 0 && !m.GroupCleared() {
+			return next.Mutate(ctx, m)
+		}
+
+		userIDs, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// The users are removed from the leaderboards of the groups they leave.
+		groupIDs, err := m.Client().User.Query().Where(user.IDIn(userIDs...)).QueryGroup().IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		afterCommit(ctx, m, func(ctx context.Context) {
+			for _, userID := range userIDs {
+				if err := l.refreshUser(ctx, userID, groupIDs); err != nil {
+					slog.Warn("failed to refresh the user in the leaderboards", "error", err, "user_id", userID)
+				}
+			}
+		})
+
+		return v, nil
+	})
+}
+
+// cheatRecordHook refreshes the users in the leaderboards when their cheat records
+// change, which can exclude them from the ranking.
+func (l *leaderboard) cheatRecordHook(next ent.Mutator) ent.Mutator {
+	return hook.CheatRecordFunc(func(ctx context.Context, m *ent.CheatRecordMutation) (ent.Value, error) {
+		var userIDs []int
+		if m.Op().Is(ent.OpCreate) {
+			if userID, ok := m.UserID(); ok {
+				userIDs = append(userIDs, userID)
+			}
+		} else {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			userIDs, err = m.Client().CheatRecord.Query().Where(cheatrecord.IDIn(ids...)).QueryUser().IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		afterCommit(ctx, m, func(ctx context.Context) {
+			for _, userID := range userIDs {
+				if err := l.refreshUser(ctx, userID, nil); err != nil {
+					slog.Warn("failed to refresh the user in the leaderboards", "error", err, "user_id", userID)
+				}
+			}
+		})
+
+		return v, nil
+	})
+}
+
+// afterCommit runs f after the transaction of the mutation is committed,
+// or immediately if the mutation is not in a transaction.
+func afterCommit(ctx context.Context, m interface{ Tx() (*ent.Tx, error) }, f func(ctx context.Context)) {
+	ctx = context.WithoutCancel(ctx)
+
+	tx, err := m.Tx()
+	if err != nil {
+		f(ctx)
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(commitCtx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(commitCtx, tx); err != nil {
+				return err
+			}
+
+			f(ctx)
+			return nil
+		})
+	})
+}
+
+// recordPoint adds the points to the points leaderboards of the user.
+func (l *leaderboard) recordPoint(ctx context.Context, userID int, points int, grantedAt time.Time) error {
+	ctx, span := tracer.Start(ctx, "leaderboard.recordPoint",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.Int("points", points),
+		))
+	defer span.End()
+
+	groupID, ranked, err := l.rankedGroupOf(ctx, userID)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query user group")
+		span.RecordError(err)
+		return err
+	}
+	if !ranked {
+		span.SetStatus(otelcodes.Ok, "User is excluded from the ranking")
+		return nil
+	}
+
+	var keys []leaderboardKey
+	for _, period := range leaderboardPeriods {
+		keys = append(keys,
+			l.keyOf(model.RankingByPoints, period, grantedAt, nil),
+			l.keyOf(model.RankingByPoints, period, grantedAt, &groupID),
+		)
+	}
+
+	if err := l.increment(ctx, keys, userID, points, grantedAt); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to increment leaderboards")
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Points recorded successfully")
+	return nil
+}
+
+// recordSolve adds the solved question to the completed questions leaderboards
+// of the periods in which the user has not solved it before.
+func (l *leaderboard) recordSolve(ctx context.Context, userID int, questionID int, sub *ent.Submission) error {
+	ctx, span := tracer.Start(ctx, "leaderboard.recordSolve",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.Int("question.id", questionID),
+		))
+	defer span.End()
+
+	groupID, ranked, err := l.rankedGroupOf(ctx, userID)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query user group")
+		span.RecordError(err)
+		return err
+	}
+	if !ranked {
+		span.SetStatus(otelcodes.Ok, "User is excluded from the ranking")
+		return nil
+	}
+
+	previous, err := l.service.client.Submission.Query().
+		Where(
+			entSubmission.IDNEQ(sub.ID),
+			entSubmission.StatusEQ(entSubmission.StatusSuccess),
+			entSubmission.SubmittedAtLTE(sub.SubmittedAt),
+			entSubmission.HasUserWith(user.ID(userID)),
+			entSubmission.HasQuestionWith(question.ID(questionID)),
+		).
+		Order(ent.Desc(entSubmission.FieldSubmittedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		span.SetStatus(otelcodes.Error, "Failed to query previous solve")
+		span.RecordError(err)
+		return fmt.Errorf("query previous solve: %w", err)
+	}
+
+	var keys []leaderboardKey
+	for _, period := range leaderboardPeriods {
+		key := l.keyOf(model.RankingByCompletedQuestions, period, sub.SubmittedAt, nil)
+		if previous != nil && !previous.SubmittedAt.Before(key.start) {
+			// Already counted in this period.
+			continue
+		}
+
+		keys = append(keys, key, l.keyOf(model.RankingByCompletedQuestions, period, sub.SubmittedAt, &groupID))
+	}
+
+	if err := l.increment(ctx, keys, userID, 1, sub.SubmittedAt); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to increment leaderboards")
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Solve recorded successfully")
+	return nil
+}
+
+// rankedGroupOf returns the group of the user, and whether the user is ranked,
+// i.e. not excluded from the default ranking.
+func (l *leaderboard) rankedGroupOf(ctx context.Context, userID int) (int, bool, error) {
+	groupID, err := l.service.client.User.Query().
+		Where(
+			user.ID(userID),
+			user.Not(user.Or(excludedUsers(model.RankingFilter{})...)),
+		).
+		QueryGroup().
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("query user group: %w", err)
+	}
+
+	return groupID, true, nil
+}
+
+// refreshUser replaces the scores of the user in the leaderboards of the current
+// periods, for all the users, the group of the user and the groups in groupIDs,
+// with the scores aggregated from the database. The user is removed from the
+// leaderboards in which the user is not ranked, e.g. excluded from the ranking,
+// or no longer in the group.
+func (l *leaderboard) refreshUser(ctx context.Context, userID int, groupIDs []int) error {
+	ctx, span := tracer.Start(ctx, "leaderboard.refreshUser",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+		))
+	defer span.End()
+
+	groupID, ranked, err := l.rankedGroupOf(ctx, userID)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query user group")
+		span.RecordError(err)
+		return err
+	}
+
+	groups := []*int{nil}
+	if ranked && !slices.Contains(groupIDs, groupID) {
+		groups = append(groups, &groupID)
+	}
+	for _, id := range groupIDs {
+		groups = append(groups, &id)
+	}
+
+	now := time.Now()
+	var execs []rueidis.LuaExec
+	for _, by := range leaderboardRankings {
+		for _, period := range leaderboardPeriods {
+			for _, groupID := range groups {
+				key := l.keyOf(by, period, now, groupID)
+
+				// An empty score removes the user from the sorted set.
+				encoded := ""
+				if ranked {
+					scores, err := l.userScores(ctx, key, user.ID(userID))
+					if err != nil {
+						span.SetStatus(otelcodes.Error, "Failed to get user scores")
+						span.RecordError(err)
+						return err
+					}
+					if len(scores) > 0 {
+						encoded = formatScore(encodeScore(scores[0].Score, scores[0].AchievedAt))
+					}
+				}
+
+				execs = append(execs, rueidis.LuaExec{
+					Keys: []string{key.String(), key.readyKey()},
+					Args: []string{encoded, memberOf(userID)},
+				})
+			}
+		}
+	}
+
+	var errs []error
+	for _, reply := range setScript.ExecMulti(ctx, l.redis, execs...) {
+		if err := reply.Error(); err != nil && !rueidis.IsRedisNil(err) {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to update leaderboards")
+		span.RecordError(err)
+		return fmt.Errorf("update leaderboard: %w", err)
+	}
+
+	span.SetAttributes(attribute.Bool("ranking.user_ranked", ranked))
+	span.SetStatus(otelcodes.Ok, "User refreshed successfully")
+	return nil
+}
+
+// increment increments the score of the user in the sorted sets which have been built,
+// which is reached at achievedAt.
+func (l *leaderboard) increment(ctx context.Context, keys []leaderboardKey, userID int, delta int, achievedAt time.Time) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	execs := make([]rueidis.LuaExec, len(keys))
+	for i, key := range keys {
+		execs[i] = rueidis.LuaExec{
+			Keys: []string{key.String(), key.readyKey()},
+			Args: []string{strconv.Itoa(delta), strconv.FormatInt(encodeAchievedAt(achievedAt), 10), memberOf(userID)},
+		}
+	}
+
+	var errs []error
+	for _, reply := range incrementScript.ExecMulti(ctx, l.redis, execs...) {
+		if err := reply.Error(); err != nil && !rueidis.IsRedisNil(err) {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("increment leaderboard: %w", err)
+	}
+
+	return nil
+}
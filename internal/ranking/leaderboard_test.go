@@ -0,0 +1,548 @@
+package ranking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
+	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/database-playground/backend-v2/internal/useraccount"
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newLeaderboardTestService creates a ranking service with the leaderboard enabled.
+func newLeaderboardTestService(t *testing.T) (*Service, *ent.Client, rueidis.Client) {
+	t.Helper()
+
+	container := testhelper.NewRedisContainer(t)
+	redisClient := testhelper.NewRedisClient(t, container)
+	entClient := testhelper.NewEntSqliteClient(t)
+
+	service := NewService(entClient, WithLeaderboard(redisClient, time.Local))
+	service.RegisterHooks()
+
+	return service, entClient, redisClient
+}
+
+func TestLeaderboard_MatchesDatabase(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	for i, u := range users {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((i + 1) * 10).
+			SetGrantedAt(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+	first := 10
+
+	fromLeaderboard, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+
+	fromDatabase, err := NewService(entClient).GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+
+	require.Equal(t, fromDatabase.TotalCount, fromLeaderboard.TotalCount)
+	require.Len(t, fromLeaderboard.Edges, len(fromDatabase.Edges))
+	for i := range fromDatabase.Edges {
+		assert.Equal(t, fromDatabase.Edges[i].Node.UserID, fromLeaderboard.Edges[i].Node.UserID)
+		assert.Equal(t, fromDatabase.Edges[i].Score, fromLeaderboard.Edges[i].Score)
+		assert.Equal(t, fromDatabase.Edges[i].Rank, fromLeaderboard.Edges[i].Rank)
+	}
+}
+
+func TestLeaderboard_MatchesDatabaseAcrossPeriods(t *testing.T) {
+	container := testhelper.NewRedisContainer(t)
+	redisClient := testhelper.NewRedisClient(t, container)
+	entClient := testhelper.NewEntSqliteClient(t)
+
+	// A location half a day away from the server, so its periods start at other times.
+	now := time.Now()
+	_, offset := now.Zone()
+	location := time.FixedZone("server+12h", (offset+12*60*60)%(24*60*60))
+
+	service := NewService(entClient, WithLocation(location), WithLeaderboard(redisClient, location))
+	service.RegisterHooks()
+
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	// The points right before and at the start of the day, in the location and on the server.
+	locationDay := periodStart(now.In(location), model.RankingPeriodDaily)
+	serverDay := periodStart(now, model.RankingPeriodDaily)
+	grants := []time.Time{
+		locationDay.Add(-time.Minute),
+		locationDay,
+		serverDay.Add(-time.Minute),
+		serverDay,
+		now,
+	}
+	for i, at := range grants {
+		_, err := entClient.Point.Create().
+			SetUser(users[i]).
+			SetPoints((i + 1) * 10).
+			SetGrantedAt(at).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	first := 10
+	for _, period := range []model.RankingPeriod{model.RankingPeriodDaily, model.RankingPeriodWeekly, model.RankingPeriodMonthly} {
+		t.Run(string(period), func(t *testing.T) {
+			filter := model.RankingFilter{
+				By:     model.RankingByPoints,
+				Order:  model.RankingOrderDesc,
+				Period: period,
+			}
+			require.True(t, service.leaderboard.supports(filter))
+
+			fromLeaderboard, err := service.GetRanking(ctx, &first, nil, filter)
+			require.NoError(t, err)
+
+			fromDatabase, err := NewService(entClient, WithLocation(location)).GetRanking(ctx, &first, nil, filter)
+			require.NoError(t, err)
+
+			require.Equal(t, fromDatabase.TotalCount, fromLeaderboard.TotalCount)
+			require.Len(t, fromLeaderboard.Edges, len(fromDatabase.Edges))
+			for i := range fromDatabase.Edges {
+				assert.Equal(t, fromDatabase.Edges[i].Node.UserID, fromLeaderboard.Edges[i].Node.UserID)
+				assert.Equal(t, fromDatabase.Edges[i].Score, fromLeaderboard.Edges[i].Score)
+			}
+		})
+	}
+
+	// The day of the location starts between the first two points.
+	daily, err := NewService(entClient, WithLocation(location)).GetRanking(ctx, &first, nil, model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	})
+	require.NoError(t, err)
+	for _, edge := range daily.Edges {
+		assert.NotEqual(t, users[0].ID, edge.Node.UserID)
+	}
+}
+
+func TestLeaderboard_IncrementsPoints(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodWeekly,
+	}
+	first := 10
+
+	// Build the leaderboard before any points are granted.
+	result, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Zero(t, result.TotalCount)
+
+	_, err = entClient.Point.Create().SetUser(users[0]).SetPoints(10).Save(ctx)
+	require.NoError(t, err)
+
+	// The points granted in a transaction are counted after it is committed.
+	tx, err := entClient.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.Point.Create().SetUser(users[1]).SetPoints(30).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	tx, err = entClient.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.Point.Create().SetUser(users[2]).SetPoints(100).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, users[1].ID, result.Edges[0].Node.UserID)
+	assert.Equal(t, 30, result.Edges[0].Score)
+	assert.Equal(t, users[0].ID, result.Edges[1].Node.UserID)
+	assert.Equal(t, 10, result.Edges[1].Score)
+
+	// The group leaderboard is incremented as well.
+	group, err := users[0].QueryGroup().Only(ctx)
+	require.NoError(t, err)
+	filter.GroupID = &group.ID
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.TotalCount)
+}
+
+func TestLeaderboard_IncrementsCompletedQuestions(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, questions := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	filter := model.RankingFilter{
+		By:     model.RankingByCompletedQuestions,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodAllTime,
+	}
+	first := 10
+
+	_, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+
+	submit := func(q *ent.Question, status entSubmission.Status) {
+		_, err := entClient.Submission.Create().
+			SetUser(users[0]).
+			SetQuestion(q).
+			SetSubmittedCode("SELECT 1").
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	submit(questions[0], entSubmission.StatusFailed)
+	submit(questions[0], entSubmission.StatusSuccess)
+	submit(questions[0], entSubmission.StatusSuccess) // solved again
+	submit(questions[1], entSubmission.StatusSuccess)
+
+	result, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TotalCount)
+	assert.Equal(t, 2, result.Edges[0].Score)
+}
+
+func TestLeaderboard_ExclusionsAndPagination(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	adminGroup, err := entClient.Group.Create().
+		SetName(useraccount.AdminGroupSlug).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, entClient.User.UpdateOne(users[4]).SetGroup(adminGroup).Exec(ctx))
+
+	_, err = entClient.CheatRecord.Create().
+		SetUser(users[3]).
+		SetReason("copying").
+		Save(ctx)
+	require.NoError(t, err)
+
+	// User 5 (admin) > User 4 (cheater) > User 3 > User 2 > User 1
+	for i, u := range users {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((i + 1) * 10).
+			SetGrantedAt(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodMonthly,
+	}
+	first := 2
+
+	result, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.TotalCount)
+	require.Len(t, result.Edges, 2)
+	assert.Equal(t, users[2].ID, result.Edges[0].Node.UserID)
+	assert.Equal(t, users[1].ID, result.Edges[1].Node.UserID)
+	assert.True(t, result.PageInfo.HasNextPage)
+
+	// The cursors without the score fall back to the position of the user.
+	after := entgql.Cursor[int]{ID: users[1].ID}
+	result, err = service.GetRanking(ctx, &first, &after, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 1)
+	assert.Equal(t, users[0].ID, result.Edges[0].Node.UserID)
+	assert.False(t, result.PageInfo.HasNextPage)
+	assert.True(t, result.PageInfo.HasPreviousPage)
+
+	included := true
+	filter.IncludeAdmins = &included
+	filter.IncludeCheaters = &included
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 5, result.TotalCount)
+	assert.Equal(t, users[4].ID, result.Edges[0].Node.UserID)
+}
+
+func TestLeaderboard_ExclusionChanges(t *testing.T) {
+	service, entClient, redisClient := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	for i, u := range users[:3] {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((i + 1) * 10).
+			SetGrantedAt(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodAllTime,
+	}
+	key := service.leaderboard.keyOf(filter.By, filter.Period, time.Now(), nil)
+	first := 10
+
+	requireRanked := func(t *testing.T, want ...int) {
+		t.Helper()
+
+		result, err := service.GetRanking(ctx, &first, nil, filter)
+		require.NoError(t, err)
+		var got []int
+		for _, edge := range result.Edges {
+			got = append(got, edge.Node.UserID)
+		}
+		require.Equal(t, want, got)
+
+		// The excluded users are not kept in the sorted set.
+		count, err := redisClient.Do(ctx, redisClient.B().Zcard().Key(key.String()).Build()).AsInt64()
+		require.NoError(t, err)
+		require.EqualValues(t, len(want), count)
+	}
+
+	requireRanked(t, users[2].ID, users[1].ID, users[0].ID)
+
+	// Hidden users are removed, and added back with their scores.
+	require.NoError(t, entClient.User.UpdateOne(users[2]).SetRankingVisibility(user.RankingVisibilityHidden).Exec(ctx))
+	requireRanked(t, users[1].ID, users[0].ID)
+	require.NoError(t, entClient.User.UpdateOne(users[2]).SetRankingVisibility(user.RankingVisibilityPseudonym).Exec(ctx))
+	requireRanked(t, users[2].ID, users[1].ID, users[0].ID)
+
+	// Cheaters are removed until their cheat records are dismissed.
+	record, err := entClient.CheatRecord.Create().
+		SetUser(users[1]).
+		SetReason("copying").
+		Save(ctx)
+	require.NoError(t, err)
+	requireRanked(t, users[2].ID, users[0].ID)
+
+	// The points granted to an excluded user are not added.
+	_, err = entClient.Point.Create().SetUser(users[1]).SetPoints(100).Save(ctx)
+	require.NoError(t, err)
+	requireRanked(t, users[2].ID, users[0].ID)
+
+	require.NoError(t, entClient.CheatRecord.UpdateOne(record).SetState(cheatrecord.StateDismissed).Exec(ctx))
+	requireRanked(t, users[1].ID, users[2].ID, users[0].ID)
+
+	// Administrators are removed when they join the admin group.
+	adminGroup, err := entClient.Group.Create().
+		SetName(useraccount.AdminGroupSlug).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, entClient.User.UpdateOne(users[0]).SetGroup(adminGroup).Exec(ctx))
+	requireRanked(t, users[1].ID, users[2].ID)
+}
+
+func TestLeaderboard_Rebuild(t *testing.T) {
+	service, entClient, redisClient := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+	first := 10
+
+	p, err := entClient.Point.Create().SetUser(users[0]).SetPoints(10).Save(ctx)
+	require.NoError(t, err)
+
+	result, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TotalCount)
+
+	// The deleted points are not decremented, until the leaderboard is rebuilt.
+	require.NoError(t, entClient.Point.DeleteOne(p).Exec(ctx))
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TotalCount)
+
+	require.NoError(t, service.Rebuild(ctx))
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Zero(t, result.TotalCount)
+
+	// A missing leaderboard (e.g. Redis restarted) is built again.
+	_, err = entClient.Point.Create().SetUser(users[1]).SetPoints(20).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, redisClient.Do(ctx, redisClient.B().Flushall().Build()).Error())
+
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TotalCount)
+	assert.Equal(t, 20, result.Edges[0].Score)
+}
+
+func TestLeaderboard_Supports(t *testing.T) {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+
+	l := newLeaderboard(nil, nil, taipei)
+
+	now := time.Now()
+	category := "test"
+	sameZone := "Asia/Taipei"
+	otherZone := "UTC"
+
+	assert.True(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily}))
+	assert.True(t, l.supports(model.RankingFilter{Period: model.RankingPeriodWeekly, Timezone: &sameZone}))
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, Timezone: &otherZone}))
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodCustom, From: &now, To: &now}))
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, Category: &category}))
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, QuestionIDs: []int{1}}))
+
+	dense := model.RankingModeDense
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, RankMode: &dense}))
+
+	included := true
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, IncludeAdmins: &included}))
+	assert.False(t, l.supports(model.RankingFilter{Period: model.RankingPeriodDaily, IncludeCheaters: &included}))
+}
+
+func TestLeaderboard_Ties(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+	first := 10
+
+	// Build the leaderboard before the points are granted, so they are incremented.
+	_, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	setupTiedPoints(t, entClient, users, today)
+
+	result, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 5)
+	for i, id := range []int{users[1].ID, users[3].ID, users[0].ID, users[2].ID, users[4].ID} {
+		assert.Equal(t, id, result.Edges[i].Node.UserID)
+	}
+	for i, rank := range []int{1, 1, 1, 4, 5} {
+		assert.Equal(t, rank, result.Edges[i].Rank)
+	}
+
+	// The rebuilt leaderboard is the same.
+	require.NoError(t, service.Rebuild(ctx))
+	rebuilt, err := service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, rebuilt.Edges, len(result.Edges))
+	for i := range result.Edges {
+		assert.Equal(t, result.Edges[i].Node.UserID, rebuilt.Edges[i].Node.UserID)
+		assert.Equal(t, result.Edges[i].Rank, rebuilt.Edges[i].Rank)
+		assert.Equal(t, result.Edges[i].Cursor, rebuilt.Edges[i].Cursor)
+	}
+
+	// The cursor keeps its position when the user moves.
+	pageSize := 2
+	result, err = service.GetRanking(ctx, &pageSize, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 2)
+
+	_, err = entClient.Point.Create().
+		SetUser(users[3]).
+		SetPoints(100).
+		SetGrantedAt(today.Add(4 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	result, err = service.GetRanking(ctx, &pageSize, result.PageInfo.EndCursor, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 2)
+	assert.Equal(t, users[0].ID, result.Edges[0].Node.UserID)
+	assert.Equal(t, users[2].ID, result.Edges[1].Node.UserID)
+	assert.Equal(t, 2, result.Edges[0].Rank)
+
+	// The ascending order is the reverse.
+	filter.Order = model.RankingOrderAsc
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 5)
+	for i, id := range []int{users[4].ID, users[2].ID, users[0].ID, users[1].ID, users[3].ID} {
+		assert.Equal(t, id, result.Edges[i].Node.UserID)
+	}
+	for i, rank := range []int{1, 2, 3, 3, 5} {
+		assert.Equal(t, rank, result.Edges[i].Rank)
+	}
+}
+
+func TestLeaderboard_GetMyRanking(t *testing.T) {
+	service, entClient, _ := newLeaderboardTestService(t)
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	adminGroup, err := entClient.Group.Create().
+		SetName(useraccount.AdminGroupSlug).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, entClient.User.UpdateOne(users[3]).SetGroup(adminGroup).Exec(ctx))
+
+	// User 5 > User 4 (admin) > User 3 > User 2 > User 1
+	for i, u := range users {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((i + 1) * 10).
+			SetGrantedAt(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+
+	result, err := service.GetMyRanking(ctx, users[2].ID, 1, filter)
+	require.NoError(t, err)
+	require.NotNil(t, result.Rank)
+	assert.Equal(t, 2, *result.Rank)
+	assert.Equal(t, 30, *result.Score)
+	assert.Equal(t, 4, result.TotalCount)
+	require.Len(t, result.Edges, 3)
+	assert.Equal(t, users[4].ID, result.Edges[0].Node.UserID)
+	assert.Equal(t, users[2].ID, result.Edges[1].Node.UserID)
+	assert.Equal(t, users[1].ID, result.Edges[2].Node.UserID)
+	assert.Equal(t, 3, result.Edges[2].Rank)
+
+	// The excluded users are not ranked.
+	result, err = service.GetMyRanking(ctx, users[3].ID, 1, filter)
+	require.NoError(t, err)
+	assert.Nil(t, result.Rank)
+	assert.Empty(t, result.Edges)
+}
@@ -1,19 +1,32 @@
 package ranking
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
-	"sort"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	_ "time/tzdata" // the time zones of the ranking filter
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/group"
 	"github.com/database-playground/backend-v2/ent/point"
+	"github.com/database-playground/backend-v2/ent/predicate"
+	"github.com/database-playground/backend-v2/ent/question"
 	entSubmission "github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
 	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/cheating"
+	"github.com/database-playground/backend-v2/internal/useraccount"
 	"github.com/database-playground/backend-v2/models"
+	"github.com/redis/rueidis"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
@@ -22,16 +35,45 @@ import (
 
 var tracer = otel.Tracer("dbplay.ranking")
 
+// ErrInvalidFilter is returned when the ranking filter is invalid.
+var ErrInvalidFilter = errors.New("invalid ranking filter")
+
 // Service handles ranking operations
 type Service struct {
-	client *ent.Client
+	client      *ent.Client
+	leaderboard *leaderboard
+	location    *time.Location
+}
+
+type ServiceOption func(*Service)
+
+// WithLocation sets the location in which the periods of the rankings and the
+// days of the rank snapshots are calculated. Defaults to time.Local.
+func WithLocation(location *time.Location) ServiceOption {
+	return func(s *Service) {
+		s.location = location
+	}
+}
+
+// WithLeaderboard keeps the leaderboards in the Redis sorted sets, with the
+// periods calculated in the location. See leaderboard for details.
+func WithLeaderboard(redis rueidis.Client, location *time.Location) ServiceOption {
+	return func(s *Service) {
+		s.leaderboard = newLeaderboard(s, redis, location)
+	}
 }
 
 // NewService creates a new ranking service
-func NewService(client *ent.Client) *Service {
-	return &Service{
-		client: client,
+func NewService(client *ent.Client, opts ...ServiceOption) *Service {
+	s := &Service{
+		client:   client,
+		location: time.Local,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // GetRanking retrieves the ranking based on the provided filter and pagination parameters
@@ -46,51 +88,22 @@ func (s *Service) GetRanking(ctx context.Context, first *int, after *entgql.Curs
 
 	// Calculate the time range based on the period
 	span.AddEvent("time_range.calculating")
-	timeRange := s.getTimeRange(filter.Period)
-	span.SetAttributes(attribute.String("ranking.time_range.start", timeRange.Format(time.RFC3339)))
-
-	// Get all users with their scores
-	var userScores []models.UserScore
-	var err error
-
-	switch filter.By {
-	case model.RankingByPoints:
-		span.AddEvent("ranking.by_points")
-		userScores, err = s.getUserScoresByPoints(ctx, timeRange)
-	case model.RankingByCompletedQuestions:
-		span.AddEvent("ranking.by_completed_questions")
-		userScores, err = s.getUserScoresByCompletedQuestions(ctx, timeRange)
-	default:
-		span.SetStatus(otelcodes.Error, fmt.Sprintf("Unsupported ranking type: %s", filter.By))
-		return nil, fmt.Errorf("unsupported ranking type: %s", filter.By)
-	}
-
+	timeRange, err := s.getTimeRange(time.Now(), filter)
 	if err != nil {
-		span.SetStatus(otelcodes.Error, "Failed to get user scores")
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
 		span.RecordError(err)
-		return nil, fmt.Errorf("failed to get user scores: %w", err)
+		return nil, err
 	}
+	span.SetAttributes(
+		attribute.String("ranking.time_range.start", timeRange.Start.Format(time.RFC3339)),
+		attribute.String("ranking.time_range.end", timeRange.End.Format(time.RFC3339)),
+	)
 
-	// Sort based on order
-	span.AddEvent("sorting.started")
-	s.sortUserScores(userScores, filter.Order)
-
-	// Calculate total count before pagination
-	totalCount := len(userScores)
-	span.SetAttributes(attribute.Int("ranking.total_count", totalCount))
-
-	// Apply cursor pagination
-	startIdx := 0
-	if after != nil {
-		span.AddEvent("pagination.cursor_applied")
-		// Find the position after the cursor
-		afterID := after.ID
-		for i, us := range userScores {
-			if us.UserID == afterID {
-				startIdx = i + 1
-				break
-			}
-		}
+	scope, err := s.getScope(filter)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Invalid ranking filter")
+		span.RecordError(err)
+		return nil, err
 	}
 
 	// Apply limit
@@ -98,18 +111,80 @@ func (s *Service) GetRanking(ctx context.Context, first *int, after *entgql.Curs
 	if first != nil && *first > 0 {
 		limit = *first
 	}
+	span.SetAttributes(attribute.Int("ranking.limit", limit))
+
+	var (
+		page   rankingPage
+		served bool
+	)
+	if s.leaderboard != nil && s.leaderboard.supports(filter) {
+		span.AddEvent("ranking.from_leaderboard")
+		page, err = s.leaderboard.getPage(ctx, filter, after, limit)
+		if err != nil {
+			// The database is the source of truth, so the ranking is still available without Redis.
+			span.RecordError(err)
+			slog.Warn("failed to get the ranking from the leaderboard, falling back to the database", "error", err)
+		} else {
+			served = true
+		}
+	}
+	if !served {
+		span.AddEvent("ranking.from_database")
+		page, err = s.getPageFromDatabase(ctx, filter, timeRange, scope, after, limit)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to get user scores")
+			span.RecordError(err)
+			return nil, fmt.Errorf("failed to get user scores: %w", err)
+		}
+	}
+
+	paginatedScores := page.Scores
 	span.SetAttributes(
-		attribute.Int("ranking.limit", limit),
-		attribute.Int("ranking.start_index", startIdx),
+		attribute.Int("ranking.total_count", page.TotalCount),
+		attribute.Int("ranking.start_index", page.StartIndex),
 	)
 
-	endIdx := min(startIdx+limit, len(userScores))
-	paginatedScores := userScores[startIdx:endIdx]
+	edges, err := s.buildEdges(ctx, paginatedScores)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to fetch users")
+		span.RecordError(err)
+		return nil, err
+	}
 
-	// Fetch user entities for the paginated results
+	// Build page info
+	pageInfo := &entgql.PageInfo[int]{
+		HasNextPage:     page.HasNextPage,
+		HasPreviousPage: page.StartIndex > 0,
+	}
+
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	span.SetAttributes(
+		attribute.Int("ranking.edges_count", len(edges)),
+		attribute.Bool("ranking.has_next_page", pageInfo.HasNextPage),
+		attribute.Bool("ranking.has_previous_page", pageInfo.HasPreviousPage),
+	)
+
+	span.SetStatus(otelcodes.Ok, "Ranking retrieved successfully")
+	return &model.RankingConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: page.TotalCount,
+	}, nil
+}
+
+// buildEdges builds the ranking edges of the user scores.
+func (s *Service) buildEdges(ctx context.Context, scores []models.UserScore) ([]*model.RankingEdge, error) {
+	ctx, span := tracer.Start(ctx, "buildEdges")
+	defer span.End()
+
+	// Fetch user entities for the scores
 	span.AddEvent("database.users.fetching")
-	userIDs := make([]int, len(paginatedScores))
-	for i, us := range paginatedScores {
+	userIDs := make([]int, len(scores))
+	for i, us := range scores {
 		userIDs[i] = us.UserID
 	}
 
@@ -128,88 +203,274 @@ func (s *Service) GetRanking(ctx context.Context, first *int, after *entgql.Curs
 		userMap[u.ID] = u
 	}
 
-	// Build edges
+	currentUserID := 0
+	if tokenInfo, ok := auth.GetUser(ctx); ok {
+		currentUserID = tokenInfo.UserID
+	}
+
 	span.AddEvent("edges.building")
-	edges := make([]*model.RankingEdge, 0, len(paginatedScores))
-	for _, us := range paginatedScores {
+	edges := make([]*model.RankingEdge, 0, len(scores))
+	for _, us := range scores {
 		if user, ok := userMap[us.UserID]; ok {
-			cursor := entgql.Cursor[int]{ID: us.UserID}
 			edges = append(edges, &model.RankingEdge{
-				Node:   user,
+				Node:   profileOf(user, currentUserID),
 				Score:  us.Score,
-				Cursor: cursor,
+				Rank:   us.Rank,
+				Cursor: cursorOf(us),
 			})
 		}
 	}
 
-	// Build page info
-	pageInfo := &entgql.PageInfo[int]{
-		HasNextPage:     endIdx < len(userScores),
-		HasPreviousPage: startIdx > 0,
+	span.SetStatus(otelcodes.Ok, "Edges built successfully")
+	return edges, nil
+}
+
+// profileOf returns the public profile of the user in the rankings.
+func profileOf(u *ent.User, currentUserID int) *models.RankingProfile {
+	profile := &models.RankingProfile{
+		UserID:      u.ID,
+		DisplayName: u.Pseudonym,
+		IsMe:        u.ID == currentUserID,
 	}
 
-	if len(edges) > 0 {
-		pageInfo.StartCursor = &edges[0].Cursor
-		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	if u.RankingVisibility == user.RankingVisibilityRealName {
+		profile.DisplayName = u.Name
+		if u.Avatar != "" {
+			profile.Avatar = &u.Avatar
+		}
 	}
 
-	span.SetAttributes(
-		attribute.Int("ranking.edges_count", len(edges)),
-		attribute.Bool("ranking.has_next_page", pageInfo.HasNextPage),
-		attribute.Bool("ranking.has_previous_page", pageInfo.HasPreviousPage),
-	)
+	return profile
+}
 
-	span.SetStatus(otelcodes.Ok, "Ranking retrieved successfully")
-	return &model.RankingConnection{
-		Edges:      edges,
-		PageInfo:   pageInfo,
-		TotalCount: totalCount,
+// rankingPage is a page of the sorted user scores.
+type rankingPage struct {
+	Scores []models.UserScore
+	// TotalCount is the number of the ranked users.
+	TotalCount int
+	// StartIndex is the index of the first score of the page in the ranking.
+	StartIndex int
+	// HasNextPage is true if there are more scores after this page.
+	HasNextPage bool
+}
+
+// getPageFromDatabase aggregates the scores of every user from the database,
+// and returns the page after the cursor.
+func (s *Service) getPageFromDatabase(ctx context.Context, filter model.RankingFilter, timeRange timeRange, scope scope, after *entgql.Cursor[int], limit int) (rankingPage, error) {
+	ctx, span := tracer.Start(ctx, "getPageFromDatabase")
+	defer span.End()
+
+	userScores, err := s.getSortedScores(ctx, filter, timeRange, scope)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get user scores")
+		span.RecordError(err)
+		return rankingPage{}, err
+	}
+
+	// Apply cursor pagination
+	startIdx := 0
+	if after != nil {
+		span.AddEvent("pagination.cursor_applied")
+		startIdx = indexAfter(userScores, after, filter.Order)
+	}
+
+	endIdx := min(startIdx+limit, len(userScores))
+
+	span.SetStatus(otelcodes.Ok, "Ranking page retrieved successfully")
+	return rankingPage{
+		Scores:      userScores[startIdx:endIdx],
+		TotalCount:  len(userScores),
+		StartIndex:  startIdx,
+		HasNextPage: endIdx < len(userScores),
 	}, nil
 }
 
-// getTimeRange calculates the start time based on the period
-func (s *Service) getTimeRange(period model.RankingPeriod) time.Time {
-	now := time.Now()
+// getSortedScores aggregates the scores of every user from the database,
+// and returns them sorted and ranked.
+func (s *Service) getSortedScores(ctx context.Context, filter model.RankingFilter, timeRange timeRange, scope scope) ([]models.UserScore, error) {
+	ctx, span := tracer.Start(ctx, "getSortedScores")
+	defer span.End()
+
+	var (
+		userScores []models.UserScore
+		err        error
+	)
+
+	switch filter.By {
+	case model.RankingByPoints:
+		span.AddEvent("ranking.by_points")
+		userScores, err = s.getUserScoresByPoints(ctx, timeRange, scope)
+	case model.RankingByCompletedQuestions:
+		span.AddEvent("ranking.by_completed_questions")
+		userScores, err = s.getUserScoresByCompletedQuestions(ctx, timeRange, scope)
+	default:
+		span.SetStatus(otelcodes.Error, fmt.Sprintf("Unsupported ranking type: %s", filter.By))
+		return nil, fmt.Errorf("unsupported ranking type: %s", filter.By)
+	}
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to get user scores")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Sort based on order
+	span.AddEvent("sorting.started")
+	s.sortUserScores(userScores, filter.Order)
+	assignRanks(userScores, filter.RankMode)
+	assignTies(userScores, 0)
+
+	span.SetStatus(otelcodes.Ok, "User scores sorted successfully")
+	return userScores, nil
+}
+
+// timeRange is the time range of a ranking. A zero Start or End means unbounded.
+type timeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// getTimeRange calculates the time range based on the period of the filter
+func (s *Service) getTimeRange(now time.Time, filter model.RankingFilter) (timeRange, error) {
+	if filter.Period == model.RankingPeriodCustom {
+		if filter.From == nil || filter.To == nil {
+			return timeRange{}, fmt.Errorf("%w: from and to are required for the CUSTOM period", ErrInvalidFilter)
+		}
+		if !filter.From.Before(*filter.To) {
+			return timeRange{}, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+		}
+
+		return timeRange{Start: *filter.From, End: *filter.To}, nil
+	}
+	if filter.From != nil || filter.To != nil {
+		return timeRange{}, fmt.Errorf("%w: from and to are only allowed for the CUSTOM period", ErrInvalidFilter)
+	}
+
+	// The periods are in the location of the service (as the leaderboards are)
+	// unless the filter asks for another time zone.
+	now = now.In(s.location)
+	if filter.Timezone != nil {
+		loc, err := time.LoadLocation(*filter.Timezone)
+		if err != nil {
+			return timeRange{}, fmt.Errorf("%w: unknown time zone %q", ErrInvalidFilter, *filter.Timezone)
+		}
+		now = now.In(loc)
+	}
+
+	switch filter.Period {
+	case model.RankingPeriodDaily, model.RankingPeriodWeekly, model.RankingPeriodMonthly, model.RankingPeriodAllTime:
+		return timeRange{Start: periodStart(now, filter.Period)}, nil
+	default:
+		return timeRange{}, fmt.Errorf("%w: unsupported period %s", ErrInvalidFilter, filter.Period)
+	}
+}
+
+// periodStart returns the start of the period containing t, in the location of t.
+// It returns the zero time for ALL_TIME.
+func periodStart(t time.Time, period model.RankingPeriod) time.Time {
+	startOfDay := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 
 	switch period {
 	case model.RankingPeriodDaily:
-		// Start of today
-		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		return startOfDay
 	case model.RankingPeriodWeekly:
 		// Start of the week (Monday)
-		weekday := now.Weekday()
-		daysToMonday := int(weekday - time.Monday)
+		daysToMonday := int(t.Weekday() - time.Monday)
 		if daysToMonday < 0 {
 			daysToMonday += 7
 		}
-		startOfWeek := now.AddDate(0, 0, -daysToMonday)
-		return time.Date(startOfWeek.Year(), startOfWeek.Month(), startOfWeek.Day(), 0, 0, 0, 0, startOfWeek.Location())
+		return startOfDay.AddDate(0, 0, -daysToMonday)
+	case model.RankingPeriodMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
 	default:
-		// Default to daily
-		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		return time.Time{}
+	}
+}
+
+// scope is the users and questions counted in a ranking.
+type scope struct {
+	users     []predicate.User
+	questions []predicate.Question
+}
+
+// getScope builds the scope of the ranking from the filter
+func (s *Service) getScope(filter model.RankingFilter) (scope, error) {
+	var sc scope
+
+	if filter.GroupID != nil {
+		sc.users = append(sc.users, user.HasGroupWith(group.ID(*filter.GroupID)))
+	}
+	if excluded := excludedUsers(filter); len(excluded) > 0 {
+		sc.users = append(sc.users, user.Not(user.Or(excluded...)))
+	}
+
+	if filter.QuestionIDs != nil {
+		sc.questions = append(sc.questions, question.IDIn(filter.QuestionIDs...))
+	}
+	if filter.Category != nil {
+		sc.questions = append(sc.questions, question.CategoryEQ(*filter.Category))
+	}
+	if len(sc.questions) > 0 && filter.By != model.RankingByCompletedQuestions {
+		return scope{}, fmt.Errorf("%w: questionIDs and category are only allowed for the COMPLETED_QUESTIONS ranking", ErrInvalidFilter)
+	}
+
+	return sc, nil
+}
+
+// excludedUsers returns the predicates of the users excluded from the ranking by default,
+// unless the filter includes them. The users hidden from the rankings are always excluded.
+func excludedUsers(filter model.RankingFilter) []predicate.User {
+	excluded := []predicate.User{
+		user.RankingVisibilityEQ(user.RankingVisibilityHidden),
+	}
+
+	if filter.IncludeAdmins == nil || !*filter.IncludeAdmins {
+		excluded = append(excluded, user.HasGroupWith(group.NameEQ(useraccount.AdminGroupSlug)))
 	}
+	if filter.IncludeCheaters == nil || !*filter.IncludeCheaters {
+		excluded = append(excluded, user.HasCheatRecordsWith(cheating.Counted()))
+	}
+
+	return excluded
 }
 
 // getUserScoresByPoints gets user scores based on total points in the time range
-func (s *Service) getUserScoresByPoints(ctx context.Context, since time.Time) ([]models.UserScore, error) {
+func (s *Service) getUserScoresByPoints(ctx context.Context, timeRange timeRange, scope scope) ([]models.UserScore, error) {
 	ctx, span := tracer.Start(ctx, "getUserScoresByPoints",
 		trace.WithAttributes(
-			attribute.String("ranking.time_range.start", since.Format(time.RFC3339)),
+			attribute.String("ranking.time_range.start", timeRange.Start.Format(time.RFC3339)),
+			attribute.String("ranking.time_range.end", timeRange.End.Format(time.RFC3339)),
 		))
 	defer span.End()
 
 	var results []struct {
-		UserID     int `json:"user_points"`
-		TotalScore int `json:"total_score"`
+		UserID     int            `json:"user_points"`
+		TotalScore int            `json:"total_score"`
+		AchievedAt models.SQLTime `json:"achieved_at"`
+	}
+
+	query := s.client.Point.Query()
+	if len(scope.users) > 0 {
+		query = query.Where(point.HasUserWith(scope.users...))
+	}
+	if !timeRange.Start.IsZero() {
+		query = query.Where(point.GrantedAtGTE(timeRange.Start))
+	}
+	if !timeRange.End.IsZero() {
+		query = query.Where(point.GrantedAtLT(timeRange.End))
 	}
 
 	span.AddEvent("database.points.querying")
-	err := s.client.Point.Query().
-		Where(point.GrantedAtGTE(since)).
+	err := query.
 		GroupBy("user_points").
-		Aggregate(func(sel *sql.Selector) string {
-			return sql.As(sql.Sum(point.FieldPoints), "total_score")
-		}).
+		Aggregate(
+			func(sel *sql.Selector) string {
+				return sql.As(sql.Sum(point.FieldPoints), "total_score")
+			},
+			// The user reaches the total score when the last points are granted.
+			func(sel *sql.Selector) string {
+				return sql.As(sql.Max(sel.C(point.FieldGrantedAt)), "achieved_at")
+			},
+		).
 		Scan(ctx, &results)
 	if err != nil {
 		span.SetStatus(otelcodes.Error, "Failed to query points")
@@ -221,8 +482,9 @@ func (s *Service) getUserScoresByPoints(ctx context.Context, since time.Time) ([
 	userScores := make([]models.UserScore, len(results))
 	for i, r := range results {
 		userScores[i] = models.UserScore{
-			UserID: r.UserID,
-			Score:  r.TotalScore,
+			UserID:     r.UserID,
+			Score:      r.TotalScore,
+			AchievedAt: time.Time(r.AchievedAt),
 		}
 	}
 
@@ -232,29 +494,41 @@ func (s *Service) getUserScoresByPoints(ctx context.Context, since time.Time) ([
 }
 
 // getUserScoresByCompletedQuestions gets user scores based on completed questions in the time range
-func (s *Service) getUserScoresByCompletedQuestions(ctx context.Context, since time.Time) ([]models.UserScore, error) {
+func (s *Service) getUserScoresByCompletedQuestions(ctx context.Context, timeRange timeRange, scope scope) ([]models.UserScore, error) {
 	ctx, span := tracer.Start(ctx, "getUserScoresByCompletedQuestions",
 		trace.WithAttributes(
-			attribute.String("ranking.time_range.start", since.Format(time.RFC3339)),
+			attribute.String("ranking.time_range.start", timeRange.Start.Format(time.RFC3339)),
+			attribute.String("ranking.time_range.end", timeRange.End.Format(time.RFC3339)),
 		))
 	defer span.End()
 
 	var results []struct {
-		UserID          int `json:"user_submissions"`
-		CompletedQuests int `json:"completed_quests"`
+		UserID        int            `json:"user_submissions"`
+		QuestionID    int            `json:"question_submissions"`
+		FirstSolvedAt models.SQLTime `json:"first_solved_at"`
 	}
 
-	// Count distinct successful submissions per user
+	query := s.client.Submission.Query().
+		Where(entSubmission.StatusEQ(entSubmission.StatusSuccess))
+	if len(scope.users) > 0 {
+		query = query.Where(entSubmission.HasUserWith(scope.users...))
+	}
+	if len(scope.questions) > 0 {
+		query = query.Where(entSubmission.HasQuestionWith(scope.questions...))
+	}
+	if !timeRange.Start.IsZero() {
+		query = query.Where(entSubmission.SubmittedAtGTE(timeRange.Start))
+	}
+	if !timeRange.End.IsZero() {
+		query = query.Where(entSubmission.SubmittedAtLT(timeRange.End))
+	}
+
+	// Find the first successful submission of every question solved by the users
 	span.AddEvent("database.submissions.querying")
-	err := s.client.Submission.Query().
-		Where(
-			entSubmission.StatusEQ(entSubmission.StatusSuccess),
-			entSubmission.SubmittedAtGTE(since),
-		).
-		GroupBy("user_submissions").
+	err := query.
+		GroupBy("user_submissions", "question_submissions").
 		Aggregate(func(sel *sql.Selector) string {
-			// Count distinct questions
-			return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", "question_submissions"), "completed_quests")
+			return sql.As(sql.Min(sel.C(entSubmission.FieldSubmittedAt)), "first_solved_at")
 		}).
 		Scan(ctx, &results)
 	if err != nil {
@@ -263,12 +537,22 @@ func (s *Service) getUserScoresByCompletedQuestions(ctx context.Context, since t
 		return nil, err
 	}
 
+	// Count the solved questions per user. The user reaches the count
+	// when the last of the questions is first solved.
 	span.AddEvent("results.processing")
-	userScores := make([]models.UserScore, len(results))
-	for i, r := range results {
-		userScores[i] = models.UserScore{
-			UserID: r.UserID,
-			Score:  r.CompletedQuests,
+	scoreIndex := make(map[int]int)
+	userScores := make([]models.UserScore, 0)
+	for _, r := range results {
+		i, ok := scoreIndex[r.UserID]
+		if !ok {
+			i = len(userScores)
+			scoreIndex[r.UserID] = i
+			userScores = append(userScores, models.UserScore{UserID: r.UserID})
+		}
+
+		userScores[i].Score++
+		if solvedAt := time.Time(r.FirstSolvedAt); solvedAt.After(userScores[i].AchievedAt) {
+			userScores[i].AchievedAt = solvedAt
 		}
 	}
 
@@ -277,15 +561,136 @@ func (s *Service) getUserScoresByCompletedQuestions(ctx context.Context, since t
 	return userScores, nil
 }
 
-// sortUserScores sorts user scores in place based on the order
+// sortUserScores sorts user scores in place based on the order.
+//
+// The ties are broken by who reached the score first (to the second), then by
+// the user ID, so the ranking is deterministic. The ascending order is the exact
+// reverse of the descending one.
 func (s *Service) sortUserScores(scores []models.UserScore, order model.RankingOrder) {
-	if order == model.RankingOrderDesc {
-		sort.Slice(scores, func(i, j int) bool {
-			return scores[i].Score > scores[j].Score
-		})
-	} else {
-		sort.Slice(scores, func(i, j int) bool {
-			return scores[i].Score < scores[j].Score
-		})
+	slices.SortFunc(scores, func(a, b models.UserScore) int {
+		if order == model.RankingOrderDesc {
+			return compareDesc(a, b)
+		}
+		return compareDesc(b, a)
+	})
+}
+
+// compareDesc compares two user scores in the descending order of the ranking.
+func compareDesc(a, b models.UserScore) int {
+	return cmp.Or(
+		comparePositionDesc(a, b),
+		cmp.Compare(a.UserID, b.UserID),
+	)
+}
+
+// comparePositionDesc compares two user scores in the descending order of the
+// ranking, without breaking the ties of the same score reached at the same second.
+func comparePositionDesc(a, b models.UserScore) int {
+	return cmp.Or(
+		cmp.Compare(b.Score, a.Score),
+		cmp.Compare(a.AchievedAt.Unix(), b.AchievedAt.Unix()),
+	)
+}
+
+// assignRanks assigns the ranks to the sorted user scores. The users with the
+// same score share the same rank.
+func assignRanks(scores []models.UserScore, mode *model.RankingMode) {
+	dense := mode != nil && *mode == model.RankingModeDense
+
+	for i := range scores {
+		switch {
+		case i > 0 && scores[i].Score == scores[i-1].Score:
+			scores[i].Rank = scores[i-1].Rank
+		case dense && i > 0:
+			scores[i].Rank = scores[i-1].Rank + 1
+		default:
+			scores[i].Rank = i + 1
+		}
+	}
+}
+
+// assignTies assigns the positions among the ties to the sorted user scores,
+// where first is the position of the first score.
+func assignTies(scores []models.UserScore, first int) {
+	for i := range scores {
+		switch {
+		case i == 0:
+			scores[i].Tie = first
+		case comparePositionDesc(scores[i], scores[i-1]) == 0:
+			scores[i].Tie = scores[i-1].Tie + 1
+		default:
+			scores[i].Tie = 0
+		}
+	}
+}
+
+// cursorOf returns the cursor of the user score. The cursor holds the score,
+// when it was reached and the position among the ties ("score:unix:tie"), so the
+// next page starts at the same position even if the user has moved since.
+//
+// The cursors are only encoded rather than encrypted, so they must not hold the
+// user ID, which would identify the users shown by the pseudonyms.
+func cursorOf(us models.UserScore) entgql.Cursor[int] {
+	return entgql.Cursor[int]{
+		Value: fmt.Sprintf("%d:%d:%d", us.Score, us.AchievedAt.Unix(), us.Tie),
+	}
+}
+
+// parseCursor returns the user score in the cursor, without the user ID.
+// It returns false if the cursor only holds the user ID.
+func parseCursor(cursor *entgql.Cursor[int]) (models.UserScore, bool) {
+	value, ok := cursor.Value.(string)
+	if !ok {
+		return models.UserScore{}, false
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return models.UserScore{}, false
+	}
+	score, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return models.UserScore{}, false
+	}
+	achieved, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return models.UserScore{}, false
+	}
+	tie, err := strconv.Atoi(parts[2])
+	if err != nil || tie < 0 {
+		return models.UserScore{}, false
+	}
+
+	return models.UserScore{
+		Score:      score,
+		AchievedAt: time.Unix(achieved, 0),
+		Tie:        tie,
+	}, true
+}
+
+// indexAfter returns the index of the first sorted user score after the cursor.
+func indexAfter(scores []models.UserScore, cursor *entgql.Cursor[int], order model.RankingOrder) int {
+	at, ok := parseCursor(cursor)
+	if !ok {
+		// The cursors without the score fall back to the position of the user.
+		for i, us := range scores {
+			if us.UserID == cursor.ID {
+				return i + 1
+			}
+		}
+		return 0
 	}
+
+	// Skip the ties up to the cursor, but not past them if some have moved since.
+	index, _ := slices.BinarySearchFunc(scores, at, func(us, at models.UserScore) int {
+		if order == model.RankingOrderDesc {
+			return comparePositionDesc(us, at)
+		}
+		return comparePositionDesc(at, us)
+	})
+	for skipped := 0; skipped <= at.Tie && index < len(scores) && comparePositionDesc(scores[index], at) == 0; skipped++ {
+		index++
+	}
+
+	return index
 }
@@ -1,16 +1,25 @@
 package ranking
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"github.com/database-playground/backend-v2/ent"
+	entCheatRecord "github.com/database-playground/backend-v2/ent/cheatrecord"
 	entQuestion "github.com/database-playground/backend-v2/ent/question"
 	entSubmission "github.com/database-playground/backend-v2/ent/submission"
+	entUser "github.com/database-playground/backend-v2/ent/user"
 	"github.com/database-playground/backend-v2/graph/model"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/setup"
 	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/database-playground/backend-v2/internal/useraccount"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -59,6 +68,7 @@ func setupTestRankingData(t *testing.T, entClient *ent.Client) ([]*ent.User, *en
 			SetName("User " + strconv.Itoa(i+1)).
 			SetEmail("user" + strconv.Itoa(i+1) + "@example.com").
 			SetGroup(group).
+			SetRankingVisibility(entUser.RankingVisibilityRealName).
 			Save(ctx)
 		require.NoError(t, err)
 		users[i] = user
@@ -117,10 +127,10 @@ func TestService_GetRanking_ByPoints_Daily(t *testing.T) {
 		assert.False(t, result.PageInfo.HasPreviousPage)
 
 		// Check order: User 2 (200), User 1 (150), User 3 (100), User 4 (50)
-		assert.Equal(t, "User 2", result.Edges[0].Node.Name)
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
-		assert.Equal(t, "User 3", result.Edges[2].Node.Name)
-		assert.Equal(t, "User 4", result.Edges[3].Node.Name)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
+		assert.Equal(t, "User 3", result.Edges[2].Node.DisplayName)
+		assert.Equal(t, "User 4", result.Edges[3].Node.DisplayName)
 	})
 
 	t.Run("ascending order", func(t *testing.T) {
@@ -166,9 +176,9 @@ func TestService_GetRanking_ByPoints_Daily(t *testing.T) {
 		assert.Equal(t, 3, result.TotalCount)
 
 		// Check order: User 3 (100), User 1 (150), User 2 (200)
-		assert.Equal(t, "User 3", result.Edges[0].Node.Name)
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
-		assert.Equal(t, "User 2", result.Edges[2].Node.Name)
+		assert.Equal(t, "User 3", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
+		assert.Equal(t, "User 2", result.Edges[2].Node.DisplayName)
 	})
 
 	t.Run("filters out yesterday's points", func(t *testing.T) {
@@ -210,7 +220,7 @@ func TestService_GetRanking_ByPoints_Daily(t *testing.T) {
 		// Verify response - only user 1 should appear
 		require.NoError(t, err)
 		assert.Equal(t, 1, result.TotalCount)
-		assert.Equal(t, "User 2", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
 	})
 }
 
@@ -277,8 +287,8 @@ func TestService_GetRanking_ByPoints_Weekly(t *testing.T) {
 		assert.Equal(t, 2, result.TotalCount)
 
 		// User 2 (200), User 1 (150 = 100 + 50)
-		assert.Equal(t, "User 2", result.Edges[0].Node.Name)
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
 	})
 }
 
@@ -358,9 +368,9 @@ func TestService_GetRanking_ByCompletedQuestions(t *testing.T) {
 		assert.Equal(t, 3, result.TotalCount)
 
 		// Check order: User 3 (3), User 1 (2), User 2 (1)
-		assert.Equal(t, "User 3", result.Edges[0].Node.Name)
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
-		assert.Equal(t, "User 2", result.Edges[2].Node.Name)
+		assert.Equal(t, "User 3", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
+		assert.Equal(t, "User 2", result.Edges[2].Node.DisplayName)
 	})
 
 	t.Run("does not double count same question", func(t *testing.T) {
@@ -397,7 +407,7 @@ func TestService_GetRanking_ByCompletedQuestions(t *testing.T) {
 		// Verify response - should count as 1 completed question, not 3
 		require.NoError(t, err)
 		assert.Equal(t, 1, result.TotalCount)
-		assert.Equal(t, "User 1", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[0].Node.DisplayName)
 	})
 }
 
@@ -484,7 +494,7 @@ func TestService_GetRanking_Pagination(t *testing.T) {
 		assert.True(t, result2.PageInfo.HasNextPage)
 
 		// Verify we got different users
-		assert.NotEqual(t, result1.Edges[0].Node.Name, result2.Edges[0].Node.Name)
+		assert.NotEqual(t, result1.Edges[0].Node.DisplayName, result2.Edges[0].Node.DisplayName)
 	})
 }
 
@@ -543,7 +553,7 @@ func TestService_GetRanking_EdgeCases(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 1, result.TotalCount)
 		assert.Equal(t, 1, len(result.Edges))
-		assert.Equal(t, "User 1", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[0].Node.DisplayName)
 	})
 }
 
@@ -592,13 +602,13 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 
 		// Check scores match expected values
 		// Order: User 2 (200), User 1 (150), User 3 (75)
-		assert.Equal(t, "User 2", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
 		assert.Equal(t, 200, result.Edges[0].Score)
 
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
 		assert.Equal(t, 150, result.Edges[1].Score)
 
-		assert.Equal(t, "User 3", result.Edges[2].Node.Name)
+		assert.Equal(t, "User 3", result.Edges[2].Node.DisplayName)
 		assert.Equal(t, 75, result.Edges[2].Score)
 	})
 
@@ -636,7 +646,7 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 		// Verify response
 		require.NoError(t, err)
 		assert.Equal(t, 1, result.TotalCount)
-		assert.Equal(t, "User 1", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[0].Node.DisplayName)
 		assert.Equal(t, 250, result.Edges[0].Score) // Sum of all entries
 	})
 
@@ -696,10 +706,10 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 
 		// Check scores match completed questions count
 		// Order: User 2 (3), User 1 (2)
-		assert.Equal(t, "User 2", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
 		assert.Equal(t, 3, result.Edges[0].Score)
 
-		assert.Equal(t, "User 1", result.Edges[1].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
 		assert.Equal(t, 2, result.Edges[1].Score)
 	})
 
@@ -754,7 +764,7 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 		// Verify response - only 1 successful submission should count
 		require.NoError(t, err)
 		assert.Equal(t, 1, result.TotalCount)
-		assert.Equal(t, "User 1", result.Edges[0].Node.Name)
+		assert.Equal(t, "User 1", result.Edges[0].Node.DisplayName)
 		assert.Equal(t, 1, result.Edges[0].Score) // Only successful submissions
 	})
 
@@ -800,8 +810,8 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 
 		// Verify first page scores
 		for _, edge := range result1.Edges {
-			expectedScore := expectedScores[edge.Node.Name]
-			assert.Equal(t, expectedScore, edge.Score, "Score mismatch for %s", edge.Node.Name)
+			expectedScore := expectedScores[edge.Node.DisplayName]
+			assert.Equal(t, expectedScore, edge.Score, "Score mismatch for %s", edge.Node.DisplayName)
 		}
 
 		// Get second page
@@ -813,8 +823,8 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 
 		// Verify second page scores
 		for _, edge := range result2.Edges {
-			expectedScore := expectedScores[edge.Node.Name]
-			assert.Equal(t, expectedScore, edge.Score, "Score mismatch for %s", edge.Node.Name)
+			expectedScore := expectedScores[edge.Node.DisplayName]
+			assert.Equal(t, expectedScore, edge.Score, "Score mismatch for %s", edge.Node.DisplayName)
 		}
 	})
 
@@ -870,12 +880,12 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 		// Build map of scores from each result
 		scoresDesc := make(map[string]int)
 		for _, edge := range resultDesc.Edges {
-			scoresDesc[edge.Node.Name] = edge.Score
+			scoresDesc[edge.Node.DisplayName] = edge.Score
 		}
 
 		scoresAsc := make(map[string]int)
 		for _, edge := range resultAsc.Edges {
-			scoresAsc[edge.Node.Name] = edge.Score
+			scoresAsc[edge.Node.DisplayName] = edge.Score
 		}
 
 		// Verify same users have same scores regardless of order
@@ -889,3 +899,489 @@ func TestService_GetRanking_ScoreValues(t *testing.T) {
 		assert.Equal(t, 100, scoresAsc["User 3"])
 	})
 }
+
+func TestService_GetTimeRange(t *testing.T) {
+	service := NewService(nil)
+
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+
+	// Wednesday 2025-01-01 01:00 in Taipei, which is still 2024-12-31 in UTC.
+	now := time.Date(2024, 12, 31, 17, 0, 0, 0, time.UTC)
+	timezone := "Asia/Taipei"
+
+	t.Run("daily in time zone", func(t *testing.T) {
+		tr, err := service.getTimeRange(now, model.RankingFilter{Period: model.RankingPeriodDaily, Timezone: &timezone})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, taipei)))
+		assert.True(t, tr.End.IsZero())
+	})
+
+	t.Run("daily without time zone", func(t *testing.T) {
+		// The periods are in the location of the service, whatever the location of now.
+		tr, err := NewService(nil, WithLocation(taipei)).getTimeRange(now.In(time.UTC), model.RankingFilter{Period: model.RankingPeriodDaily})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, taipei)))
+
+		tr, err = NewService(nil, WithLocation(time.UTC)).getTimeRange(now.In(taipei), model.RankingFilter{Period: model.RankingPeriodDaily})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		tr, err := service.getTimeRange(now, model.RankingFilter{Period: model.RankingPeriodWeekly, Timezone: &timezone})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(time.Date(2024, 12, 30, 0, 0, 0, 0, taipei)))
+	})
+
+	t.Run("monthly", func(t *testing.T) {
+		tr, err := service.getTimeRange(now, model.RankingFilter{Period: model.RankingPeriodMonthly, Timezone: &timezone})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, taipei)))
+	})
+
+	t.Run("all time", func(t *testing.T) {
+		tr, err := service.getTimeRange(now, model.RankingFilter{Period: model.RankingPeriodAllTime})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.IsZero())
+		assert.True(t, tr.End.IsZero())
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		from := time.Date(2024, 9, 1, 0, 0, 0, 0, taipei)
+		to := time.Date(2025, 1, 31, 0, 0, 0, 0, taipei)
+
+		tr, err := service.getTimeRange(now, model.RankingFilter{Period: model.RankingPeriodCustom, From: &from, To: &to})
+		require.NoError(t, err)
+		assert.True(t, tr.Start.Equal(from))
+		assert.True(t, tr.End.Equal(to))
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		from := now
+		to := now.Add(time.Hour)
+		unknownZone := "Mars/Olympus_Mons"
+
+		filters := []model.RankingFilter{
+			{Period: model.RankingPeriodCustom},
+			{Period: model.RankingPeriodCustom, From: &from},
+			{Period: model.RankingPeriodCustom, From: &to, To: &from},
+			{Period: model.RankingPeriodDaily, From: &from, To: &to},
+			{Period: model.RankingPeriodDaily, Timezone: &unknownZone},
+		}
+		for _, filter := range filters {
+			_, err := service.getTimeRange(now, filter)
+			require.ErrorIs(t, err, ErrInvalidFilter)
+		}
+	})
+}
+
+func TestService_GetRanking_CustomAndAllTime(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, _, _ := setupTestRankingData(t, entClient)
+
+	ctx := context.Background()
+	examStart := time.Now().AddDate(0, -2, 0)
+	examEnd := examStart.Add(2 * time.Hour)
+
+	// In the exam
+	_, err := entClient.Point.Create().
+		SetUser(users[0]).
+		SetPoints(100).
+		SetGrantedAt(examStart.Add(time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// After the exam
+	_, err = entClient.Point.Create().
+		SetUser(users[1]).
+		SetPoints(500).
+		SetGrantedAt(examEnd).
+		Save(ctx)
+	require.NoError(t, err)
+
+	first := 10
+
+	t.Run("custom", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodCustom,
+			From:   &examStart,
+			To:     &examEnd,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, result.TotalCount)
+		assert.Equal(t, "User 1", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, 100, result.Edges[0].Score)
+	})
+
+	t.Run("all time", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodAllTime,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, result.TotalCount)
+		assert.Equal(t, "User 2", result.Edges[0].Node.DisplayName)
+		assert.Equal(t, "User 1", result.Edges[1].Node.DisplayName)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := service.GetRanking(ctx, &first, nil, model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodCustom,
+		})
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+func TestService_GetRanking_Scoped(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, database, questions := setupTestRankingData(t, entClient)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	otherGroup, err := entClient.Group.Create().
+		SetName("Other Group").
+		Save(ctx)
+	require.NoError(t, err)
+
+	adminGroup, err := entClient.Group.Create().
+		SetName(useraccount.AdminGroupSlug).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// User 4 is in another class, and User 5 is an administrator.
+	require.NoError(t, entClient.User.UpdateOne(users[3]).SetGroup(otherGroup).Exec(ctx))
+	require.NoError(t, entClient.User.UpdateOne(users[4]).SetGroup(adminGroup).Exec(ctx))
+
+	// User 3 has an unresolved cheat record, User 2 has a resolved one,
+	// and User 1 has a pending one waiting to be confirmed.
+	_, err = entClient.CheatRecord.Create().
+		SetUser(users[2]).
+		SetReason("copying").
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = entClient.CheatRecord.Create().
+		SetUser(users[1]).
+		SetReason("copying").
+		SetResolvedReason("false positive").
+		SetResolvedAt(now).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = entClient.CheatRecord.Create().
+		SetUser(users[0]).
+		SetReason("suspected plagiarism").
+		SetState(entCheatRecord.StatePending).
+		Save(ctx)
+	require.NoError(t, err)
+
+	otherCategoryQuestion, err := entClient.Question.Create().
+		SetCategory("other").
+		SetTitle("Question 4").
+		SetDescription("Test question").
+		SetReferenceAnswer("SELECT 1").
+		SetDifficulty(entQuestion.DifficultyEasy).
+		SetDatabase(database).
+		Save(ctx)
+	require.NoError(t, err)
+
+	solve := func(u *ent.User, q *ent.Question) {
+		_, err := entClient.Submission.Create().
+			SetUser(u).
+			SetQuestion(q).
+			SetSubmittedCode("SELECT 1").
+			SetStatus(entSubmission.StatusSuccess).
+			SetSubmittedAt(now).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	solve(users[0], questions[0])
+	solve(users[1], questions[0])
+	solve(users[1], otherCategoryQuestion)
+	for _, u := range users[2:] {
+		solve(u, questions[0])
+		solve(u, questions[1])
+	}
+
+	first := 10
+	names := func(result *model.RankingConnection) []string {
+		names := make([]string, len(result.Edges))
+		for i, edge := range result.Edges {
+			names[i] = edge.Node.DisplayName
+		}
+		return names
+	}
+	filter := func(modify func(*model.RankingFilter)) model.RankingFilter {
+		f := model.RankingFilter{
+			By:     model.RankingByCompletedQuestions,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodDaily,
+		}
+		modify(&f)
+		return f
+	}
+	enabled := true
+
+	t.Run("excludes admins and cheaters by default", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, filter(func(*model.RankingFilter) {}))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"User 1", "User 2", "User 4"}, names(result))
+	})
+
+	t.Run("includes admins and cheaters", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, filter(func(f *model.RankingFilter) {
+			f.IncludeAdmins = &enabled
+			f.IncludeCheaters = &enabled
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.TotalCount)
+	})
+
+	t.Run("group", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, filter(func(f *model.RankingFilter) {
+			f.GroupID = &otherGroup.ID
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"User 4"}, names(result))
+	})
+
+	t.Run("question IDs", func(t *testing.T) {
+		result, err := service.GetRanking(ctx, &first, nil, filter(func(f *model.RankingFilter) {
+			f.QuestionIDs = []int{questions[1].ID}
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"User 4"}, names(result))
+		assert.Equal(t, 1, result.Edges[0].Score)
+	})
+
+	t.Run("category", func(t *testing.T) {
+		category := "test"
+		result, err := service.GetRanking(ctx, &first, nil, filter(func(f *model.RankingFilter) {
+			f.Category = &category
+		}))
+		require.NoError(t, err)
+
+		scores := make(map[string]int)
+		for _, edge := range result.Edges {
+			scores[edge.Node.DisplayName] = edge.Score
+		}
+		assert.Equal(t, map[string]int{"User 1": 1, "User 2": 1, "User 4": 2}, scores)
+	})
+
+	t.Run("question scope is not allowed for points", func(t *testing.T) {
+		_, err := service.GetRanking(ctx, &first, nil, filter(func(f *model.RankingFilter) {
+			f.By = model.RankingByPoints
+			f.QuestionIDs = []int{questions[0].ID}
+		}))
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+// setupTiedPoints grants the points so that User 2 and User 4 reach 100 points first
+// at the same time, User 1 reaches 100 points later, and User 3 and User 5 are behind.
+func setupTiedPoints(t *testing.T, entClient *ent.Client, users []*ent.User, today time.Time) {
+	t.Helper()
+
+	grants := []struct {
+		user   *ent.User
+		points int
+		at     time.Duration
+	}{
+		{users[0], 100, 3 * time.Hour},
+		{users[1], 100, time.Hour},
+		{users[2], 50, 2 * time.Hour},
+		{users[3], 100, time.Hour},
+		{users[4], 20, time.Hour},
+	}
+	for _, g := range grants {
+		_, err := entClient.Point.Create().
+			SetUser(g.user).
+			SetPoints(g.points).
+			SetGrantedAt(today.Add(g.at)).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func TestService_GetRanking_Ties(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, _, _ := setupTestRankingData(t, entClient)
+
+	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	setupTiedPoints(t, entClient, users, today)
+
+	first := 10
+	ranking := func(filter model.RankingFilter) (ids []int, ranks []int) {
+		result, err := service.GetRanking(ctx, &first, nil, filter)
+		require.NoError(t, err)
+		for _, edge := range result.Edges {
+			ids = append(ids, edge.Node.UserID)
+			ranks = append(ranks, edge.Rank)
+		}
+		return ids, ranks
+	}
+
+	t.Run("competition ranks", func(t *testing.T) {
+		ids, ranks := ranking(model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodDaily,
+		})
+		assert.Equal(t, []int{users[1].ID, users[3].ID, users[0].ID, users[2].ID, users[4].ID}, ids)
+		assert.Equal(t, []int{1, 1, 1, 4, 5}, ranks)
+	})
+
+	t.Run("dense ranks", func(t *testing.T) {
+		dense := model.RankingModeDense
+		ids, ranks := ranking(model.RankingFilter{
+			By:       model.RankingByPoints,
+			Order:    model.RankingOrderDesc,
+			Period:   model.RankingPeriodDaily,
+			RankMode: &dense,
+		})
+		assert.Equal(t, []int{users[1].ID, users[3].ID, users[0].ID, users[2].ID, users[4].ID}, ids)
+		assert.Equal(t, []int{1, 1, 1, 2, 3}, ranks)
+	})
+
+	t.Run("ascending order is the reverse", func(t *testing.T) {
+		ids, ranks := ranking(model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderAsc,
+			Period: model.RankingPeriodDaily,
+		})
+		assert.Equal(t, []int{users[4].ID, users[2].ID, users[0].ID, users[3].ID, users[1].ID}, ids)
+		assert.Equal(t, []int{1, 2, 3, 3, 3}, ranks)
+	})
+
+	t.Run("cursor keeps its position when the user moves", func(t *testing.T) {
+		filter := model.RankingFilter{
+			By:     model.RankingByPoints,
+			Order:  model.RankingOrderDesc,
+			Period: model.RankingPeriodDaily,
+		}
+		pageSize := 2
+
+		result, err := service.GetRanking(ctx, &pageSize, nil, filter)
+		require.NoError(t, err)
+		require.Len(t, result.Edges, 2)
+		assert.Equal(t, users[3].ID, result.Edges[1].Node.UserID)
+
+		// User 4 moves to the top after the first page is read.
+		_, err = entClient.Point.Create().
+			SetUser(users[3]).
+			SetPoints(100).
+			SetGrantedAt(today.Add(4 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		result, err = service.GetRanking(ctx, &pageSize, result.PageInfo.EndCursor, filter)
+		require.NoError(t, err)
+		require.Len(t, result.Edges, 2)
+		assert.Equal(t, users[0].ID, result.Edges[0].Node.UserID)
+		assert.Equal(t, users[2].ID, result.Edges[1].Node.UserID)
+		assert.Equal(t, 2, result.Edges[0].Rank)
+	})
+}
+
+func TestService_GetRanking_Privacy(t *testing.T) {
+	entClient := testhelper.NewEntSqliteClient(t)
+	service := NewService(entClient)
+
+	users, _, _ := setupTestRankingData(t, entClient)
+	ctx := context.Background()
+
+	require.NoError(t, entClient.User.UpdateOne(users[0]).
+		SetAvatar("https://example.com/avatar.png").
+		Exec(ctx))
+	require.NoError(t, entClient.User.UpdateOne(users[1]).
+		SetRankingVisibility(entUser.RankingVisibilityPseudonym).
+		SetAvatar("https://example.com/avatar.png").
+		Exec(ctx))
+	require.NoError(t, entClient.User.UpdateOne(users[2]).
+		SetRankingVisibility(entUser.RankingVisibilityHidden).
+		Exec(ctx))
+	// A user created before the pseudonyms were introduced gets one when migrated.
+	require.NoError(t, entClient.User.UpdateOne(users[3]).
+		SetRankingVisibility(entUser.RankingVisibilityPseudonym).
+		ClearPseudonym().
+		Exec(ctx))
+	require.NoError(t, setup.Migrate(ctx, entClient))
+
+	// User 1 > User 2 > User 3 (hidden) > User 4
+	for i, u := range users[:4] {
+		_, err := entClient.Point.Create().
+			SetUser(u).
+			SetPoints((5 - i) * 10).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := model.RankingFilter{
+		By:     model.RankingByPoints,
+		Order:  model.RankingOrderDesc,
+		Period: model.RankingPeriodDaily,
+	}
+	first := 10
+
+	// The hidden users are never ranked, even with the administrators and the cheaters.
+	included := true
+	filter.IncludeAdmins = &included
+	filter.IncludeCheaters = &included
+
+	result, err := service.GetRanking(auth.WithUser(ctx, auth.TokenInfo{UserID: users[1].ID}), &first, nil, filter)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.TotalCount)
+	require.Len(t, result.Edges, 3)
+
+	realName := result.Edges[0].Node
+	assert.Equal(t, "User 1", realName.DisplayName)
+	require.NotNil(t, realName.Avatar)
+	assert.Equal(t, "https://example.com/avatar.png", *realName.Avatar)
+	assert.False(t, realName.IsMe)
+
+	pseudonymous := result.Edges[1].Node
+	assert.NotEqual(t, "User 2", pseudonymous.DisplayName)
+	assert.NotEmpty(t, pseudonymous.DisplayName)
+	assert.Nil(t, pseudonymous.Avatar)
+	assert.True(t, pseudonymous.IsMe)
+
+	// The cursors are only encoded, so anyone can decode them, and they must not
+	// hold the ID of the pseudonymous user.
+	var encoded bytes.Buffer
+	result.Edges[1].Cursor.MarshalGQL(&encoded)
+	encodedCursor, err := strconv.Unquote(encoded.String())
+	require.NoError(t, err)
+	var decoded entgql.Cursor[int]
+	require.NoError(t, decoded.UnmarshalGQL(encodedCursor))
+	assert.Zero(t, decoded.ID)
+	// Only the score, when it was reached and the position among the ties.
+	parts := strings.Split(fmt.Sprint(decoded.Value), ":")
+	require.Len(t, parts, 3)
+	assert.Equal(t, strconv.Itoa(result.Edges[1].Score), parts[0])
+	assert.Equal(t, "0", parts[2])
+
+	// The pseudonym is generated once, and kept.
+	generated := result.Edges[2].Node
+	assert.NotEmpty(t, generated.DisplayName)
+	assert.Equal(t, users[3].ID, generated.UserID)
+
+	result, err = service.GetRanking(ctx, &first, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, result.Edges, 3)
+	assert.Equal(t, pseudonymous.DisplayName, result.Edges[1].Node.DisplayName)
+	assert.Equal(t, generated.DisplayName, result.Edges[2].Node.DisplayName)
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// The headers of the rate limit, following the IETF draft "RateLimit header fields for HTTP".
+const (
+	HeaderLimit      = "RateLimit-Limit"
+	HeaderRemaining  = "RateLimit-Remaining"
+	HeaderReset      = "RateLimit-Reset"
+	HeaderRetryAfter = "Retry-After"
+)
+
+// MiddlewareOption configures a Middleware.
+type MiddlewareOption func(*middleware)
+
+type middleware struct {
+	graphql bool
+}
+
+// WithGraphQLResponse responds the throttled requests with a GraphQL error
+// coded RATE_LIMITED, instead of a plain JSON error.
+func WithGraphQLResponse() MiddlewareOption {
+	return func(m *middleware) {
+		m.graphql = true
+	}
+}
+
+// Middleware limits the requests of the route group with the limit.
+//
+// The requests are keyed by the authenticated user (see auth.GetUser), or by
+// the client IP for the anonymous ones, so it should be used after
+// auth.Middleware. The client IP respects the trusted proxies of the engine.
+//
+// The RateLimit-* headers are set on every limited response, and the
+// throttled requests are rejected with 429 Too Many Requests and the
+// Retry-After header. The requests are allowed if Redis is unavailable.
+func Middleware(limiter *Limiter, group string, limit Limit, opts ...MiddlewareOption) gin.HandlerFunc {
+	m := &middleware{}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return func(c *gin.Context) {
+		if !limit.Enabled() {
+			c.Next()
+			return
+		}
+
+		subject := "ip:" + c.ClientIP()
+		if user, ok := auth.GetUser(c.Request.Context()); ok {
+			subject = "user:" + strconv.Itoa(user.UserID)
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), subject, Bucket{Key: group, Limit: limit})
+		if err != nil {
+			slog.Warn("failed to check the rate limit", "error", err, "group", group, "subject", subject)
+			c.Next()
+			return
+		}
+
+		c.Header(HeaderLimit, strconv.Itoa(limit.Burst))
+		c.Header(HeaderRemaining, strconv.Itoa(result.Remaining))
+		c.Header(HeaderReset, strconv.Itoa(seconds(result.ResetAfter)))
+
+		if result.Allowed {
+			c.Next()
+			return
+		}
+
+		retryAfter := seconds(result.RetryAfter)
+		c.Header(HeaderRetryAfter, strconv.Itoa(retryAfter))
+
+		if m.graphql {
+			// The standard format for GraphQL errors.
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"errors": []gin.H{
+					{
+						"message": "too many requests",
+						"path":    []string{},
+						"extensions": map[string]any{
+							"code":        defs.CodeRateLimited,
+							"retry_after": retryAfter,
+						},
+					},
+				},
+				"data": nil,
+			})
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"error":       "too many requests",
+			"retry_after": retryAfter,
+		})
+	}
+}
+
+// seconds rounds the duration up to seconds.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
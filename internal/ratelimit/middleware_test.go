@@ -0,0 +1,127 @@
+package ratelimit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/graph/defs"
+	"github.com/database-playground/backend-v2/internal/auth"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEngine(t *testing.T, opts ...ratelimit.MiddlewareOption) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	limiter := newTestLimiter(t)
+	engine := gin.New()
+	require.NoError(t, engine.SetTrustedProxies([]string{"10.0.0.0/8"}))
+
+	// the user ID in the X-User-ID header simulates auth.Middleware
+	engine.Use(func(c *gin.Context) {
+		if c.GetHeader("X-User-ID") == "1" {
+			c.Request = c.Request.WithContext(auth.WithUser(c.Request.Context(), auth.TokenInfo{UserID: 1}))
+		}
+		c.Next()
+	})
+	engine.Use(ratelimit.Middleware(limiter, "test", ratelimit.Limit{Burst: 2, Interval: time.Minute}, opts...))
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	return engine
+}
+
+func request(engine *gin.Engine, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	engine := newTestEngine(t)
+
+	rec := request(engine, "192.0.2.1:1234", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get(ratelimit.HeaderLimit))
+	assert.Equal(t, "1", rec.Header().Get(ratelimit.HeaderRemaining))
+	assert.NotEmpty(t, rec.Header().Get(ratelimit.HeaderReset))
+
+	rec = request(engine, "192.0.2.1:1234", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(ratelimit.HeaderRemaining))
+
+	rec = request(engine, "192.0.2.1:1234", nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get(ratelimit.HeaderRetryAfter))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "too many requests", body["error"])
+
+	// another IP is not limited
+	rec = request(engine, "192.0.2.2:1234", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestMiddleware_KeyedByUser(t *testing.T) {
+	engine := newTestEngine(t)
+	asUser := map[string]string{"X-User-ID": "1"}
+
+	for range 2 {
+		require.Equal(t, http.StatusOK, request(engine, "192.0.2.1:1234", asUser).Code)
+	}
+
+	// the user is limited on any IP, and the anonymous requests of the IP are not
+	assert.Equal(t, http.StatusTooManyRequests, request(engine, "192.0.2.2:1234", asUser).Code)
+	assert.Equal(t, http.StatusOK, request(engine, "192.0.2.1:1234", nil).Code)
+}
+
+func TestMiddleware_TrustedProxies(t *testing.T) {
+	engine := newTestEngine(t)
+
+	// the requests from a trusted proxy are keyed by the forwarded IP
+	for range 2 {
+		require.Equal(t, http.StatusOK, request(engine, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "192.0.2.1"}).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, request(engine, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "192.0.2.1"}).Code)
+	assert.Equal(t, http.StatusOK, request(engine, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "192.0.2.2"}).Code)
+
+	// the forwarded IP is ignored if the proxy is not trusted
+	for range 2 {
+		require.Equal(t, http.StatusOK, request(engine, "192.0.2.3:1234", map[string]string{"X-Forwarded-For": "192.0.2.4"}).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, request(engine, "192.0.2.3:1234", map[string]string{"X-Forwarded-For": "192.0.2.5"}).Code)
+}
+
+func TestMiddleware_GraphQLResponse(t *testing.T) {
+	engine := newTestEngine(t, ratelimit.WithGraphQLResponse())
+
+	for range 2 {
+		require.Equal(t, http.StatusOK, request(engine, "192.0.2.1:1234", nil).Code)
+	}
+
+	rec := request(engine, "192.0.2.1:1234", nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	var body struct {
+		Errors []struct {
+			Extensions map[string]any `json:"extensions"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, defs.CodeRateLimited, body.Errors[0].Extensions["code"])
+	assert.EqualValues(t, 60, body.Errors[0].Extensions["retry_after"])
+}
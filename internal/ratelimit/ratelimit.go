@@ -0,0 +1,237 @@
+// Package ratelimit implements the token-bucket rate limiters stored in Redis.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/rueidis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.ratelimit")
+
+// RequestsTotal tracks the requests checked by the rate limiters.
+//
+// The result is "allowed", "throttled" or "error".
+var RequestsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_rate_limit_requests_total",
+		Help: "Total number of requests checked by the rate limiters",
+	},
+	[]string{"limiter", "result"},
+)
+
+const redisRateLimitPrefix = "ratelimit:"
+
+// Limit is the limit of a token bucket. The bucket holds at most Burst tokens,
+// and is refilled with a token every Interval. Each request takes a token.
+//
+// For example, {Burst: 10, Interval: 6 * time.Second} allows 10 requests at
+// once, and 10 requests per minute in the long run.
+type Limit struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// Enabled reports whether the limit limits anything.
+func (l Limit) Enabled() bool {
+	return l.Burst > 0 && l.Interval > 0
+}
+
+// Bucket is a token bucket of a subject identified by the key.
+type Bucket struct {
+	Key   string
+	Limit Limit
+}
+
+// Result is the result of a rate limit check.
+type Result struct {
+	// Allowed reports whether the request is allowed.
+	Allowed bool
+	// Limit is the most restrictive enabled limit in the check.
+	Limit Limit
+	// Remaining is the minimum number of the requests the buckets
+	// still allow after this request.
+	Remaining int
+	// RetryAfter is how long the client should wait before retrying
+	// a throttled request. It is zero if the request is allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long it takes to refill all the buckets.
+	ResetAfter time.Duration
+}
+
+// Err returns an *ExceededError if the request is throttled, or nil otherwise.
+func (r Result) Err() error {
+	if r.Allowed {
+		return nil
+	}
+
+	return &ExceededError{RetryAfter: r.RetryAfter}
+}
+
+// ExceededError is returned when a request exceeds the rate limit.
+type ExceededError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter)
+}
+
+// allowScript takes ARGV[1] tokens from each bucket in KEYS only if every
+// bucket has enough tokens, so a throttled request does not consume the others.
+//
+// The rest of ARGV is the burst and the interval in milliseconds of each
+// bucket. The buckets are hashes of the remaining tokens and the last refill
+// time, and expire once they are full again.
+//
+// It returns {allowed, remaining, retry after, reset after} in milliseconds.
+var allowScript = rueidis.NewLuaScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local cost = tonumber(ARGV[1])
+local allowed = 1
+local tokens = {}
+local retry = 0
+for i, key in ipairs(KEYS) do
+	local burst = tonumber(ARGV[i * 2])
+	local interval = tonumber(ARGV[i * 2 + 1])
+	local state = redis.call("HMGET", key, "tokens", "ts")
+	local count = tonumber(state[1]) or burst
+	local ts = tonumber(state[2]) or now
+	if now > ts then
+		count = math.min(burst, count + (now - ts) / interval)
+	end
+	tokens[i] = count
+	if count < cost then
+		allowed = 0
+		retry = math.max(retry, math.ceil((cost - count) * interval))
+	end
+end
+local remaining = -1
+local reset = 0
+for i, key in ipairs(KEYS) do
+	local burst = tonumber(ARGV[i * 2])
+	local interval = tonumber(ARGV[i * 2 + 1])
+	local count = tokens[i]
+	if allowed == 1 then
+		count = count - cost
+		local ttl = math.max(1, math.ceil((burst - count) * interval))
+		redis.call("HSET", key, "tokens", tostring(count), "ts", now)
+		redis.call("PEXPIRE", key, ttl)
+	end
+	if remaining < 0 or math.floor(count) < remaining then
+		remaining = math.floor(count)
+	end
+	reset = math.max(reset, math.ceil((burst - count) * interval))
+end
+return {allowed, remaining, retry, reset}
+`)
+
+// Limiter checks the requests against the token buckets in Redis.
+//
+// The buckets are shared by all the instances using the same Redis, and
+// are keyed by the name of the limiter, the subject (e.g. a user) and the
+// key of the bucket. The buckets of a subject are in the same hash slot,
+// so that they can be checked atomically.
+type Limiter struct {
+	client rueidis.Client
+	name   string
+}
+
+// NewLimiter creates a new Limiter named name.
+//
+// The name is used in the Redis keys and as the "limiter" label of the metrics.
+func NewLimiter(client rueidis.Client, name string) *Limiter {
+	return &Limiter{client: client, name: name}
+}
+
+// Name returns the name of the limiter.
+func (l *Limiter) Name() string {
+	return l.name
+}
+
+// Allow checks a request of the subject against the buckets, and takes a
+// token from each of them if the request is allowed. The buckets with a
+// disabled limit are ignored, and the request is allowed if there are none left.
+func (l *Limiter) Allow(ctx context.Context, subject string, buckets ...Bucket) (Result, error) {
+	return l.AllowN(ctx, subject, 1, buckets...)
+}
+
+// AllowN is like Allow, but the request takes n tokens from each bucket,
+// e.g. a batch of n items. A request taking more tokens than the burst of
+// a bucket is never allowed.
+func (l *Limiter) AllowN(ctx context.Context, subject string, n int, buckets ...Bucket) (Result, error) {
+	ctx, span := tracer.Start(ctx, "Allow",
+		trace.WithAttributes(
+			attribute.String("ratelimit.limiter", l.name),
+			attribute.String("ratelimit.subject", subject),
+			attribute.Int("ratelimit.cost", n),
+		))
+	defer span.End()
+
+	keys := make([]string, 0, len(buckets))
+	args := make([]string, 0, len(buckets)*2+1)
+	args = append(args, strconv.Itoa(n))
+	var limit Limit
+	for _, bucket := range buckets {
+		if !bucket.Limit.Enabled() {
+			continue
+		}
+
+		keys = append(keys, redisRateLimitPrefix+l.name+":{"+subject+"}:"+bucket.Key)
+		args = append(args,
+			strconv.Itoa(bucket.Limit.Burst),
+			strconv.FormatInt(max(bucket.Limit.Interval.Milliseconds(), 1), 10),
+		)
+		if !limit.Enabled() || bucket.Limit.Burst < limit.Burst {
+			limit = bucket.Limit
+		}
+	}
+	if len(keys) == 0 {
+		span.SetStatus(otelcodes.Ok, "No limits")
+		return Result{Allowed: true}, nil
+	}
+
+	reply, err := allowScript.Exec(ctx, l.client, keys, args).AsIntSlice()
+	if err != nil || len(reply) != 4 {
+		if err == nil {
+			err = fmt.Errorf("unexpected reply length %d", len(reply))
+		}
+
+		RequestsTotal.WithLabelValues(l.name, "error").Inc()
+		span.SetStatus(otelcodes.Error, "Failed to check the rate limit")
+		span.RecordError(err)
+		return Result{}, fmt.Errorf("check rate limit: %w", err)
+	}
+
+	result := Result{
+		Allowed:    reply[0] == 1,
+		Limit:      limit,
+		Remaining:  int(reply[1]),
+		RetryAfter: time.Duration(reply[2]) * time.Millisecond,
+		ResetAfter: time.Duration(reply[3]) * time.Millisecond,
+	}
+	span.SetAttributes(
+		attribute.Bool("ratelimit.allowed", result.Allowed),
+		attribute.Int("ratelimit.remaining", result.Remaining),
+	)
+
+	if !result.Allowed {
+		RequestsTotal.WithLabelValues(l.name, "throttled").Inc()
+		span.SetStatus(otelcodes.Ok, "Request throttled")
+		return result, nil
+	}
+
+	RequestsTotal.WithLabelValues(l.name, "allowed").Inc()
+	span.SetStatus(otelcodes.Ok, "Request allowed")
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/internal/ratelimit"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(t *testing.T) *ratelimit.Limiter {
+	t.Helper()
+
+	container := testhelper.NewRedisContainer(t)
+	redisClient := testhelper.NewRedisClient(t, container)
+
+	return ratelimit.NewLimiter(redisClient, "test")
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter := newTestLimiter(t)
+	ctx := context.Background()
+	bucket := ratelimit.Bucket{Key: "all", Limit: ratelimit.Limit{Burst: 3, Interval: time.Hour}}
+
+	for i := range 3 {
+		result, err := limiter.Allow(ctx, "user:1", bucket)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2-i, result.Remaining)
+		assert.Zero(t, result.RetryAfter)
+		require.NoError(t, result.Err())
+	}
+
+	result, err := limiter.Allow(ctx, "user:1", bucket)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+	assert.Greater(t, result.RetryAfter, 59*time.Minute)
+	assert.LessOrEqual(t, result.RetryAfter, time.Hour)
+	assert.Greater(t, result.ResetAfter, 2*time.Hour)
+
+	var exceeded *ratelimit.ExceededError
+	require.ErrorAs(t, result.Err(), &exceeded)
+	assert.Equal(t, result.RetryAfter, exceeded.RetryAfter)
+
+	// the subjects are independent
+	result, err = limiter.Allow(ctx, "user:2", bucket)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestLimiter_Allow_Refill(t *testing.T) {
+	limiter := newTestLimiter(t)
+	ctx := context.Background()
+	bucket := ratelimit.Bucket{Key: "all", Limit: ratelimit.Limit{Burst: 1, Interval: 200 * time.Millisecond}}
+
+	result, err := limiter.Allow(ctx, "user:1", bucket)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = limiter.Allow(ctx, "user:1", bucket)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	time.Sleep(result.RetryAfter + 50*time.Millisecond)
+
+	result, err = limiter.Allow(ctx, "user:1", bucket)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestLimiter_AllowN(t *testing.T) {
+	limiter := newTestLimiter(t)
+	ctx := context.Background()
+	bucket := ratelimit.Bucket{Key: "all", Limit: ratelimit.Limit{Burst: 5, Interval: time.Hour}}
+
+	result, err := limiter.AllowN(ctx, "user:1", 3, bucket)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 2, result.Remaining)
+
+	// the request is throttled as a whole without taking the remaining tokens
+	result, err = limiter.AllowN(ctx, "user:1", 3, bucket)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 2, result.Remaining)
+	assert.Greater(t, result.RetryAfter, 59*time.Minute)
+	assert.LessOrEqual(t, result.RetryAfter, time.Hour)
+
+	result, err = limiter.AllowN(ctx, "user:1", 2, bucket)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+}
+
+func TestLimiter_Allow_MultipleBuckets(t *testing.T) {
+	limiter := newTestLimiter(t)
+	ctx := context.Background()
+	global := ratelimit.Bucket{Key: "all", Limit: ratelimit.Limit{Burst: 3, Interval: time.Hour}}
+	question := func(id string) ratelimit.Bucket {
+		return ratelimit.Bucket{Key: "question:" + id, Limit: ratelimit.Limit{Burst: 1, Interval: time.Hour}}
+	}
+
+	result, err := limiter.Allow(ctx, "user:1", global, question("1"))
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	assert.Equal(t, ratelimit.Limit{Burst: 1, Interval: time.Hour}, result.Limit)
+
+	// the question bucket is empty, and the global bucket is not consumed
+	result, err = limiter.Allow(ctx, "user:1", global, question("1"))
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	result, err = limiter.Allow(ctx, "user:1", global, question("2"))
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	assert.Zero(t, result.Remaining)
+
+	result, err = limiter.Allow(ctx, "user:1", global, question("3"))
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	// the global bucket is empty now
+	result, err = limiter.Allow(ctx, "user:1", global, question("4"))
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+}
+
+func TestLimiter_Allow_Disabled(t *testing.T) {
+	limiter := newTestLimiter(t)
+	ctx := context.Background()
+
+	for range 10 {
+		result, err := limiter.Allow(ctx, "user:1",
+			ratelimit.Bucket{Key: "all", Limit: ratelimit.Limit{}},
+			ratelimit.Bucket{Key: "question:1", Limit: ratelimit.Limit{Burst: 0, Interval: time.Hour}},
+		)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+}
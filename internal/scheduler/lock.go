@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/rueidis"
+)
+
+// Locker guards a scheduled run so that it runs on only one replica.
+type Locker interface {
+	// TryLock acquires the lock of the key for ttl.
+	// It returns false if the lock is held by another replica.
+	TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+const redisLockPrefix = "scheduler:lock:"
+
+// RedisLocker is a Locker backed by Redis.
+type RedisLocker struct {
+	redis rueidis.Client
+}
+
+// NewRedisLocker creates a new RedisLocker.
+func NewRedisLocker(redis rueidis.Client) *RedisLocker {
+	return &RedisLocker{redis: redis}
+}
+
+func (l *RedisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	err := l.redis.Do(ctx,
+		l.redis.B().Set().Key(redisLockPrefix+key).Value("1").Nx().Px(ttl).Build(),
+	).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,37 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/internal/scheduler"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisLocker_TryLock(t *testing.T) {
+	container := testhelper.NewRedisContainer(t)
+	redisClient := testhelper.NewRedisClient(t, container)
+	ctx := context.Background()
+
+	locker := scheduler.NewRedisLocker(redisClient)
+
+	acquired, err := locker.TryLock(ctx, "job:1", 100*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	acquired, err = locker.TryLock(ctx, "job:1", 100*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	acquired, err = locker.TryLock(ctx, "job:2", 100*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// The lock expires by itself.
+	require.Eventually(t, func() bool {
+		acquired, err := locker.TryLock(ctx, "job:1", 100*time.Millisecond)
+		return err == nil && acquired
+	}, 2*time.Second, 50*time.Millisecond)
+}
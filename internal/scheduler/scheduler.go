@@ -0,0 +1,401 @@
+// Package scheduler runs the periodic background jobs.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.scheduler")
+
+// JobRunsTotal tracks the outcomes of the job runs.
+var JobRunsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_job_runs_total",
+		Help: "Total number of scheduled job runs by job and status",
+	},
+	[]string{"job", "status"},
+)
+
+// JobRunDuration tracks the duration of the job runs.
+var JobRunDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "dbplay_job_run_duration_seconds",
+		Help:    "Duration of the scheduled job runs in seconds",
+		Buckets: prometheus.ExponentialBuckets(0.1, 4, 8),
+	},
+	[]string{"job"},
+)
+
+// JobLastSuccess tracks the last time each job succeeded.
+var JobLastSuccess = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "dbplay_job_last_success_timestamp_seconds",
+		Help: "Unix timestamp of the last successful run of the scheduled job",
+	},
+	[]string{"job"},
+)
+
+const (
+	// DefaultJobTimeout is the default timeout of a job run.
+	DefaultJobTimeout = time.Hour
+
+	statusSkipped = "skipped"
+)
+
+// ErrUnknownJob is returned when running a job which is not registered.
+var ErrUnknownJob = errors.New("unknown job")
+
+// errInterrupted is recorded in the stale job runs.
+var errInterrupted = errors.New("interrupted: the instance stopped before the run finished")
+
+// Job is a periodic background job.
+type Job struct {
+	// Name identifies the job in the history, the metrics and the lock.
+	// It must be unique and should not be changed.
+	Name string
+	// Schedule is a standard cron expression (e.g. "0 3 * * *") or a
+	// descriptor (e.g. "@daily", "@every 1h"). The expression is evaluated
+	// in the local time zone, unless it is prefixed with "CRON_TZ=<zone>".
+	// The "@every" schedules are aligned to the multiples of the interval (see
+	// time.Time.Truncate), so that they are scheduled at the same time on every
+	// replica.
+	Schedule string
+	// Timeout is the timeout of a run. Defaults to DefaultJobTimeout.
+	Timeout time.Duration
+	// Run runs the job. It should return when the context is canceled.
+	Run func(ctx context.Context) error
+}
+
+type registeredJob struct {
+	Job
+
+	schedule cron.Schedule
+	running  atomic.Bool
+}
+
+// Scheduler runs the registered jobs on their schedules.
+//
+// Multiple schedulers (e.g. on different replicas) can run at the same time:
+// every scheduled run is guarded by a lock, so it runs on only one replica.
+type Scheduler struct {
+	entClient *ent.Client
+	locker    Locker
+	instance  string
+
+	jobs map[string]*registeredJob
+}
+
+type SchedulerOption func(*Scheduler)
+
+// WithInstance sets the instance name recorded in the job runs.
+// Defaults to the hostname.
+func WithInstance(instance string) SchedulerOption {
+	return func(s *Scheduler) {
+		s.instance = instance
+	}
+}
+
+// NewScheduler creates a new Scheduler.
+func NewScheduler(entClient *ent.Client, locker Locker, opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		entClient: entClient,
+		locker:    locker,
+		jobs:      make(map[string]*registeredJob),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		s.instance = hostname
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Register registers the jobs to the scheduler.
+func (s *Scheduler) Register(jobs ...Job) error {
+	for _, job := range jobs {
+		if job.Name == "" {
+			return errors.New("job name is required")
+		}
+		if job.Run == nil {
+			return fmt.Errorf("job %q: run function is required", job.Name)
+		}
+		if _, ok := s.jobs[job.Name]; ok {
+			return fmt.Errorf("job %q: already registered", job.Name)
+		}
+
+		schedule, err := cron.ParseStandard(job.Schedule)
+		if err != nil {
+			return fmt.Errorf("job %q: parse schedule: %w", job.Name, err)
+		}
+		if every, ok := schedule.(cron.ConstantDelaySchedule); ok {
+			schedule = alignedSchedule{interval: every.Delay}
+		}
+
+		if job.Timeout <= 0 {
+			job.Timeout = DefaultJobTimeout
+		}
+
+		s.jobs[job.Name] = &registeredJob{Job: job, schedule: schedule}
+	}
+
+	return nil
+}
+
+// Run runs the registered jobs on their schedules until the context is canceled.
+//
+// A run is skipped if the previous run of the same job is still running on this
+// replica. When the context is canceled, it waits for the running jobs to return.
+//
+// The stale runs are marked as failed before the jobs are scheduled (see FailStaleRuns).
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	if failed, err := s.FailStaleRuns(ctx); err != nil {
+		slog.Error("failed to mark the stale job runs as failed", "error", err)
+	} else if failed > 0 {
+		slog.Warn("marked the stale job runs as failed", "count", failed)
+	}
+
+	next := make(map[string]time.Time, len(s.jobs))
+	now := time.Now()
+	for name, job := range s.jobs {
+		next[name] = job.schedule.Next(now)
+	}
+
+	for {
+		var earliest time.Time
+		for _, t := range next {
+			if earliest.IsZero() || t.Before(earliest) {
+				earliest = t
+			}
+		}
+		if earliest.IsZero() {
+			<-ctx.Done()
+			return
+		}
+
+		timer := time.NewTimer(time.Until(earliest))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		for name, scheduledAt := range next {
+			if scheduledAt.After(now) {
+				continue
+			}
+
+			job := s.jobs[name]
+			next[name] = job.schedule.Next(now)
+
+			if !job.running.CompareAndSwap(false, true) {
+				slog.Warn("skipping the job run since the previous run is still running", "job", name)
+				JobRunsTotal.WithLabelValues(name, statusSkipped).Inc()
+				continue
+			}
+
+			wg.Go(func() {
+				defer job.running.Store(false)
+
+				if err := s.runJob(ctx, job, scheduledAt); err != nil {
+					slog.Error("job run failed", "job", name, "error", err)
+				}
+			})
+		}
+	}
+}
+
+// FailStaleRuns marks the job runs left running as failed, and returns
+// the number of them.
+//
+// A run is stale if it was started by this instance, which has just been
+// started, or if it has been running longer than the timeout of its job
+// (DefaultJobTimeout for the unregistered jobs), since the instance running
+// it must have stopped before recording its result.
+func (s *Scheduler) FailStaleRuns(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "FailStaleRuns",
+		trace.WithAttributes(
+			attribute.String("scheduler.instance", s.instance),
+		))
+	defer span.End()
+
+	span.AddEvent("database.job_run.query")
+	runs, err := s.entClient.JobRun.Query().
+		Where(jobrun.StatusEQ(jobrun.StatusRunning)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query running job runs")
+		span.RecordError(err)
+		return 0, fmt.Errorf("query running job runs: %w", err)
+	}
+
+	now := time.Now()
+	failed := 0
+	for _, run := range runs {
+		timeout := DefaultJobTimeout
+		if job, ok := s.jobs[run.JobName]; ok {
+			timeout = job.Timeout
+		}
+		if run.Instance != s.instance && now.Sub(run.StartedAt) <= timeout {
+			continue
+		}
+
+		span.AddEvent("database.job_run.update", trace.WithAttributes(attribute.Int("job_run.id", run.ID)))
+		err := s.entClient.JobRun.UpdateOne(run).
+			SetStatus(jobrun.StatusFailed).
+			SetFinishedAt(now).
+			SetError(errInterrupted.Error()).
+			Exec(ctx)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "Failed to update stale job run")
+			span.RecordError(err)
+			return failed, fmt.Errorf("update stale job run: %w", err)
+		}
+
+		JobRunsTotal.WithLabelValues(run.JobName, jobrun.StatusFailed.String()).Inc()
+		failed++
+	}
+
+	span.SetAttributes(attribute.Int("job_run.stale", failed))
+	span.SetStatus(otelcodes.Ok, "Stale job runs failed")
+	return failed, nil
+}
+
+// RunJob runs the job immediately, as if it was scheduled at scheduledAt.
+//
+// The run is skipped (returning nil) if another replica has taken the run
+// scheduled at the same time.
+func (s *Scheduler) RunJob(ctx context.Context, name string, scheduledAt time.Time) error {
+	job, ok := s.jobs[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownJob, name)
+	}
+
+	return s.runJob(ctx, job, scheduledAt)
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job *registeredJob, scheduledAt time.Time) error {
+	ctx, span := tracer.Start(ctx, "RunJob",
+		trace.WithNewRoot(),
+		trace.WithAttributes(
+			attribute.String("job.name", job.Name),
+			attribute.String("job.scheduled_at", scheduledAt.Format(time.RFC3339)),
+		))
+	defer span.End()
+
+	// The lock is per scheduled run and expires by itself, so the replicas whose
+	// clocks are slightly behind do not run the same scheduled run again.
+	span.AddEvent("scheduler.lock.acquire")
+	lockKey := fmt.Sprintf("%s:%d", job.Name, scheduledAt.Unix())
+	acquired, err := s.locker.TryLock(ctx, lockKey, job.Timeout)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to acquire lock")
+		span.RecordError(err)
+		return fmt.Errorf("acquire job lock: %w", err)
+	}
+	if !acquired {
+		JobRunsTotal.WithLabelValues(job.Name, statusSkipped).Inc()
+		span.SetStatus(otelcodes.Ok, "Job run taken by another instance")
+		return nil
+	}
+
+	span.AddEvent("database.job_run.create")
+	run, err := s.entClient.JobRun.Create().
+		SetJobName(job.Name).
+		SetStatus(jobrun.StatusRunning).
+		SetInstance(s.instance).
+		SetScheduledAt(scheduledAt).
+		Save(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to create job run")
+		span.RecordError(err)
+		return fmt.Errorf("create job run: %w", err)
+	}
+
+	startedAt := time.Now()
+	runErr := s.execute(ctx, job)
+	duration := time.Since(startedAt)
+
+	status := jobrun.StatusSucceeded
+	if runErr != nil {
+		status = jobrun.StatusFailed
+	}
+
+	JobRunsTotal.WithLabelValues(job.Name, status.String()).Inc()
+	JobRunDuration.WithLabelValues(job.Name).Observe(duration.Seconds())
+	if runErr == nil {
+		JobLastSuccess.WithLabelValues(job.Name).SetToCurrentTime()
+	}
+
+	// The run context may have been canceled, but the history should be updated anyway.
+	span.AddEvent("database.job_run.update")
+	update := s.entClient.JobRun.UpdateOne(run).
+		SetStatus(status).
+		SetFinishedAt(startedAt.Add(duration)).
+		SetDurationMs(int(duration.Milliseconds()))
+	if runErr != nil {
+		update.SetError(runErr.Error())
+	}
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to update job run")
+		span.RecordError(err)
+		return errors.Join(runErr, fmt.Errorf("update job run: %w", err))
+	}
+
+	if runErr != nil {
+		span.SetStatus(otelcodes.Error, "Job run failed")
+		span.RecordError(runErr)
+		return runErr
+	}
+
+	span.SetStatus(otelcodes.Ok, "Job run succeeded")
+	return nil
+}
+
+// alignedSchedule runs at the multiples of the interval, which do not
+// depend on when the replica was started, unlike cron.ConstantDelaySchedule.
+type alignedSchedule struct {
+	interval time.Duration
+}
+
+func (a alignedSchedule) Next(t time.Time) time.Time {
+	return t.Truncate(a.interval).Add(a.interval)
+}
+
+// execute runs the job with its timeout, converting panics into errors.
+func (s *Scheduler) execute(ctx context.Context, job *registeredJob) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, job.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.Run(ctx)
+}
@@ -0,0 +1,281 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent/jobrun"
+	"github.com/database-playground/backend-v2/internal/scheduler"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// memoryLocker is an in-memory Locker shared by the schedulers in a test.
+type memoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]struct{}
+}
+
+func newMemoryLocker() *memoryLocker {
+	return &memoryLocker{locks: make(map[string]struct{})}
+}
+
+func (l *memoryLocker) TryLock(_ context.Context, key string, _ time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.locks[key]; ok {
+		return false, nil
+	}
+
+	l.locks[key] = struct{}{}
+	return true, nil
+}
+
+func TestRegister_Invalid(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	s := scheduler.NewScheduler(client, newMemoryLocker())
+
+	noop := func(context.Context) error { return nil }
+
+	require.Error(t, s.Register(scheduler.Job{Schedule: "@daily", Run: noop}))
+	require.Error(t, s.Register(scheduler.Job{Name: "job", Schedule: "@daily"}))
+	require.Error(t, s.Register(scheduler.Job{Name: "job", Schedule: "invalid", Run: noop}))
+
+	require.NoError(t, s.Register(scheduler.Job{Name: "job", Schedule: "0 3 * * *", Run: noop}))
+	require.Error(t, s.Register(scheduler.Job{Name: "job", Schedule: "@daily", Run: noop}))
+}
+
+func TestRunJob_RecordsHistory(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+
+	s := scheduler.NewScheduler(client, newMemoryLocker(), scheduler.WithInstance("test"))
+	require.NoError(t, s.Register(
+		scheduler.Job{
+			Name:     "succeeding",
+			Schedule: "@daily",
+			Run:      func(context.Context) error { return nil },
+		},
+		scheduler.Job{
+			Name:     "failing",
+			Schedule: "@daily",
+			Run:      func(context.Context) error { return errors.New("boom") },
+		},
+		scheduler.Job{
+			Name:     "panicking",
+			Schedule: "@daily",
+			Run:      func(context.Context) error { panic("boom") },
+		},
+	))
+
+	scheduledAt := time.Now().Truncate(time.Second)
+	require.NoError(t, s.RunJob(ctx, "succeeding", scheduledAt))
+	require.Error(t, s.RunJob(ctx, "failing", scheduledAt))
+	require.Error(t, s.RunJob(ctx, "panicking", scheduledAt))
+
+	succeeded, err := client.JobRun.Query().Where(jobrun.JobName("succeeding")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, jobrun.StatusSucceeded, succeeded.Status)
+	require.Equal(t, "test", succeeded.Instance)
+	require.True(t, succeeded.ScheduledAt.Equal(scheduledAt))
+	require.NotNil(t, succeeded.FinishedAt)
+	require.NotNil(t, succeeded.DurationMs)
+	require.Nil(t, succeeded.Error)
+
+	failed, err := client.JobRun.Query().Where(jobrun.JobName("failing")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, jobrun.StatusFailed, failed.Status)
+	require.NotNil(t, failed.Error)
+	require.Equal(t, "boom", *failed.Error)
+
+	panicked, err := client.JobRun.Query().Where(jobrun.JobName("panicking")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, jobrun.StatusFailed, panicked.Status)
+	require.NotNil(t, panicked.Error)
+	require.Contains(t, *panicked.Error, "panicked")
+
+	require.ErrorIs(t, s.RunJob(ctx, "unknown", scheduledAt), scheduler.ErrUnknownJob)
+}
+
+func TestRunJob_LockedByAnotherInstance(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+	locker := newMemoryLocker()
+
+	var runs atomic.Int32
+	job := scheduler.Job{
+		Name:     "job",
+		Schedule: "@daily",
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	}
+
+	first := scheduler.NewScheduler(client, locker, scheduler.WithInstance("first"))
+	second := scheduler.NewScheduler(client, locker, scheduler.WithInstance("second"))
+	require.NoError(t, first.Register(job))
+	require.NoError(t, second.Register(job))
+
+	scheduledAt := time.Now().Truncate(time.Second)
+	require.NoError(t, first.RunJob(ctx, "job", scheduledAt))
+	require.NoError(t, second.RunJob(ctx, "job", scheduledAt))
+	require.EqualValues(t, 1, runs.Load())
+
+	count, err := client.JobRun.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// The next scheduled run can be taken again.
+	require.NoError(t, second.RunJob(ctx, "job", scheduledAt.Add(time.Hour)))
+	require.EqualValues(t, 2, runs.Load())
+}
+
+func TestRunJob_Timeout(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+
+	s := scheduler.NewScheduler(client, newMemoryLocker())
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:     "slow",
+		Schedule: "@daily",
+		Timeout:  10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}))
+
+	err := s.RunJob(ctx, "slow", time.Now())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRun_RunsOnSchedule(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+
+	ran := make(chan struct{}, 1)
+	s := scheduler.NewScheduler(client, newMemoryLocker())
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:     "every-second",
+		Schedule: "@every 1s",
+		Run: func(context.Context) error {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job was not run on schedule")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestRun_EveryScheduleAlignedAcrossInstances(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	locker := newMemoryLocker()
+
+	job := scheduler.Job{
+		Name:     "every-two-seconds",
+		Schedule: "@every 2s",
+		Run:      func(context.Context) error { return nil },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i, instance := range []string{"first", "second"} {
+		s := scheduler.NewScheduler(client, locker, scheduler.WithInstance(instance))
+		require.NoError(t, s.Register(job))
+
+		wg.Go(func() {
+			// the replicas are started at different times
+			time.Sleep(time.Duration(i) * 1100 * time.Millisecond)
+			s.Run(ctx)
+		})
+	}
+
+	time.Sleep(5 * time.Second)
+	cancel()
+	wg.Wait()
+
+	runs, err := client.JobRun.Query().Order(jobrun.ByScheduledAt()).All(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, runs)
+	for i, run := range runs {
+		require.Zero(t, run.ScheduledAt.UnixMilli()%2000, "scheduled at %s", run.ScheduledAt)
+		if i > 0 {
+			require.GreaterOrEqual(t, run.ScheduledAt.Sub(runs[i-1].ScheduledAt), 2*time.Second)
+		}
+	}
+}
+
+func TestFailStaleRuns(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	s := scheduler.NewScheduler(client, newMemoryLocker(), scheduler.WithInstance("restarted"))
+	require.NoError(t, s.Register(scheduler.Job{
+		Name:     "job",
+		Schedule: "@daily",
+		Timeout:  time.Minute,
+		Run:      func(context.Context) error { return nil },
+	}))
+
+	createRun := func(instance string, startedAt time.Time) int {
+		run, err := client.JobRun.Create().
+			SetJobName("job").
+			SetStatus(jobrun.StatusRunning).
+			SetInstance(instance).
+			SetScheduledAt(startedAt).
+			SetStartedAt(startedAt).
+			Save(ctx)
+		require.NoError(t, err)
+		return run.ID
+	}
+
+	// left by this instance before it was restarted
+	ownRun := createRun("restarted", now)
+	// running longer than the timeout on another instance
+	timedOutRun := createRun("other", now.Add(-2*time.Minute))
+	// still running on another instance
+	activeRun := createRun("other", now)
+
+	failed, err := s.FailStaleRuns(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, failed)
+
+	for id, status := range map[int]jobrun.Status{
+		ownRun:      jobrun.StatusFailed,
+		timedOutRun: jobrun.StatusFailed,
+		activeRun:   jobrun.StatusRunning,
+	} {
+		run, err := client.JobRun.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, status, run.Status)
+		if status == jobrun.StatusFailed {
+			require.NotNil(t, run.FinishedAt)
+			require.NotNil(t, run.Error)
+		}
+	}
+}
@@ -2,11 +2,15 @@ package setup
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/cheatrecord"
 	"github.com/database-playground/backend-v2/ent/group"
 	"github.com/database-playground/backend-v2/ent/scopeset"
+	"github.com/database-playground/backend-v2/ent/user"
+	"github.com/database-playground/backend-v2/internal/pseudonym"
 	"github.com/database-playground/backend-v2/internal/useraccount"
 )
 
@@ -22,7 +26,32 @@ type SetupResult struct {
 
 // Migrate migrates the database to the latest version.
 func Migrate(ctx context.Context, entClient *ent.Client) error {
-	return entClient.Schema.Create(ctx)
+	if err := entClient.Schema.Create(ctx); err != nil {
+		return err
+	}
+
+	// the cheat records resolved before the review states are introduced are dismissed.
+	if _, err := entClient.CheatRecord.Update().
+		Where(cheatrecord.StateEQ(cheatrecord.StateOpen), cheatrecord.ResolvedAtNotNil()).
+		SetState(cheatrecord.StateDismissed).
+		Save(ctx); err != nil {
+		return fmt.Errorf("migrate resolved cheat records: %w", err)
+	}
+
+	// the users created before the pseudonyms are introduced get one.
+	userIDs, err := entClient.User.Query().
+		Where(user.Or(user.PseudonymIsNil(), user.PseudonymEQ(""))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("query users without pseudonyms: %w", err)
+	}
+	for _, id := range userIDs {
+		if err := entClient.User.UpdateOneID(id).SetPseudonym(pseudonym.Generate()).Exec(ctx); err != nil {
+			return fmt.Errorf("migrate user pseudonyms: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // Setup setups the database playground instance.
@@ -0,0 +1,185 @@
+package sqlnorm
+
+import "strings"
+
+// Indent is the indentation of the formatted SQL.
+const Indent = "  "
+
+// clauseKeywords start a new line in the formatted SQL.
+var clauseKeywords = map[string]struct{}{
+	"SELECT": {}, "FROM": {}, "WHERE": {}, "GROUP": {}, "HAVING": {}, "ORDER": {},
+	"LIMIT": {}, "UNION": {}, "INTERSECT": {}, "EXCEPT": {}, "WITH": {}, "VALUES": {},
+	"SET": {}, "RETURNING": {}, "WINDOW": {}, "INSERT": {}, "UPDATE": {}, "DELETE": {},
+}
+
+// joinKeywords are the keywords of the join operators.
+var joinKeywords = map[string]struct{}{
+	"NATURAL": {}, "LEFT": {}, "RIGHT": {}, "FULL": {}, "INNER": {}, "OUTER": {}, "CROSS": {}, "JOIN": {},
+}
+
+// Format pretty-prints the SQL for displaying: the keywords are uppercased,
+// each clause starts a new line, the items of SELECT and SET and the
+// conditions joined by AND and OR are indented in their own lines, and the
+// subqueries are indented. The identifiers, literals and comments are kept.
+func Format(sql string) string {
+	f := &formatter{stack: []formatFrame{{}}, lineStart: true}
+
+	tokens := Tokenize(sql)
+	for i, t := range tokens {
+		if t.Kind == TokenKeyword {
+			t.Text = strings.ToUpper(t.Text)
+		}
+		f.token(t, tokens[i+1:])
+	}
+
+	return strings.TrimRight(f.b.String(), " \n")
+}
+
+type formatFrame struct {
+	// subquery is true if the parentheses enclose a subquery.
+	subquery bool
+	// indent is the indentation of the clauses in this level.
+	indent int
+	// clause is the current clause keyword in this level of parentheses.
+	clause string
+	// between is true after BETWEEN and before its AND.
+	between bool
+}
+
+type formatter struct {
+	b         strings.Builder
+	stack     []formatFrame
+	lineStart bool
+	prev      *Token
+}
+
+func (f *formatter) top() *formatFrame {
+	return &f.stack[len(f.stack)-1]
+}
+
+// statement returns whether the current level is a statement or a subquery,
+// where the clauses start new lines.
+func (f *formatter) statement() bool {
+	return len(f.stack) == 1 || f.top().subquery
+}
+
+// newline starts a new line with the indentation, or only changes the
+// indentation if the current line is empty.
+func (f *formatter) newline(indent int) {
+	if f.b.Len() == 0 {
+		return
+	}
+
+	trimmed := strings.TrimRight(f.b.String(), " ")
+	f.b.Reset()
+	f.b.WriteString(trimmed)
+	if !f.lineStart {
+		f.b.WriteByte('\n')
+	}
+	f.b.WriteString(strings.Repeat(Indent, indent))
+	f.lineStart = true
+}
+
+func (f *formatter) write(t Token) {
+	if !f.lineStart && f.prev != nil && needsSpace(*f.prev, t) {
+		f.b.WriteByte(' ')
+	}
+	f.b.WriteString(t.Text)
+	f.lineStart = false
+	f.prev = &t
+}
+
+func (f *formatter) token(t Token, next []Token) {
+	top := f.top()
+
+	switch {
+	case t.Kind == TokenComment:
+		f.write(t)
+		if strings.HasPrefix(t.Text, "--") {
+			f.newline(top.indent)
+		}
+
+	case t.Is("("):
+		f.write(t)
+		frame := formatFrame{indent: top.indent}
+		if n, ok := firstNonComment(next); ok && (n.Is("SELECT") || n.Is("WITH") || n.Is("VALUES")) {
+			frame.subquery = true
+			frame.indent = top.indent + 1
+			f.newline(frame.indent)
+		}
+		f.stack = append(f.stack, frame)
+
+	case t.Is(")"):
+		if len(f.stack) > 1 {
+			f.stack = f.stack[:len(f.stack)-1]
+			if top.subquery {
+				f.newline(f.top().indent)
+			}
+		}
+		f.write(t)
+
+	case t.Is(","):
+		f.write(t)
+		if f.statement() && (top.clause == "SELECT" || top.clause == "SET") {
+			f.newline(top.indent + 1)
+		}
+
+	case t.Is(";"):
+		f.write(t)
+		f.stack = []formatFrame{{}}
+		if len(next) > 0 {
+			f.newline(0)
+			f.b.WriteByte('\n')
+		}
+
+	case t.Kind == TokenKeyword:
+		keyword := t.Text
+		_, isClause := clauseKeywords[keyword]
+		_, isJoin := joinKeywords[keyword]
+
+		switch {
+		case isClause:
+			if f.statement() {
+				f.newline(top.indent)
+			}
+			top.clause = keyword
+		case isJoin:
+			// newline before the first keyword of the join operator
+			if f.statement() && (f.prev == nil || !f.prev.joinKeyword()) {
+				f.newline(top.indent)
+			}
+			top.clause = "FROM"
+		case keyword == "ON" || keyword == "USING":
+			top.clause = keyword
+		case keyword == "BETWEEN":
+			top.between = true
+		case keyword == "AND" && top.between:
+			top.between = false
+		case keyword == "AND" || keyword == "OR":
+			if f.statement() && (top.clause == "WHERE" || top.clause == "HAVING" || top.clause == "ON") {
+				f.newline(top.indent + 1)
+			}
+		}
+		f.write(t)
+
+	default:
+		f.write(t)
+	}
+}
+
+func (t Token) joinKeyword() bool {
+	if t.Kind != TokenKeyword {
+		return false
+	}
+	_, ok := joinKeywords[strings.ToUpper(t.Text)]
+	return ok
+}
+
+func firstNonComment(tokens []Token) (Token, bool) {
+	for _, t := range tokens {
+		if t.Kind != TokenComment {
+			return t, true
+		}
+	}
+	return Token{}, false
+}
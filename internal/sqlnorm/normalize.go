@@ -0,0 +1,208 @@
+package sqlnorm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// Placeholder replaces the literals and the parameters in the normalized SQL.
+const Placeholder = "?"
+
+// AliasPrefix is the prefix of the renamed aliases in the normalized SQL.
+// The aliases are renamed to a1, a2, … in the order they are defined.
+const AliasPrefix = "a"
+
+// Normalize returns the canonical form of the SQL, so that the SQL differing
+// only in the following aspects have the same normalized form:
+//
+//   - the comments and whitespace;
+//   - the case of the keywords (uppercased) and the identifiers (lowercased);
+//   - the quotes of the identifiers, if they are not needed;
+//   - the names of the table and column aliases, and whether AS is written;
+//   - the values of the literals and the parameters;
+//   - the optional INNER and OUTER keywords of the joins;
+//   - == and != (written as = and <>);
+//   - the trailing semicolons.
+func Normalize(sql string) string {
+	tokens := make([]Token, 0)
+	for _, t := range Tokenize(sql) {
+		switch {
+		case t.Kind == TokenComment:
+			continue
+		case t.Is("INNER") || t.Is("OUTER"):
+			continue
+		case t.Kind == TokenQuotedIdentifier:
+			t = unquote(t)
+		}
+		tokens = append(tokens, t)
+	}
+	for len(tokens) > 0 && tokens[len(tokens)-1].Is(";") {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	aliases := findAliases(tokens)
+	names := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		if _, ok := names[alias.name]; !ok {
+			names[alias.name] = AliasPrefix + strconv.Itoa(len(names)+1)
+		}
+	}
+	implicit := make(map[int]struct{}, len(aliases))
+	for _, alias := range aliases {
+		if alias.implicit {
+			implicit[alias.index] = struct{}{}
+		}
+	}
+
+	var b strings.Builder
+	var prev *Token
+	for i, t := range tokens {
+		switch {
+		case t.Kind == TokenKeyword:
+			t.Text = strings.ToUpper(t.Text)
+		case t.IsIdentifier():
+			t.Text = strings.ToLower(t.Text)
+			if name, ok := names[t.Text]; ok && (prev == nil || !prev.Is(".")) {
+				t.Text = name
+			}
+		case t.IsLiteral() || t.Kind == TokenParameter:
+			t.Text = Placeholder
+		case t.Text == "==":
+			t.Text = "="
+		case t.Text == "!=":
+			t.Text = "<>"
+		}
+
+		if _, ok := implicit[i]; ok {
+			b.WriteString(" AS")
+			prev = &Token{TokenKeyword, "AS"}
+		}
+		if prev != nil && needsSpace(*prev, t) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.Text)
+		prev = &t
+	}
+
+	return b.String()
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the normalized SQL.
+func Hash(sql string) string {
+	sum := sha256.Sum256([]byte(Normalize(sql)))
+	return hex.EncodeToString(sum[:])
+}
+
+// unquote removes the quotes of the identifier if they are not needed,
+// or quotes it with double quotes otherwise.
+func unquote(t Token) Token {
+	text := t.Text
+	if len(text) < 2 {
+		return t
+	}
+
+	var name string
+	switch text[0] {
+	case '[':
+		name = strings.TrimSuffix(text[1:], "]")
+	default:
+		quote := text[:1]
+		name = strings.ReplaceAll(strings.TrimSuffix(text[1:], quote), quote+quote, quote)
+	}
+
+	if name != "" && identifierEnd(name) == len(name) && !isDigit(rune(name[0])) && !IsKeyword(name) {
+		return Token{TokenIdentifier, name}
+	}
+	return Token{TokenQuotedIdentifier, `"` + strings.ReplaceAll(strings.ToLower(name), `"`, `""`) + `"`}
+}
+
+// alias is the definition of a table or column alias.
+type alias struct {
+	// index is the index of the alias token.
+	index int
+	// name is the lowercased name of the alias.
+	name string
+	// implicit is true if the alias is defined without AS.
+	implicit bool
+}
+
+// findAliases finds the table and column aliases defined in the tokens.
+func findAliases(tokens []Token) []alias {
+	type frame struct {
+		// clause is the current clause keyword in this level of parentheses.
+		clause string
+		// cast is true if the parentheses are the arguments of CAST.
+		cast bool
+	}
+
+	stack := []frame{{}}
+	var aliases []alias
+
+	for i, t := range tokens {
+		top := &stack[len(stack)-1]
+
+		switch {
+		case t.Is("("):
+			stack = append(stack, frame{cast: i > 0 && tokens[i-1].Is("CAST")})
+			continue
+		case t.Is(")"):
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		case t.Kind == TokenKeyword:
+			switch keyword := strings.ToUpper(t.Text); keyword {
+			case "JOIN":
+				top.clause = "FROM"
+			case "SELECT", "FROM", "WHERE", "GROUP", "HAVING", "ORDER", "LIMIT", "ON", "USING",
+				"UNION", "INTERSECT", "EXCEPT", "SET", "VALUES", "WINDOW", "RETURNING":
+				top.clause = keyword
+			case "AS":
+				if !top.cast && i+1 < len(tokens) && tokens[i+1].IsIdentifier() {
+					aliases = append(aliases, alias{index: i + 1, name: strings.ToLower(tokens[i+1].Text)})
+				}
+			}
+			continue
+		}
+
+		if !t.IsIdentifier() || i == 0 {
+			continue
+		}
+		prev := tokens[i-1]
+
+		switch top.clause {
+		case "FROM":
+			// FROM users u, FROM (SELECT …) t
+			if prev.IsIdentifier() || prev.Is(")") {
+				aliases = append(aliases, alias{index: i, name: strings.ToLower(t.Text), implicit: true})
+			}
+		case "SELECT":
+			// SELECT name n, COUNT(*) c FROM …
+			endsExpression := prev.IsIdentifier() || prev.IsLiteral() || prev.Kind == TokenParameter ||
+				prev.Is(")") || prev.Is("END") || prev.Is("NULL")
+			endsItem := i+1 == len(tokens) || tokens[i+1].Is(",") || tokens[i+1].Is("FROM") ||
+				tokens[i+1].Is(")") || tokens[i+1].Is(";")
+			if endsExpression && endsItem {
+				aliases = append(aliases, alias{index: i, name: strings.ToLower(t.Text), implicit: true})
+			}
+		}
+	}
+
+	return aliases
+}
+
+// needsSpace returns whether a space is needed between the two tokens.
+func needsSpace(prev, cur Token) bool {
+	switch {
+	case cur.Is(",") || cur.Is(")") || cur.Is(".") || cur.Is(";"):
+		return false
+	case prev.Is("(") || prev.Is("."):
+		return false
+	case cur.Is("(") && (prev.IsIdentifier() || prev.Is("CAST") || prev.Is("REPLACE") || prev.Is("RAISE")):
+		// function calls
+		return false
+	}
+	return true
+}
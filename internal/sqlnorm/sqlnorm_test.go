@@ -0,0 +1,150 @@
+package sqlnorm_test
+
+import (
+	"testing"
+
+	"github.com/database-playground/backend-v2/internal/sqlnorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := sqlnorm.Tokenize("SELECT \"a\"\"b\", x'2A', 'it''s', 1.5e3, ?2, :name -- comment\nFROM [t] WHERE a <> b /* block */")
+
+	expected := []sqlnorm.Token{
+		{Kind: sqlnorm.TokenKeyword, Text: "SELECT"},
+		{Kind: sqlnorm.TokenQuotedIdentifier, Text: `"a""b"`},
+		{Kind: sqlnorm.TokenPunctuation, Text: ","},
+		{Kind: sqlnorm.TokenBlob, Text: "x'2A'"},
+		{Kind: sqlnorm.TokenPunctuation, Text: ","},
+		{Kind: sqlnorm.TokenString, Text: "'it''s'"},
+		{Kind: sqlnorm.TokenPunctuation, Text: ","},
+		{Kind: sqlnorm.TokenNumber, Text: "1.5e3"},
+		{Kind: sqlnorm.TokenPunctuation, Text: ","},
+		{Kind: sqlnorm.TokenParameter, Text: "?2"},
+		{Kind: sqlnorm.TokenPunctuation, Text: ","},
+		{Kind: sqlnorm.TokenParameter, Text: ":name"},
+		{Kind: sqlnorm.TokenComment, Text: "-- comment"},
+		{Kind: sqlnorm.TokenKeyword, Text: "FROM"},
+		{Kind: sqlnorm.TokenQuotedIdentifier, Text: "[t]"},
+		{Kind: sqlnorm.TokenKeyword, Text: "WHERE"},
+		{Kind: sqlnorm.TokenIdentifier, Text: "a"},
+		{Kind: sqlnorm.TokenOperator, Text: "<>"},
+		{Kind: sqlnorm.TokenIdentifier, Text: "b"},
+		{Kind: sqlnorm.TokenComment, Text: "/* block */"},
+	}
+	assert.Equal(t, expected, tokens)
+}
+
+func TestTokenize_Unterminated(t *testing.T) {
+	tokens := sqlnorm.Tokenize("SELECT 'abc")
+
+	assert.Equal(t, []sqlnorm.Token{
+		{Kind: sqlnorm.TokenKeyword, Text: "SELECT"},
+		{Kind: sqlnorm.TokenString, Text: "'abc"},
+	}, tokens)
+}
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sql      string
+		expected string
+	}{
+		{
+			name:     "case and whitespace",
+			sql:      "select   Name\n\tFROM Users  ;",
+			expected: "SELECT name FROM users",
+		},
+		{
+			name:     "comments",
+			sql:      "SELECT name -- the name\nFROM users /* all users */",
+			expected: "SELECT name FROM users",
+		},
+		{
+			name:     "literals and parameters",
+			sql:      "SELECT * FROM users WHERE name = 'Alice' AND age > 18 AND id = ?1",
+			expected: "SELECT * FROM users WHERE name = ? AND age > ? AND id = ?",
+		},
+		{
+			name:     "quoted identifiers",
+			sql:      "SELECT \"Name\", `email`, [user id] FROM \"users\"",
+			expected: `SELECT name, email, "user id" FROM users`,
+		},
+		{
+			name:     "table aliases",
+			sql:      "SELECT u.name FROM users u JOIN orders AS o ON o.user_id = u.id",
+			expected: "SELECT a1.name FROM users AS a1 JOIN orders AS a2 ON a2.user_id = a1.id",
+		},
+		{
+			name:     "column aliases",
+			sql:      "SELECT COUNT(*) total, name FROM users GROUP BY name ORDER BY total",
+			expected: "SELECT count(*) AS a1, name FROM users GROUP BY name ORDER BY a1",
+		},
+		{
+			name:     "cast is not an alias",
+			sql:      "SELECT CAST(age AS TEXT) FROM users",
+			expected: "SELECT CAST(age AS text) FROM users",
+		},
+		{
+			name:     "joins and operators",
+			sql:      "SELECT * FROM a LEFT OUTER JOIN b ON a.id == b.id INNER JOIN c ON c.id != a.id",
+			expected: "SELECT * FROM a LEFT JOIN b ON a.id = b.id JOIN c ON c.id <> a.id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sqlnorm.Normalize(tc.sql))
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	a := sqlnorm.Hash("SELECT u.name FROM users u WHERE u.id = 1")
+	b := sqlnorm.Hash("select x.NAME\nfrom users as x\nwhere x.id = 2;")
+	c := sqlnorm.Hash("SELECT u.email FROM users u WHERE u.id = 1")
+
+	assert.Len(t, a, 64)
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sql      string
+		expected string
+	}{
+		{
+			name:     "clauses",
+			sql:      "select name, email from users where age > 18 and active = 1 order by name limit 10;",
+			expected: "SELECT name,\n  email\nFROM users\nWHERE age > 18\n  AND active = 1\nORDER BY name\nLIMIT 10;",
+		},
+		{
+			name:     "joins",
+			sql:      "select u.name from users u left outer join orders o on o.user_id = u.id",
+			expected: "SELECT u.name\nFROM users u\nLEFT OUTER JOIN orders o ON o.user_id = u.id",
+		},
+		{
+			name:     "between",
+			sql:      "select * from t where a between 1 and 2 and b = 3",
+			expected: "SELECT *\nFROM t\nWHERE a BETWEEN 1 AND 2\n  AND b = 3",
+		},
+		{
+			name:     "subqueries",
+			sql:      "select name from users where id in (select user_id from orders) and count(*) > 0",
+			expected: "SELECT name\nFROM users\nWHERE id IN (\n  SELECT user_id\n  FROM orders\n)\n  AND count(*) > 0",
+		},
+		{
+			name:     "comments and statements",
+			sql:      "-- first\nselect 1; select 'A'",
+			expected: "-- first\nSELECT 1;\n\nSELECT 'A'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sqlnorm.Format(tc.sql))
+		})
+	}
+}
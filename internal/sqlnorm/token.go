@@ -0,0 +1,234 @@
+package sqlnorm
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the kind of a token.
+type TokenKind int
+
+const (
+	// TokenKeyword is a SQLite keyword, e.g. SELECT.
+	TokenKeyword TokenKind = iota
+	// TokenIdentifier is an unquoted identifier, e.g. users.
+	TokenIdentifier
+	// TokenQuotedIdentifier is a quoted identifier, e.g. "users", `users` or [users].
+	TokenQuotedIdentifier
+	// TokenString is a string literal, e.g. 'Alice'.
+	TokenString
+	// TokenNumber is a numeric literal, e.g. 42, 3.14 or 0x2A.
+	TokenNumber
+	// TokenBlob is a blob literal, e.g. X'2A'.
+	TokenBlob
+	// TokenParameter is a bound parameter, e.g. ?, ?1, :name, @name or $name.
+	TokenParameter
+	// TokenOperator is an operator, e.g. =, <> or ||.
+	TokenOperator
+	// TokenPunctuation is one of ( ) , ; and .
+	TokenPunctuation
+	// TokenComment is a line or block comment.
+	TokenComment
+)
+
+// Token is a token of SQL.
+type Token struct {
+	Kind TokenKind
+	// Text is the original text of the token.
+	Text string
+}
+
+// Is returns whether the token is the keyword or punctuation, ignoring the case.
+func (t Token) Is(text string) bool {
+	return (t.Kind == TokenKeyword || t.Kind == TokenPunctuation) && strings.EqualFold(t.Text, text)
+}
+
+// IsLiteral returns whether the token is a string, numeric or blob literal.
+func (t Token) IsLiteral() bool {
+	return t.Kind == TokenString || t.Kind == TokenNumber || t.Kind == TokenBlob
+}
+
+// IsIdentifier returns whether the token is a quoted or unquoted identifier.
+func (t Token) IsIdentifier() bool {
+	return t.Kind == TokenIdentifier || t.Kind == TokenQuotedIdentifier
+}
+
+var multiCharOperators = []string{"->>", "->", "||", "<=", ">=", "<>", "!=", "==", "<<", ">>"}
+
+// Tokenize splits the SQLite-flavoured SQL into tokens, skipping the whitespace.
+//
+// Tokenize never fails: an unterminated string, identifier or comment
+// extends to the end of the SQL, and an unknown character becomes an operator.
+func Tokenize(sql string) []Token {
+	var tokens []Token
+
+	for i := 0; i < len(sql); {
+		r, size := utf8.DecodeRuneInString(sql[i:])
+		rest := sql[i:]
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			tokens = append(tokens, Token{TokenComment, strings.TrimRight(rest[:end], "\r")})
+			i += end
+
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += 4
+			}
+			tokens = append(tokens, Token{TokenComment, rest[:end]})
+			i += end
+
+		case r == '\'':
+			end := quotedEnd(rest, '\'')
+			tokens = append(tokens, Token{TokenString, rest[:end]})
+			i += end
+
+		case r == '"' || r == '`':
+			end := quotedEnd(rest, byte(r))
+			tokens = append(tokens, Token{TokenQuotedIdentifier, rest[:end]})
+			i += end
+
+		case r == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end++
+			}
+			tokens = append(tokens, Token{TokenQuotedIdentifier, rest[:end]})
+			i += end
+
+		case (r == 'x' || r == 'X') && len(rest) > 1 && rest[1] == '\'':
+			end := 1 + quotedEnd(rest[1:], '\'')
+			tokens = append(tokens, Token{TokenBlob, rest[:end]})
+			i += end
+
+		case isDigit(r) || (r == '.' && len(rest) > 1 && isDigit(rune(rest[1]))):
+			end := numberEnd(rest)
+			tokens = append(tokens, Token{TokenNumber, rest[:end]})
+			i += end
+
+		case isIdentifierStart(r):
+			end := identifierEnd(rest)
+			word := rest[:end]
+			kind := TokenIdentifier
+			if IsKeyword(word) {
+				kind = TokenKeyword
+			}
+			tokens = append(tokens, Token{kind, word})
+			i += end
+
+		case r == '?':
+			end := 1
+			for end < len(rest) && isDigit(rune(rest[end])) {
+				end++
+			}
+			tokens = append(tokens, Token{TokenParameter, rest[:end]})
+			i += end
+
+		case (r == ':' || r == '@' || r == '$') && len(rest) > 1 && identifierEnd(rest[1:]) > 0:
+			end := 1 + identifierEnd(rest[1:])
+			tokens = append(tokens, Token{TokenParameter, rest[:end]})
+			i += end
+
+		case strings.ContainsRune("(),;.", r):
+			tokens = append(tokens, Token{TokenPunctuation, rest[:size]})
+			i += size
+
+		default:
+			end := size
+			for _, op := range multiCharOperators {
+				if strings.HasPrefix(rest, op) {
+					end = len(op)
+					break
+				}
+			}
+			tokens = append(tokens, Token{TokenOperator, rest[:end]})
+			i += end
+		}
+	}
+
+	return tokens
+}
+
+// quotedEnd returns the end of the quoted text starting at s[0], where a
+// doubled quote is an escaped quote.
+func quotedEnd(s string, quote byte) int {
+	for i := 1; i < len(s); i++ {
+		if s[i] != quote {
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(s)
+}
+
+func numberEnd(s string) int {
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		i := 2
+		for i < len(s) && strings.IndexByte("0123456789abcdefABCDEF", s[i]) >= 0 {
+			i++
+		}
+		return i
+	}
+
+	i := 0
+	for i < len(s) && isDigit(rune(s[i])) {
+		i++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && isDigit(rune(s[i])) {
+			i++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(rune(s[j])) {
+			i = j
+			for i < len(s) && isDigit(rune(s[i])) {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+func identifierEnd(s string) int {
+	end := 0
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if !isIdentifierStart(r) && !isDigit(r) && r != '$' {
+			break
+		}
+		end += size
+	}
+	return end
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r >= utf8.RuneSelf
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"strconv"
 
 	"github.com/database-playground/backend-v2/ent"
 	entsubmission "github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
 	"github.com/database-playground/backend-v2/internal/sqlrunner"
 	"github.com/database-playground/backend-v2/models"
 	"github.com/prometheus/client_golang/prometheus"
@@ -33,10 +36,35 @@ type SubmissionService struct {
 	entClient    *ent.Client
 	eventService *events.EventService
 	sqlrunner    *sqlrunner.SqlRunner
+
+	limiter       *ratelimit.Limiter
+	userLimit     ratelimit.Limit
+	questionLimit ratelimit.Limit
 }
 
-func NewSubmissionService(entClient *ent.Client, eventService *events.EventService, sqlrunner *sqlrunner.SqlRunner) *SubmissionService {
-	return &SubmissionService{entClient: entClient, eventService: eventService, sqlrunner: sqlrunner}
+// Option configures a SubmissionService.
+type Option func(*SubmissionService)
+
+// WithRateLimit limits the submissions of a user with userLimit, and the
+// submissions of a user to a question with questionLimit.
+//
+// The throttled submissions are rejected with ErrRateLimited before running
+// the answer. A disabled limit is not checked.
+func WithRateLimit(limiter *ratelimit.Limiter, userLimit, questionLimit ratelimit.Limit) Option {
+	return func(ss *SubmissionService) {
+		ss.limiter = limiter
+		ss.userLimit = userLimit
+		ss.questionLimit = questionLimit
+	}
+}
+
+func NewSubmissionService(entClient *ent.Client, eventService *events.EventService, sqlrunner *sqlrunner.SqlRunner, opts ...Option) *SubmissionService {
+	ss := &SubmissionService{entClient: entClient, eventService: eventService, sqlrunner: sqlrunner}
+	for _, opt := range opts {
+		opt(ss)
+	}
+
+	return ss
 }
 
 type SubmitAnswerInput struct {
@@ -47,6 +75,11 @@ type SubmitAnswerInput struct {
 
 var ErrQuestionNotFound = errors.New("question not found")
 
+// ErrRateLimited is returned when the user submits too many answers.
+//
+// The error also wraps a *ratelimit.ExceededError with the time to wait.
+var ErrRateLimited = errors.New("too many submissions")
+
 // SubmitAnswer submits an answer from a user to a question.
 func (ss *SubmissionService) SubmitAnswer(ctx context.Context, input SubmitAnswerInput) (*ent.Submission, error) {
 	ctx, span := tracer.Start(ctx, "SubmitAnswer",
@@ -56,6 +89,12 @@ func (ss *SubmissionService) SubmitAnswer(ctx context.Context, input SubmitAnswe
 		))
 	defer span.End()
 
+	span.AddEvent("rate_limit.checking")
+	if err := ss.checkRateLimit(ctx, input); err != nil {
+		span.SetStatus(otelcodes.Error, "Rate limited")
+		return nil, err
+	}
+
 	span.AddEvent("question.fetching")
 	question, err := ss.entClient.Question.Get(ctx, input.QuestionID)
 	if err != nil {
@@ -120,10 +159,10 @@ func (ss *SubmissionService) SubmitAnswer(ctx context.Context, input SubmitAnswe
 	span.AddEvent("event.triggering")
 	ss.eventService.TriggerEvent(ctx, events.Event{
 		Type: events.EventTypeSubmitAnswer,
-		Payload: map[string]any{
-			"submission_id": submission.ID,
-			"question_id":   input.QuestionID,
-			"status":        submission.Status,
+		Payload: events.SubmitAnswerPayload{
+			SubmissionID: submission.ID,
+			QuestionID:   input.QuestionID,
+			Status:       submission.Status,
 		},
 		UserID: input.SubmitterID,
 	})
@@ -132,6 +171,29 @@ func (ss *SubmissionService) SubmitAnswer(ctx context.Context, input SubmitAnswe
 	return submission, nil
 }
 
+// checkRateLimit takes a token from the buckets of the user and the question,
+// and returns ErrRateLimited if either is empty. The submission is allowed if
+// the rate limit can not be checked, so a Redis outage does not block the users.
+func (ss *SubmissionService) checkRateLimit(ctx context.Context, input SubmitAnswerInput) error {
+	if ss.limiter == nil {
+		return nil
+	}
+
+	result, err := ss.limiter.Allow(ctx, "user:"+strconv.Itoa(input.SubmitterID),
+		ratelimit.Bucket{Key: "all", Limit: ss.userLimit},
+		ratelimit.Bucket{Key: "question:" + strconv.Itoa(input.QuestionID), Limit: ss.questionLimit},
+	)
+	if err != nil {
+		slog.Warn("failed to check the submission rate limit", "error", err, "user_id", input.SubmitterID)
+		return nil
+	}
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+
+	return nil
+}
+
 // runAnswer runs both the reference answer and the users' answer, compare them,
 // and return the result of this submission.
 func (ss *SubmissionService) runAnswer(ctx context.Context, schema, answer, referenceAnswer string) (*models.UserSQLExecutionResult, error) {
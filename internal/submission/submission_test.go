@@ -3,6 +3,7 @@ package submission_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/event"
@@ -10,6 +11,7 @@ import (
 	"github.com/database-playground/backend-v2/ent/submission"
 	"github.com/database-playground/backend-v2/ent/user"
 	eventsService "github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/ratelimit"
 	"github.com/database-playground/backend-v2/internal/setup"
 	"github.com/database-playground/backend-v2/internal/sqlrunner"
 	submissionService "github.com/database-playground/backend-v2/internal/submission"
@@ -234,6 +236,46 @@ func TestSubmitAnswer_QuestionNotFound(t *testing.T) {
 	require.Nil(t, result)
 }
 
+func TestSubmitAnswer_RateLimited(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	eventService := eventsService.NewEventService(client, nil)
+	redisClient := testhelper.NewRedisClient(t, testhelper.NewRedisContainer(t))
+
+	// the rate limit is checked before running the answer, so the
+	// non-existent questions need no SQL runner.
+	service := submissionService.NewSubmissionService(client, eventService, nil,
+		submissionService.WithRateLimit(
+			ratelimit.NewLimiter(redisClient, "submission"),
+			ratelimit.Limit{Burst: 3, Interval: time.Hour},
+			ratelimit.Limit{Burst: 1, Interval: time.Minute},
+		),
+	)
+
+	userID, _, _ := setupTestData(t, client)
+	submit := func(questionID int) error {
+		_, err := service.SubmitAnswer(context.Background(), submissionService.SubmitAnswerInput{
+			SubmitterID: userID,
+			QuestionID:  questionID,
+			Answer:      "SELECT 1;",
+		})
+		return err
+	}
+
+	require.ErrorIs(t, submit(99991), submissionService.ErrQuestionNotFound)
+
+	// per-question cooldown
+	err := submit(99991)
+	require.ErrorIs(t, err, submissionService.ErrRateLimited)
+	var exceeded *ratelimit.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	require.Greater(t, exceeded.RetryAfter, 50*time.Second)
+
+	// per-user limit
+	require.ErrorIs(t, submit(99992), submissionService.ErrQuestionNotFound)
+	require.ErrorIs(t, submit(99993), submissionService.ErrQuestionNotFound)
+	require.ErrorIs(t, submit(99994), submissionService.ErrRateLimited)
+}
+
 func TestCompareAnswer(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -1,19 +1,73 @@
 package testhelper
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/database-playground/backend-v2/ent"
 	"github.com/database-playground/backend-v2/ent/enttest"
 	"github.com/database-playground/backend-v2/internal/workers"
 )
 
+const entSqliteDSN = "file:ent?mode=memory&cache=private&_fk=1"
+
 // NewEntSqliteClient creates a new in-memory Ent SQLite client for testing.
 func NewEntSqliteClient(t *testing.T) *ent.Client {
 	t.Helper()
 
-	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=private&_fk=1")
+	client := enttest.Open(t, "sqlite3", entSqliteDSN)
+	closeOnCleanup(t, client)
+
+	return client
+}
+
+// QueryCounter is a driver counting the queries sent to the database.
+// The queries in the transactions are not counted.
+type QueryCounter struct {
+	dialect.Driver
+
+	count atomic.Int64
+}
+
+func (c *QueryCounter) Query(ctx context.Context, query string, args, v any) error {
+	c.count.Add(1)
+	return c.Driver.Query(ctx, query, args, v)
+}
+
+// Count returns the number of the queries since the last Reset.
+func (c *QueryCounter) Count() int {
+	return int(c.count.Load())
+}
 
+// Reset resets the count.
+func (c *QueryCounter) Reset() {
+	c.count.Store(0)
+}
+
+// NewEntSqliteClientWithQueryCounter creates a new in-memory Ent SQLite
+// client for testing, with a QueryCounter counting its queries.
+func NewEntSqliteClientWithQueryCounter(t *testing.T) (*ent.Client, *QueryCounter) {
+	t.Helper()
+
+	drv, err := entsql.Open(dialect.SQLite, entSqliteDSN)
+	if err != nil {
+		t.Fatalf("Failed to open SQLite: %v", err)
+	}
+	// every connection to the in-memory database gets a database of its own.
+	drv.DB().SetMaxOpenConns(1)
+
+	counter := &QueryCounter{Driver: drv}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(counter)))
+	closeOnCleanup(t, client)
+	counter.Reset()
+
+	return client, counter
+}
+
+func closeOnCleanup(t *testing.T, client *ent.Client) {
 	t.Cleanup(func() {
 		// must wait the workers to finish
 		workers.Global.Wait()
@@ -22,6 +76,4 @@ func NewEntSqliteClient(t *testing.T) *ent.Client {
 			t.Fatalf("Failed to close client: %v", err)
 		}
 	})
-
-	return client
 }
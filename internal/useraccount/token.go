@@ -79,8 +79,8 @@ func (c *Context) GrantToken(ctx context.Context, user *ent.User, machine string
 		c.eventService.TriggerEvent(ctx, events.Event{
 			Type:   events.EventTypeImpersonated,
 			UserID: user.ID,
-			Payload: map[string]any{
-				"impersonator_id": options.impersonatorID,
+			Payload: events.ImpersonatedPayload{
+				ImpersonatorID: options.impersonatorID,
 			},
 		})
 		meta[MetaImpersonation] = strconv.Itoa(options.impersonatorID)
@@ -90,8 +90,8 @@ func (c *Context) GrantToken(ctx context.Context, user *ent.User, machine string
 		c.eventService.TriggerEvent(ctx, events.Event{
 			Type:   events.EventTypeLogin,
 			UserID: user.ID,
-			Payload: map[string]any{
-				"machine": machine,
+			Payload: events.LoginPayload{
+				Machine: machine,
 			},
 		})
 	}
@@ -0,0 +1,313 @@
+// Package webhook delivers the events to the outgoing webhook subscriptions.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/ent/webhooksubscription"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("dbplay.webhook")
+
+// DeliveryTotal tracks the outcomes of the webhook deliveries.
+var DeliveryTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "dbplay_webhook_delivery_total",
+		Help: "Total number of webhook deliveries by status",
+	},
+	[]string{"status"},
+)
+
+// HandlerName is the name of the webhook Dispatcher in the event outbox.
+const HandlerName = "webhook"
+
+// ErrUnknownEventType is returned when a subscription subscribes to an unknown event type.
+var ErrUnknownEventType = errors.New("unknown event type")
+
+const (
+	// HeaderEvent is the header of the event type.
+	HeaderEvent = "X-DBPlay-Event"
+	// HeaderEventID is the header of the event ID.
+	HeaderEventID = "X-DBPlay-Event-ID"
+	// HeaderTimestamp is the header of the Unix timestamp the request is signed at.
+	HeaderTimestamp = "X-DBPlay-Timestamp"
+	// HeaderSignature is the header of the HMAC-SHA256 signature, see Sign.
+	HeaderSignature = "X-DBPlay-Signature"
+
+	// DefaultTimeout is the default timeout of a delivery request.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Payload is the JSON body POSTed to the webhooks.
+type Payload struct {
+	ID          int            `json:"id"`
+	Type        string         `json:"type"`
+	UserID      int            `json:"user_id"`
+	TriggeredAt time.Time      `json:"triggered_at"`
+	Payload     map[string]any `json:"payload,omitempty"`
+}
+
+// Sign returns the signature of the body signed at the timestamp:
+// "sha256=" followed by the hex-encoded HMAC-SHA256 of "<timestamp>.<body>".
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature of the body signed at the timestamp is valid.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Dispatcher is an events.EventHandler which delivers the events
+// to the enabled webhook subscriptions matching the event type.
+//
+// Every attempt is logged as a WebhookDelivery. If any delivery fails,
+// HandleEvent returns an error so the event outbox retries it with backoff;
+// the subscriptions which have received the event are skipped in the retries.
+type Dispatcher struct {
+	entClient  *ent.Client
+	httpClient *http.Client
+}
+
+type Option func(*Dispatcher)
+
+// WithHTTPClient sets the HTTP client to deliver the webhooks with.
+func WithHTTPClient(client *http.Client) Option {
+	return func(d *Dispatcher) {
+		d.httpClient = client
+	}
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(entClient *ent.Client, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		entClient:  entClient,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// HandleEvent delivers the event to the matched webhook subscriptions.
+func (d *Dispatcher) HandleEvent(ctx context.Context, event *ent.Event, _ events.Payload) error {
+	ctx, span := tracer.Start(ctx, "HandleEvent",
+		trace.WithAttributes(
+			attribute.Int("event.id", event.ID),
+			attribute.String("event.type", event.Type),
+		))
+	defer span.End()
+
+	span.AddEvent("database.webhook_subscription.query")
+	subscriptions, err := d.entClient.WebhookSubscription.Query().
+		Where(webhooksubscription.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query webhook subscriptions")
+		span.RecordError(err)
+		return fmt.Errorf("query webhook subscriptions: %w", err)
+	}
+
+	body, err := json.Marshal(Payload{
+		ID:          event.ID,
+		Type:        event.Type,
+		UserID:      event.UserID,
+		TriggeredAt: event.TriggeredAt,
+		Payload:     event.Payload,
+	})
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to marshal payload")
+		span.RecordError(err)
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		if !Matches(subscription, event.Type) {
+			continue
+		}
+
+		if err := d.deliver(ctx, subscription, event, body); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d: %w", subscription.ID, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to deliver webhooks")
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhooks delivered successfully")
+	return nil
+}
+
+// WantsEvent reports whether any enabled subscription subscribes to the event
+// type, so the events nobody subscribes to are not enqueued for the Dispatcher.
+func (d *Dispatcher) WantsEvent(ctx context.Context, eventType events.EventType) (bool, error) {
+	subscriptions, err := d.entClient.WebhookSubscription.Query().
+		Where(webhooksubscription.Enabled(true)).
+		Select(webhooksubscription.FieldEventTypes).
+		All(ctx)
+	if err != nil {
+		return false, fmt.Errorf("query webhook subscriptions: %w", err)
+	}
+
+	return slices.ContainsFunc(subscriptions, func(subscription *ent.WebhookSubscription) bool {
+		return Matches(subscription, string(eventType))
+	}), nil
+}
+
+// ValidateEventTypes returns ErrUnknownEventType if any of the event types
+// to subscribe to is not one of events.EventTypes.
+func ValidateEventTypes(eventTypes []string) error {
+	known := events.EventTypes()
+	for _, eventType := range eventTypes {
+		if !slices.Contains(known, events.EventType(eventType)) {
+			return fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether the subscription subscribes to the event type.
+func Matches(subscription *ent.WebhookSubscription, eventType string) bool {
+	return len(subscription.EventTypes) == 0 || slices.Contains(subscription.EventTypes, eventType)
+}
+
+// deliver delivers the event to a subscription unless it has been delivered,
+// and logs the attempt.
+func (d *Dispatcher) deliver(ctx context.Context, subscription *ent.WebhookSubscription, event *ent.Event, body []byte) error {
+	ctx, span := tracer.Start(ctx, "deliver",
+		trace.WithAttributes(
+			attribute.Int("webhook.subscription.id", subscription.ID),
+			attribute.Int("event.id", event.ID),
+		))
+	defer span.End()
+
+	deliveries := d.entClient.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.HasSubscriptionWith(webhooksubscription.ID(subscription.ID)),
+			webhookdelivery.EventID(event.ID),
+		)
+
+	span.AddEvent("database.webhook_delivery.query")
+	delivered, err := deliveries.Clone().
+		Where(webhookdelivery.StatusEQ(webhookdelivery.StatusSucceeded)).
+		Exist(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to query deliveries")
+		span.RecordError(err)
+		return fmt.Errorf("query deliveries: %w", err)
+	}
+	if delivered {
+		span.SetStatus(otelcodes.Ok, "Event has been delivered")
+		return nil
+	}
+
+	attempts, err := deliveries.Count(ctx)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to count deliveries")
+		span.RecordError(err)
+		return fmt.Errorf("count deliveries: %w", err)
+	}
+
+	start := time.Now()
+	statusCode, deliverErr := d.post(ctx, subscription, event, body)
+	duration := time.Since(start)
+
+	create := d.entClient.WebhookDelivery.Create().
+		SetSubscription(subscription).
+		SetEventID(event.ID).
+		SetEventType(event.Type).
+		SetAttempt(attempts + 1).
+		SetDurationMs(int(duration.Milliseconds())).
+		SetDeliveredAt(start)
+	if statusCode != 0 {
+		create.SetResponseStatus(statusCode)
+	}
+	status := webhookdelivery.StatusSucceeded
+	if deliverErr != nil {
+		status = webhookdelivery.StatusFailed
+		create.SetError(deliverErr.Error())
+	}
+	create.SetStatus(status)
+
+	DeliveryTotal.WithLabelValues(status.String()).Inc()
+
+	span.AddEvent("database.webhook_delivery.create")
+	if err := create.Exec(ctx); err != nil {
+		span.SetStatus(otelcodes.Error, "Failed to log delivery")
+		span.RecordError(err)
+		return errors.Join(deliverErr, fmt.Errorf("log delivery: %w", err))
+	}
+
+	if deliverErr != nil {
+		span.SetStatus(otelcodes.Error, "Failed to deliver webhook")
+		span.RecordError(deliverErr)
+		return deliverErr
+	}
+
+	span.SetStatus(otelcodes.Ok, "Webhook delivered successfully")
+	return nil
+}
+
+// post POSTs the signed body to the subscription URL.
+//
+// It returns the response status code, or 0 if there is no response.
+func (d *Dispatcher) post(ctx context.Context, subscription *ent.WebhookSubscription, event *ent.Event, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "dbplay-webhook/1")
+	req.Header.Set(HeaderEvent, event.Type)
+	req.Header.Set(HeaderEventID, strconv.Itoa(event.ID))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
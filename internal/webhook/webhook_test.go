@@ -0,0 +1,301 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/database-playground/backend-v2/ent"
+	"github.com/database-playground/backend-v2/ent/webhookdelivery"
+	"github.com/database-playground/backend-v2/internal/events"
+	"github.com/database-playground/backend-v2/internal/setup"
+	"github.com/database-playground/backend-v2/internal/testhelper"
+	"github.com/database-playground/backend-v2/internal/webhook"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testSecret = "test-secret"
+
+// receiver is a webhook receiver which fails the first failures requests.
+type receiver struct {
+	mu       sync.Mutex
+	failures int
+	received []webhook.Payload
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.failures > 0 {
+		rc.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+	if err != nil || !webhook.Verify(testSecret, timestamp, body, r.Header.Get(webhook.HeaderSignature)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhook.Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if r.Header.Get(webhook.HeaderEvent) != payload.Type {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	rc.received = append(rc.received, payload)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func setupTestEvent(t *testing.T, client *ent.Client, eventType string) *ent.Event {
+	t.Helper()
+
+	ctx := context.Background()
+
+	setupResult, err := setup.Setup(ctx, client)
+	require.NoError(t, err)
+
+	user, err := client.User.Create().
+		SetName("Test User").
+		SetEmail("test@example.com").
+		SetGroup(setupResult.StudentGroup).
+		Save(ctx)
+	require.NoError(t, err)
+
+	event, err := client.Event.Create().
+		SetUserID(user.ID).
+		SetType(eventType).
+		SetPayload(map[string]any{"question_id": 1}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return event
+}
+
+func createSubscription(t *testing.T, client *ent.Client, url string, eventTypes ...string) *ent.WebhookSubscription {
+	t.Helper()
+
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	subscription, err := client.WebhookSubscription.Create().
+		SetName("Test Webhook").
+		SetURL(url).
+		SetSecret(testSecret).
+		SetEventTypes(eventTypes).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	return subscription
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":1}`)
+	signature := webhook.Sign("secret", 1700000000, body)
+
+	require.Regexp(t, `^sha256=[0-9a-f]{64}$`, signature)
+	require.True(t, webhook.Verify("secret", 1700000000, body, signature))
+	require.False(t, webhook.Verify("another-secret", 1700000000, body, signature))
+	require.False(t, webhook.Verify("secret", 1700000001, body, signature))
+	require.False(t, webhook.Verify("secret", 1700000000, []byte(`{"id":2}`), signature))
+}
+
+func TestDispatcher_HandleEvent(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	event := setupTestEvent(t, client, "submit_answer")
+	ctx := context.Background()
+
+	rc := &receiver{}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	subscription := createSubscription(t, client, server.URL)
+
+	err := webhook.NewDispatcher(client).HandleEvent(ctx, event, nil)
+	require.NoError(t, err)
+
+	require.Len(t, rc.received, 1)
+	require.Equal(t, event.ID, rc.received[0].ID)
+	require.Equal(t, "submit_answer", rc.received[0].Type)
+	require.Equal(t, event.UserID, rc.received[0].UserID)
+	require.EqualValues(t, 1, rc.received[0].Payload["question_id"])
+
+	delivery, err := subscription.QueryDeliveries().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, webhookdelivery.StatusSucceeded, delivery.Status)
+	require.Equal(t, 1, delivery.Attempt)
+	require.Equal(t, event.ID, delivery.EventID)
+	require.NotNil(t, delivery.ResponseStatus)
+	require.Equal(t, http.StatusNoContent, *delivery.ResponseStatus)
+	require.Nil(t, delivery.Error)
+}
+
+func TestDispatcher_RetryFailedDelivery(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	event := setupTestEvent(t, client, "submit_answer")
+	ctx := context.Background()
+
+	failing := &receiver{failures: 1}
+	failingServer := httptest.NewServer(failing)
+	defer failingServer.Close()
+
+	healthy := &receiver{}
+	healthyServer := httptest.NewServer(healthy)
+	defer healthyServer.Close()
+
+	failingSubscription := createSubscription(t, client, failingServer.URL)
+	createSubscription(t, client, healthyServer.URL)
+
+	dispatcher := webhook.NewDispatcher(client)
+
+	// The failed delivery makes the handler fail, so the outbox retries it.
+	err := dispatcher.HandleEvent(ctx, event, nil)
+	require.Error(t, err)
+	require.Len(t, healthy.received, 1)
+	require.Empty(t, failing.received)
+
+	failed, err := failingSubscription.QueryDeliveries().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, webhookdelivery.StatusFailed, failed.Status)
+	require.Equal(t, http.StatusInternalServerError, *failed.ResponseStatus)
+	require.NotNil(t, failed.Error)
+
+	// The retry delivers only to the failed subscription.
+	err = dispatcher.HandleEvent(ctx, event, nil)
+	require.NoError(t, err)
+	require.Len(t, healthy.received, 1)
+	require.Len(t, failing.received, 1)
+
+	deliveries, err := failingSubscription.QueryDeliveries().
+		Order(webhookdelivery.ByAttempt()).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, 2, deliveries[1].Attempt)
+	require.Equal(t, webhookdelivery.StatusSucceeded, deliveries[1].Status)
+
+	count, err := client.WebhookDelivery.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
+
+func TestDispatcher_SkipUnmatchedSubscriptions(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	event := setupTestEvent(t, client, "login")
+	ctx := context.Background()
+
+	rc := &receiver{}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	createSubscription(t, client, server.URL, "submit_answer")
+
+	disabled := createSubscription(t, client, server.URL)
+	err := disabled.Update().SetEnabled(false).Exec(ctx)
+	require.NoError(t, err)
+
+	err = webhook.NewDispatcher(client).HandleEvent(ctx, event, nil)
+	require.NoError(t, err)
+	require.Empty(t, rc.received)
+
+	count, err := client.WebhookDelivery.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestDispatcher_Timeout(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	event := setupTestEvent(t, client, "login")
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	subscription := createSubscription(t, client, server.URL)
+
+	dispatcher := webhook.NewDispatcher(client, webhook.WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+	err := dispatcher.HandleEvent(ctx, event, nil)
+	require.Error(t, err)
+
+	delivery, err := subscription.QueryDeliveries().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, webhookdelivery.StatusFailed, delivery.Status)
+	require.Nil(t, delivery.ResponseStatus)
+}
+
+func TestMatches(t *testing.T) {
+	require.True(t, webhook.Matches(&ent.WebhookSubscription{}, "login"))
+	require.True(t, webhook.Matches(&ent.WebhookSubscription{EventTypes: []string{"login", "logout"}}, "login"))
+	require.False(t, webhook.Matches(&ent.WebhookSubscription{EventTypes: []string{"logout"}}, "login"))
+}
+
+func TestDispatcher_WantsEvent(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	dispatcher := webhook.NewDispatcher(client)
+	ctx := context.Background()
+
+	// Nothing subscribes to the events yet.
+	wants, err := dispatcher.WantsEvent(ctx, events.EventTypeLogin)
+	require.NoError(t, err)
+	require.False(t, wants)
+
+	subscription := createSubscription(t, client, "https://example.com/hook", "login")
+	wants, err = dispatcher.WantsEvent(ctx, events.EventTypeLogin)
+	require.NoError(t, err)
+	require.True(t, wants)
+	wants, err = dispatcher.WantsEvent(ctx, events.EventTypeLogout)
+	require.NoError(t, err)
+	require.False(t, wants)
+
+	// The disabled subscriptions do not count.
+	require.NoError(t, client.WebhookSubscription.UpdateOne(subscription).SetEnabled(false).Exec(ctx))
+	wants, err = dispatcher.WantsEvent(ctx, events.EventTypeLogin)
+	require.NoError(t, err)
+	require.False(t, wants)
+}
+
+func TestValidateEventTypes(t *testing.T) {
+	require.NoError(t, webhook.ValidateEventTypes(nil))
+	require.NoError(t, webhook.ValidateEventTypes([]string{"login", "submit_answer"}))
+	require.ErrorIs(t, webhook.ValidateEventTypes([]string{"login", "log_in"}), webhook.ErrUnknownEventType)
+}
+
+func TestSubscription_InvalidURL(t *testing.T) {
+	client := testhelper.NewEntSqliteClient(t)
+	ctx := context.Background()
+
+	for _, url := range []string{"ftp://example.com/hook", "/relative/hook", "https://"} {
+		_, err := client.WebhookSubscription.Create().
+			SetName("Invalid Webhook").
+			SetURL(url).
+			SetSecret(testSecret).
+			Save(ctx)
+		require.True(t, ent.IsValidationError(err), "url %q should be rejected", url)
+	}
+}
@@ -0,0 +1,11 @@
+package models
+
+// AuditLogChange is a field of the target changed by an audited mutation.
+//
+// The values are JSON-encoded. Before is nil if the target is created,
+// and After is nil if the target is deleted.
+type AuditLogChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
@@ -0,0 +1,27 @@
+package models
+
+// CheatEvidence is the structured evidence of a cheat record.
+type CheatEvidence struct {
+	SubmissionIDs []int `json:"submission_ids,omitempty"` // 相關的提交
+	EventIDs      []int `json:"event_ids,omitempty"`      // 相關的事件
+
+	// Plagiarism is set if the record is created by the plagiarism detector.
+	Plagiarism *PlagiarismEvidence `json:"plagiarism,omitempty"`
+}
+
+// PlagiarismEvidence is the evidence of a suspected plagiarism: the user and
+// another user in the same group submitted the same normalized solution.
+type PlagiarismEvidence struct {
+	QuestionID          int `json:"question_id"`
+	SubmissionID        int `json:"submission_id"`         // 這位使用者的提交
+	MatchedUserID       int `json:"matched_user_id"`       // 提交相同解答的另一位使用者
+	MatchedSubmissionID int `json:"matched_submission_id"` // 另一位使用者的提交
+
+	NormalizedHash  string `json:"normalized_hash"`  // 正規化 SQL 的雜湊值
+	IntervalSeconds int    `json:"interval_seconds"` // 兩次提交間隔的秒數
+	SharedUsers     int    `json:"shared_users"`     // 偵測期間內群組中提交相同解答的使用者數
+
+	IdenticalCode    bool `json:"identical_code"`    // 兩次提交的原始 SQL 完全相同
+	SubmittedClosely bool `json:"submitted_closely"` // 兩次提交的間隔在偵測的時間範圍內
+	RareSolution     bool `json:"rare_solution"`     // 偵測期間內只有這兩位使用者提交相同的解答
+}
@@ -1,6 +1,7 @@
 package models
 
 type QuestionStatistics struct {
+	QuestionID             int `json:"-"`                      // 統計的題目 ID
 	CorrectSubmissionCount int `json:"correctSubmissionCount"` // 答案正確的提交數
 	SubmissionCount        int `json:"submissionCount"`        // 所有提交數
 	AttemptedUsers         int `json:"attemptedUsers"`         // 嘗試人數
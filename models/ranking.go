@@ -1,7 +1,26 @@
 package models
 
+import "time"
+
 // UserScore represents a user's score for ranking purposes
 type UserScore struct {
 	UserID int
 	Score  int
+	// AchievedAt is when the user reached the score, which breaks the ties.
+	AchievedAt time.Time
+	// Rank is the 1-based rank of the score, shared by the ties.
+	Rank int
+	// Tie is the 0-based position of the user among the users reaching the same
+	// score at the same second, which places the cursors without the user ID.
+	Tie int
+}
+
+// RankingProfile is the public profile of a user in the rankings, which only
+// shows what the user chooses to.
+type RankingProfile struct {
+	// UserID is not exposed in GraphQL, so the pseudonymous users cannot be identified.
+	UserID      int     `json:"-"`
+	DisplayName string  `json:"displayName"`
+	Avatar      *string `json:"avatar"`
+	IsMe        bool    `json:"isMe"`
 }
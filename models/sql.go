@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// SQLTime scans the time aggregated by the database (e.g. MIN or MAX),
+// which is returned as text by SQLite.
+type SQLTime time.Time
+
+// sqlTimeLayouts are the layouts of the time stored by SQLite.
+var sqlTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+func (t *SQLTime) Scan(src any) error {
+	var value string
+	switch src := src.(type) {
+	case nil:
+		*t = SQLTime{}
+		return nil
+	case time.Time:
+		*t = SQLTime(src)
+		return nil
+	case string:
+		value = src
+	case []byte:
+		value = string(src)
+	default:
+		return fmt.Errorf("unsupported time type %T", src)
+	}
+
+	for _, layout := range sqlTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			*t = SQLTime(parsed)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid time %q", value)
+}